@@ -0,0 +1,147 @@
+package llmspecs
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// AlternativesOptions tunes Alternatives.
+type AlternativesOptions struct {
+	// SameProvider keeps models from m's own provider. By default they are
+	// excluded, since alternatives are mostly wanted when a provider is down,
+	// except for equivalents such as m's own variants.
+	SameProvider bool
+	// Filter adds hard constraints on the candidates, e.g.
+	// Query().MaxInputPrice(1). Only its filters apply. It may be nil.
+	Filter *QueryBuilder
+	// MinScore drops computed matches scoring below it. Equivalents are
+	// always kept.
+	MinScore float64
+	// Limit caps the number of results; 0 returns all.
+	Limit int
+}
+
+// Alternative is a substitute for a model, as returned by Alternatives.
+type Alternative struct {
+	Model Model
+	// Equivalent reports an explicit equivalent_to link in either direction,
+	// the same Hugging Face weights served under another ID, or another
+	// variant of the same model, e.g. its ":free" tier.
+	Equivalent bool
+	// Score rates the similarity between 0 and 1; equivalents score 1.
+	Score float64
+	// SameTokenizer reports a shared tokenizer family, a hint that the
+	// models come from the same lineage and count tokens alike.
+	SameTokenizer bool
+	// Context is 1 when the candidate's window is at least as large as the
+	// original's, falling to 0 at a sixteenth of it.
+	Context float64
+	// Price is 1 for the same blended price per million tokens, falling to
+	// 0 when the prices are an order of magnitude apart.
+	Price float64
+}
+
+// Alternatives returns substitutes for m, best first: equivalents, from any
+// provider, then models offering at least all of m's capabilities, ranked by tokenizer
+// family, context window and price similarity. Deprecated and retired
+// models, and m itself, are never returned. Ties are broken by ID, so the
+// list is deterministic.
+func Alternatives(m Model, opts AlternativesOptions) []Alternative {
+	var results []Alternative
+	for _, c := range Query().ExcludeDeprecated().List() {
+		if c.ID() == m.ID() {
+			continue
+		}
+		if opts.Filter != nil && !opts.Filter.match(c) {
+			continue
+		}
+		if equivalent(m, c) {
+			results = append(results, Alternative{
+				Model:         c,
+				Equivalent:    true,
+				Score:         1,
+				SameTokenizer: sameTokenizer(m, c),
+				Context:       contextSimilarity(m, c),
+				Price:         priceSimilarity(m, c),
+			})
+			continue
+		}
+		if !opts.SameProvider && strings.EqualFold(c.Provider(), m.Provider()) {
+			continue
+		}
+		if c.Features()&m.Features() != m.Features() {
+			continue
+		}
+		a := Alternative{
+			Model:         c,
+			SameTokenizer: sameTokenizer(m, c),
+			Context:       contextSimilarity(m, c),
+			Price:         priceSimilarity(m, c),
+		}
+		a.Score = 0.4*a.Context + 0.4*a.Price
+		if a.SameTokenizer {
+			a.Score += 0.2
+		}
+		if a.Score < opts.MinScore {
+			continue
+		}
+		results = append(results, a)
+	}
+
+	// q.List is sorted by ID, so a stable sort keeps ties in ID order
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Equivalent != b.Equivalent {
+			return a.Equivalent
+		}
+		return a.Score > b.Score
+	})
+	if opts.Limit > 0 && len(results) > opts.Limit {
+		results = results[:opts.Limit]
+	}
+	return results
+}
+
+// equivalent reports whether a and b are declared or known to be the same model.
+func equivalent(a, b Model) bool {
+	if slices.Contains(a.EquivalentTo(), b.ID()) || slices.Contains(b.EquivalentTo(), a.ID()) {
+		return true
+	}
+	// Variants serve the same weights under other terms
+	baseA, _, _ := strings.Cut(a.ID(), ":")
+	baseB, _, _ := strings.Cut(b.ID(), ":")
+	if baseA == baseB {
+		return true
+	}
+	hf := a.HuggingFaceID()
+	return hf != "" && strings.EqualFold(hf, b.HuggingFaceID())
+}
+
+// sameTokenizer ignores the catch-all "Other" family.
+func sameTokenizer(a, b Model) bool {
+	t := a.Tokenizer()
+	return t != "" && !strings.EqualFold(t, "Other") && strings.EqualFold(t, b.Tokenizer())
+}
+
+func contextSimilarity(original, candidate Model) float64 {
+	want, got := original.ContextLength(), candidate.ContextLength()
+	if want <= 0 || got >= want {
+		return 1
+	}
+	if got <= 0 {
+		return 0
+	}
+	return max(0, 1-math.Log2(float64(want)/float64(got))/4)
+}
+
+func priceSimilarity(a, b Model) float64 {
+	pa, okA := blendedPrice(a)
+	pb, okB := blendedPrice(b)
+	if !okA || !okB {
+		return 0
+	}
+	// blendedPrice is log1p of USD per million tokens
+	return max(0, 1-math.Abs(pa-pb)/math.Ln10)
+}
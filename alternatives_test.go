@@ -0,0 +1,110 @@
+package llmspecs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAlternatives(t *testing.T) {
+	m, _ := Get("openai/gpt-4o")
+	results := Alternatives(m, AlternativesOptions{Limit: 10})
+	if len(results) == 0 {
+		t.Fatal("Expected alternatives for gpt-4o")
+	}
+	for i, a := range results {
+		c := a.Model
+		if c.Provider() == m.Provider() && !a.Equivalent {
+			t.Errorf("%s is from the same provider", c.ID())
+		}
+		if !a.Equivalent && c.Features()&m.Features() != m.Features() {
+			t.Errorf("%s lacks capabilities of gpt-4o: %v", c.ID(), c.Features())
+		}
+		if c.Status() == StatusDeprecated || c.Status() == StatusRetired {
+			t.Errorf("%s is %v", c.ID(), c.Status())
+		}
+		if i > 0 && results[i-1].Score < a.Score {
+			t.Error("Alternatives not ranked by score")
+		}
+	}
+
+	again := Alternatives(m, AlternativesOptions{Limit: 10})
+	if !slices.EqualFunc(results, again, func(a, b Alternative) bool { return a.Model.ID() == b.Model.ID() }) {
+		t.Error("Alternatives is not deterministic")
+	}
+
+	same := Alternatives(m, AlternativesOptions{SameProvider: true, Filter: Query().Provider("OpenAI")})
+	for _, a := range same {
+		if a.Model.ID() == m.ID() || a.Model.Provider() != "OpenAI" {
+			t.Errorf("Unexpected alternative %s", a.Model.ID())
+		}
+	}
+}
+
+func TestAlternatives_Equivalents(t *testing.T) {
+	llama, _ := Get("meta-llama/llama-3.1-70b-instruct")
+	hosted := &modelData{
+		IDVal: "acme/llama-3.1-70b", ProviderVal: "Acme", FeaturesVal: CapChat | ModalityTextIn | ModalityTextOut,
+		HFIDVal: "meta-llama/Meta-Llama-3.1-70B-Instruct",
+	}
+	linked := &modelData{
+		IDVal: "acme/l3-70b-turbo", ProviderVal: "Acme", FeaturesVal: CapChat,
+		EquivList: []string{"meta-llama/llama-3.1-70b-instruct"},
+	}
+	for _, m := range []Model{hosted, linked} {
+		if err := Register(m); err != nil {
+			t.Fatal(err)
+		}
+		defer Unregister(m.ID())
+	}
+
+	results := Alternatives(llama, AlternativesOptions{MinScore: 0.5})
+	if len(results) < 2 || !results[0].Equivalent || !results[1].Equivalent {
+		t.Fatalf("Expected the two equivalents first, got %v", results)
+	}
+	if results[0].Model.ID() != "acme/l3-70b-turbo" || results[1].Model.ID() != "acme/llama-3.1-70b" {
+		t.Errorf("Equivalents not ordered by ID: %s, %s", results[0].Model.ID(), results[1].Model.ID())
+	}
+	for _, a := range results[2:] {
+		if a.Equivalent || a.Score < 0.5 {
+			t.Errorf("Unexpected match %s (%v)", a.Model.ID(), a.Score)
+		}
+	}
+
+	// Links work in both directions
+	back := Alternatives(linked, AlternativesOptions{})
+	if len(back) == 0 || back[0].Model.ID() != llama.ID() || !back[0].Equivalent {
+		t.Errorf("Expected the reverse link to llama, got %v", back)
+	}
+}
+
+func TestAlternatives_BuiltinEquivalents(t *testing.T) {
+	tests := map[string][]string{
+		// Declared in models/, in both directions
+		"openai/o3-mini-high":    {"openai/o3-mini"},
+		"openai/o3-mini":         {"openai/o3-mini-high"},
+		"mistralai/mistral-tiny": {"mistralai/mistral-7b-instruct-v0.2"},
+		"x-ai/grok-3-beta":       {"x-ai/grok-3"},
+		// Same Hugging Face weights
+		"mistralai/mistral-7b-instruct": {"mistralai/mistral-7b-instruct-v0.3"},
+		// Variants of the model itself, despite the shared provider
+		"qwen/qwen3-coder":   {"qwen/qwen3-coder:exacto", "qwen/qwen3-coder:free"},
+		"openai/gpt-oss-20b": {"openai/gpt-oss-20b:free"},
+	}
+	for id, want := range tests {
+		m, ok := Get(id)
+		if !ok {
+			t.Fatalf("%s not found", id)
+		}
+		var got []string
+		for _, a := range Alternatives(m, AlternativesOptions{}) {
+			if a.Equivalent {
+				got = append(got, a.Model.ID())
+			}
+		}
+		for _, w := range want {
+			if !slices.Contains(got, w) {
+				t.Errorf("Alternatives(%s) equivalents = %v, missing %s", id, got, w)
+			}
+		}
+	}
+}
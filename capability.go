@@ -1,8 +1,13 @@
 package llmspecs
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Capability represents a model's features or modalities using bitmasks.
@@ -27,9 +32,13 @@ const (
 	CapFunctionCall Capability = 1 << (16 + iota)
 	CapJsonMode
 	CapSystemPrompt
+	CapReasoning // thinking models that emit reasoning tokens
+)
 
-	// Types (32-47 bit)
-	CapChat Capability = 1 << (32 + iota)
+const (
+	// Types (32-47 bit). They start at bit 35, where they have always been,
+	// since Capability values may be persisted; new bits go after them.
+	CapChat Capability = 1 << (35 + iota)
 	CapEmbedding
 	CapRerank
 	CapTTS
@@ -56,6 +65,7 @@ var capabilityNames = []struct {
 	{CapFunctionCall, "FunctionCall"},
 	{CapJsonMode, "JsonMode"},
 	{CapSystemPrompt, "SystemPrompt"},
+	{CapReasoning, "Reasoning"},
 	{CapChat, "Chat"},
 	{CapEmbedding, "Embedding"},
 	{CapRerank, "Rerank"},
@@ -104,3 +114,120 @@ func (c Capability) String() string {
 	}
 	return strings.Join(names, "|")
 }
+
+// lookupCapability finds a single capability by name. It accepts both the
+// String spelling ("ImageIn") and the Go identifier used in models/ YAML
+// ("ModalityImageIn"), case-insensitively.
+func lookupCapability(name string) (Capability, bool) {
+	for _, entry := range capabilityNames {
+		if strings.EqualFold(name, entry.name) || strings.EqualFold(name, capabilityIdent(entry.mask, entry.name)) {
+			return entry.mask, true
+		}
+	}
+	return 0, false
+}
+
+// capabilityIdent returns the Go identifier of a capability, e.g. "CapChat"
+// or "ModalityImageIn".
+func capabilityIdent(mask Capability, name string) string {
+	if mask < CapFunctionCall {
+		return "Modality" + name
+	}
+	return "Cap" + name
+}
+
+// ParseCapability parses a capability set such as "Chat|ImageIn" or
+// "CapChat, ModalityImageIn". Names may be separated by "|", "," or spaces and
+// use either the String spelling or the Go identifier, case-insensitively.
+// "None" and the empty string yield 0; "Unknown(0x...)" restores raw bits.
+func ParseCapability(s string) (Capability, error) {
+	var c Capability
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == '|' || r == ',' || r == ' ' || r == '\t'
+	})
+	for _, name := range fields {
+		bits, err := parseCapabilityName(name)
+		if err != nil {
+			return 0, err
+		}
+		c |= bits
+	}
+	return c, nil
+}
+
+func parseCapabilityName(name string) (Capability, error) {
+	if strings.EqualFold(name, "None") {
+		return 0, nil
+	}
+	if c, ok := lookupCapability(name); ok {
+		return c, nil
+	}
+	if hex, ok := strings.CutPrefix(name, "Unknown("); ok && strings.HasSuffix(hex, ")") {
+		if bits, err := strconv.ParseUint(strings.TrimSuffix(hex, ")"), 0, 64); err == nil {
+			return Capability(bits), nil
+		}
+	}
+	return 0, fmt.Errorf("llmspecs: unknown capability %q", name)
+}
+
+// MarshalText implements encoding.TextMarshaler. The empty set marshals to "".
+func (c Capability) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(c.ToStrings(), "|")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCapability.
+func (c *Capability) UnmarshalText(text []byte) error {
+	v, err := ParseCapability(string(text))
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+// MarshalJSON encodes the set as an array of names, e.g. ["Chat","ImageIn"].
+func (c Capability) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.ToStrings())
+}
+
+// UnmarshalJSON accepts an array of names or a single string in any form
+// accepted by ParseCapability.
+func (c *Capability) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var s string
+		if json.Unmarshal(data, &s) != nil {
+			return errors.New("llmspecs: capability must be a string or an array of strings")
+		}
+		names = []string{s}
+	}
+	return c.UnmarshalText([]byte(strings.Join(names, "|")))
+}
+
+// MarshalYAML encodes the set as a sequence of names.
+func (c Capability) MarshalYAML() (any, error) {
+	return c.ToStrings(), nil
+}
+
+// UnmarshalYAML accepts a sequence of names, like the features list of the
+// models/ schema, or a single scalar in any form accepted by ParseCapability.
+func (c *Capability) UnmarshalYAML(node *yaml.Node) error {
+	var names []string
+	if node.Kind == yaml.ScalarNode {
+		names = []string{node.Value}
+	} else if err := node.Decode(&names); err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(strings.Join(names, "|")))
+}
+
+// Set implements flag.Value. Repeated flags accumulate, so
+// "-cap ImageIn -cap FunctionCall" and "-cap ImageIn,FunctionCall" are equivalent.
+func (c *Capability) Set(s string) error {
+	v, err := ParseCapability(s)
+	if err != nil {
+		return err
+	}
+	*c |= v
+	return nil
+}
@@ -1,8 +1,12 @@
 package llmspecs
 
 import (
+	"encoding/json"
+	"flag"
 	"reflect"
 	"testing"
+
+	"gopkg.in/yaml.v3"
 )
 
 func TestCapability_Has(t *testing.T) {
@@ -24,6 +28,20 @@ func TestCapability_Has(t *testing.T) {
 	}
 }
 
+func TestCapability_StableBits(t *testing.T) {
+	// Values may be persisted, so bits must never move
+	tests := map[Capability]uint{
+		ModalityTextIn: 0, ModalityFileOut: 9,
+		CapFunctionCall: 16, CapSystemPrompt: 18, CapReasoning: 19,
+		CapChat: 35, CapEmbedding: 36, CapRerank: 37, CapTTS: 38, CapASR: 39, CapMultimodal: 40,
+	}
+	for c, bit := range tests {
+		if c != 1<<bit {
+			t.Errorf("%s = %#x, want bit %d", c, uint64(c), bit)
+		}
+	}
+}
+
 func TestCapability_All(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -49,6 +67,12 @@ func TestCapability_All(t *testing.T) {
 			wantStr:  "TextIn|FunctionCall",
 			wantList: []string{"TextIn", "FunctionCall"},
 		},
+		{
+			name:     "推理能力",
+			input:    CapChat | CapReasoning,
+			wantStr:  "Reasoning|Chat",
+			wantList: []string{"Reasoning", "Chat"},
+		},
 		{
 			name:     "未知位测试",
 			input:    1 << 62, // 一个未定义的位
@@ -71,3 +95,72 @@ func TestCapability_All(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCapability(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Capability
+	}{
+		{"", 0},
+		{"None", 0},
+		{"Chat|ImageIn", CapChat | ModalityImageIn},
+		{"CapChat, ModalityImageIn", CapChat | ModalityImageIn},
+		{"functioncall jsonmode", CapFunctionCall | CapJsonMode},
+		{"TextIn|Unknown(0x8000000000000000)", ModalityTextIn | 1<<63},
+	}
+	for _, tt := range tests {
+		got, err := ParseCapability(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseCapability(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := ParseCapability("Chat|Telepathy"); err == nil {
+		t.Error("Expected an error for an unknown name")
+	}
+}
+
+func TestCapability_Marshaling(t *testing.T) {
+	caps := CapChat | ModalityImageIn | CapReasoning
+
+	text, _ := caps.MarshalText()
+	var fromText Capability
+	if err := fromText.UnmarshalText(text); err != nil || fromText != caps {
+		t.Errorf("Text round-trip of %q gave %v, %v", text, fromText, err)
+	}
+
+	data, err := json.Marshal(struct{ Features Capability }{caps})
+	if err != nil || string(data) != `{"Features":["ImageIn","Reasoning","Chat"]}` {
+		t.Errorf("Unexpected JSON %s, %v", data, err)
+	}
+	var v struct{ Features Capability }
+	if err := json.Unmarshal(data, &v); err != nil || v.Features != caps {
+		t.Errorf("JSON round-trip gave %v, %v", v.Features, err)
+	}
+	if err := json.Unmarshal([]byte(`{"Features":"CapChat|ModalityImageIn"}`), &v); err != nil || v.Features != CapChat|ModalityImageIn {
+		t.Errorf("JSON string form gave %v, %v", v.Features, err)
+	}
+
+	var y struct {
+		Features Capability `yaml:"features"`
+	}
+	if err := yaml.Unmarshal([]byte("features:\n  - CapChat\n  - ModalityImageIn\n"), &y); err != nil || y.Features != CapChat|ModalityImageIn {
+		t.Errorf("YAML sequence gave %v, %v", y.Features, err)
+	}
+	out, _ := yaml.Marshal(y)
+	y.Features = 0
+	if err := yaml.Unmarshal(out, &y); err != nil || y.Features != CapChat|ModalityImageIn {
+		t.Errorf("YAML round-trip of %s gave %v, %v", out, y.Features, err)
+	}
+}
+
+func TestCapability_Flag(t *testing.T) {
+	var caps Capability
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&caps, "cap", "required capabilities")
+	if err := fs.Parse([]string{"-cap", "ImageIn", "-cap", "FunctionCall,JsonMode"}); err != nil {
+		t.Fatal(err)
+	}
+	if caps != ModalityImageIn|CapFunctionCall|CapJsonMode {
+		t.Errorf("Unexpected flag value %v", caps)
+	}
+}
@@ -3,13 +3,16 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -17,6 +20,8 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"gopkg.in/yaml.v3"
+
+	"github.com/kingfs/go-llm-specs/internal/modelid"
 )
 
 // OpenRouter model structures
@@ -29,6 +34,11 @@ type OpenRouterModel struct {
 	Architecture        OpenRouterArchitecture `json:"architecture"`
 	Pricing             OpenRouterPricing      `json:"pricing"`
 	SupportedParameters []string               `json:"supported_parameters"`
+	DefaultParameters   map[string]*float64    `json:"default_parameters"`
+	Created             int64                  `json:"created"`
+	CanonicalSlug       string                 `json:"canonical_slug"`
+	HuggingFaceID       string                 `json:"hugging_face_id"`
+	ExpirationDate      string                 `json:"expiration_date"`
 }
 
 type OpenRouterTopProvider struct {
@@ -38,13 +48,22 @@ type OpenRouterTopProvider struct {
 
 type OpenRouterArchitecture struct {
 	Modality         string   `json:"modality"`
+	Tokenizer        string   `json:"tokenizer"`
+	InstructType     string   `json:"instruct_type"`
 	InputModalities  []string `json:"input_modalities"`
 	OutputModalities []string `json:"output_modalities"`
 }
 
 type OpenRouterPricing struct {
-	Prompt     string `json:"prompt"`
-	Completion string `json:"completion"`
+	Prompt            string `json:"prompt"`
+	Completion        string `json:"completion"`
+	InputCacheRead    string `json:"input_cache_read"`
+	InputCacheWrite   string `json:"input_cache_write"`
+	InternalReasoning string `json:"internal_reasoning"`
+	Image             string `json:"image"`
+	Audio             string `json:"audio"`
+	Request           string `json:"request"`
+	WebSearch         string `json:"web_search"`
 }
 
 type OpenRouterResponse struct {
@@ -56,16 +75,91 @@ type RegistryData struct {
 	Models map[string]ModelRegistry `yaml:"models"`
 }
 type ModelRegistry struct {
-	ID            string   `yaml:"id"`
-	Name          string   `yaml:"name"`
-	NameCN        string   `yaml:"name_cn,omitempty"`
-	Provider      string   `yaml:"provider"`
-	Description   string   `yaml:"description,omitempty"`
-	DescriptionCN string   `yaml:"description_cn,omitempty"`
-	ContextLen    int      `yaml:"context_length"`
-	MaxOutput     int      `yaml:"max_output,omitempty"`
-	Features      []string `yaml:"features,omitempty"`
-	Aliases       []string `yaml:"aliases,omitempty"`
+	ID            string                `yaml:"id"`
+	Name          string                `yaml:"name"`
+	NameCN        string                `yaml:"name_cn,omitempty"`
+	Provider      string                `yaml:"provider"`
+	Description   string                `yaml:"description,omitempty"`
+	DescriptionCN string                `yaml:"description_cn,omitempty"`
+	ContextLen    int                   `yaml:"context_length"`
+	MaxOutput     int                   `yaml:"max_output,omitempty"`
+	Features      []string              `yaml:"features,omitempty"`
+	Aliases       []string              `yaml:"aliases,omitempty"`
+	Pricing       Pricing               `yaml:"pricing,omitempty"`
+	Parameters    []string              `yaml:"supported_parameters,omitempty"`
+	Defaults      map[string]float64    `yaml:"default_parameters,omitempty"`
+	Ranges        map[string]ParamRange `yaml:"parameter_ranges,omitempty"`
+	Reasoning     Reasoning             `yaml:"reasoning,omitempty"`
+	Tokenizer     string                `yaml:"tokenizer,omitempty"`
+	InstructType  string                `yaml:"instruct_type,omitempty"`
+	// Source is "openrouter" for models maintained by sync. Such models are
+	// marked retired once they disappear from the API.
+	Source        string    `yaml:"source,omitempty"`
+	CanonicalSlug string    `yaml:"canonical_slug,omitempty"`
+	HuggingFaceID string    `yaml:"hugging_face_id,omitempty"`
+	ReleasedAt    time.Time `yaml:"released_at,omitempty"`
+	ExpiresAt     time.Time `yaml:"expires_at,omitempty"`
+	Status        string    `yaml:"status,omitempty"` // preview, deprecated or retired; empty means active
+	ReplacedBy    string    `yaml:"replaced_by,omitempty"`
+	// EquivalentTo lists interchangeable models, e.g. the same weights
+	// served by another host. It is maintained by hand.
+	EquivalentTo []string `yaml:"equivalent_to,omitempty"`
+	// Family, Version, SizeClass and SnapshotDate override the values
+	// parsed from the ID, for IDs that do not follow the usual patterns.
+	Family       string    `yaml:"family,omitempty"`
+	Version      string    `yaml:"version,omitempty"`
+	SizeClass    string    `yaml:"size_class,omitempty"`
+	SnapshotDate time.Time `yaml:"snapshot_date,omitempty"`
+	// ExactOnly keeps the model out of normalized lookup. Set it on one side
+	// of a normalized name collision between models of the same vendor,
+	// which otherwise fails generation. It is maintained by hand.
+	ExactOnly bool `yaml:"exact_only,omitempty"`
+	// Locked lists top-level keys that sync must not overwrite from the API,
+	// so manual values (e.g. negotiated pricing) survive the daily update.
+	Locked []string `yaml:"locked,omitempty"`
+}
+
+// Pricing mirrors OpenRouterPricing; values are USD decimal strings kept verbatim.
+type Pricing struct {
+	Prompt            string `yaml:"prompt,omitempty"`
+	Completion        string `yaml:"completion,omitempty"`
+	InputCacheRead    string `yaml:"input_cache_read,omitempty"`
+	InputCacheWrite   string `yaml:"input_cache_write,omitempty"`
+	InternalReasoning string `yaml:"internal_reasoning,omitempty"`
+	Image             string `yaml:"image,omitempty"`
+	Audio             string `yaml:"audio,omitempty"`
+	Request           string `yaml:"request,omitempty"`
+	WebSearch         string `yaml:"web_search,omitempty"`
+}
+
+// ParamRange is the accepted interval of a numeric request parameter.
+// It is maintained by hand; the API does not report ranges.
+type ParamRange struct {
+	Min float64 `yaml:"min"`
+	Max float64 `yaml:"max"`
+}
+
+// Reasoning holds thinking controls, all maintained by hand: the API only
+// reports that reasoning_effort is accepted, not which levels, so Efforts is
+// left empty rather than guessed.
+type Reasoning struct {
+	Efforts            []string `yaml:"efforts,omitempty"`
+	MinBudgetTokens    int      `yaml:"min_budget_tokens,omitempty"`
+	MaxBudgetTokens    int      `yaml:"max_budget_tokens,omitempty"`
+	CountsTowardOutput bool     `yaml:"counts_toward_output,omitempty"`
+}
+
+func (m ModelRegistry) isLocked(key string) bool {
+	return hasString(m.Locked, key)
+}
+
+func hasString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
 }
 
 func main() {
@@ -100,16 +194,64 @@ func main() {
 
 	// 5. Process for Code Generation
 	processedModels := make([]*ProcessedModel, 0)
+	exists := func(id string) bool {
+		_, ok := finalModels[id]
+		return ok
+	}
 	for id, m := range finalModels {
 		p := &ProcessedModel{
 			ID:            id,
 			Name:          m.Name,
+			NameCN:        m.NameCN,
 			Provider:      m.Provider,
 			Description:   m.Description,
 			DescriptionCN: m.DescriptionCN,
 			ContextLen:    m.ContextLen,
 			MaxOutput:     m.MaxOutput,
+			Pricing:       pricingLiteral(m.Pricing),
 			Aliases:       m.Aliases,
+			Parameters:    m.Parameters,
+			Defaults:      floatMapLiteral(m.Defaults),
+			Ranges:        rangesLiteral(m.Ranges),
+			Reasoning:     reasoningLiteral(m.Reasoning),
+			Tokenizer:     m.Tokenizer,
+			InstructType:  m.InstructType,
+			ReplacedBy:    m.ReplacedBy,
+			HuggingFaceID: m.HuggingFaceID,
+			EquivalentTo:  m.EquivalentTo,
+			Family:        m.Family,
+			Version:       m.Version,
+			SizeClass:     m.SizeClass,
+			ExactOnly:     m.ExactOnly,
+		}
+		parsed := modelid.ParseAmong(id, m.ReleasedAt, exists)
+		if p.Family == "" {
+			p.Family = parsed.Family
+		}
+		if p.Version == "" {
+			p.Version = parsed.Version
+		}
+		if p.SizeClass == "" {
+			p.SizeClass = parsed.Size
+		}
+		if snapshot := m.SnapshotDate; !snapshot.IsZero() {
+			p.SnapshotDate = snapshot.Unix()
+		} else if !parsed.Snapshot.IsZero() {
+			p.SnapshotDate = parsed.Snapshot.Unix()
+		}
+		if m.CanonicalSlug != id {
+			p.CanonicalSlug = m.CanonicalSlug
+		}
+		if !m.ReleasedAt.IsZero() {
+			p.ReleasedAt = m.ReleasedAt.Unix()
+		}
+		if !m.ExpiresAt.IsZero() {
+			p.ExpiresAt = m.ExpiresAt.Unix()
+		}
+		if st, ok := statusConst[m.Status]; ok {
+			p.Status = st
+		} else {
+			log.Printf("Warning: model %s has unknown status %q, treating as active", id, m.Status)
 		}
 		if len(m.Features) > 0 {
 			p.Features = strings.Join(m.Features, " | ")
@@ -171,24 +313,237 @@ func main() {
 		for _, alias := range p.Aliases {
 			lowerAlias := strings.ToLower(alias)
 			if existingID, ok := aliasMap[lowerAlias]; ok && existingID != p.ID {
-				// Log collision but keep existing (usually manual alias wins if it came first, but here it's processed order)
-				// We should probably sort processedModels by ID first to be deterministic.
+				// Models are sorted by ID, so the first claim wins deterministically
+				log.Printf("Warning: alias %q of %s is already used by %s, skipping", alias, p.ID, existingID)
 			} else {
 				aliasMap[lowerAlias] = p.ID
 			}
 		}
 	}
 
-	// 8. Generate Code
-	if err := generateCode(processedModels, aliasMap); err != nil {
+	// Link variants such as "vendor/model:free" to their base model
+	byID := make(map[string]*ProcessedModel, len(processedModels))
+	for _, p := range processedModels {
+		byID[p.ID] = p
+	}
+	for _, p := range processedModels {
+		baseID, variant := splitVariant(p.ID)
+		if variant == "" {
+			continue
+		}
+		if base, exists := byID[baseID]; exists {
+			p.BaseVariant = baseID
+			base.Variants = append(base.Variants, p.ID)
+		}
+	}
+
+	// Make equivalent_to links symmetric, dropping links to unknown models
+	for _, p := range processedModels {
+		links := p.EquivalentTo
+		p.EquivalentTo = nil
+		for _, id := range links {
+			if _, exists := byID[id]; !exists || id == p.ID {
+				log.Printf("Warning: model %s declares unknown equivalent %q, skipping", p.ID, id)
+				continue
+			}
+			if !hasString(p.EquivalentTo, id) {
+				p.EquivalentTo = append(p.EquivalentTo, id)
+			}
+		}
+	}
+	for _, p := range processedModels {
+		for _, id := range p.EquivalentTo {
+			if other := byID[id]; !hasString(other.EquivalentTo, p.ID) {
+				other.EquivalentTo = append(other.EquivalentTo, p.ID)
+			}
+		}
+	}
+
+	// 8. Populate reverse indexes. Variants such as ":free" share the HF ID and
+	// canonical slug of their base model, so the base entry wins. A base whose
+	// slug is its own ID stores no slug, so it is seeded here: otherwise its
+	// variant would take the key.
+	hfMap := make(map[string]string)
+	canonicalMap := make(map[string]string)
+	ownSlugs := make(map[string]string) // lowercase ID -> ID, for models whose slug is their ID
+	for _, p := range processedModels {
+		if p.CanonicalSlug == "" {
+			ownSlugs[strings.ToLower(p.ID)] = p.ID
+		}
+	}
+	for _, p := range processedModels {
+		if p.HuggingFaceID != "" {
+			key := strings.ToLower(p.HuggingFaceID)
+			if existing, ok := hfMap[key]; !ok || preferID(p.ID, existing) {
+				hfMap[key] = p.ID
+			}
+		}
+		if p.CanonicalSlug != "" {
+			key := strings.ToLower(p.CanonicalSlug)
+			if id, ok := ownSlugs[key]; ok {
+				canonicalMap[key] = id
+			} else if existing, ok := canonicalMap[key]; !ok || preferID(p.ID, existing) {
+				canonicalMap[key] = p.ID
+			}
+		}
+	}
+
+	// Index normalized IDs, aliases and canonical slugs (see modelid.Normalize).
+	// Keys shared by several models are kept with all of them, so lookups
+	// report the ambiguity instead of picking one.
+	normalizedMap := make(map[string][]string)
+	looseMap := make(map[string][]string)
+	for _, p := range processedModels {
+		if p.ExactOnly {
+			continue
+		}
+		names := append([]string{p.ID}, p.Aliases...)
+		if _, variant := splitVariant(p.ID); variant == "" && p.CanonicalSlug != "" {
+			// Variants share the canonical slug of their base model
+			names = append(names, p.CanonicalSlug)
+		}
+		for _, name := range names {
+			for i, key := range modelid.Normalize(name).Keys {
+				index := normalizedMap
+				if i > 0 {
+					index = looseMap
+				}
+				if !hasString(index[key], p.ID) {
+					index[key] = append(index[key], p.ID)
+				}
+			}
+		}
+	}
+	if err := checkCollisions(normalizedMap, looseMap); err != nil {
+		log.Fatalf("Normalized name collision: %v", err)
+	}
+
+	// 9. Build bitmap indexes over the ID-sorted model list, so queries can
+	// filter by provider and capability with bitset intersections.
+	ids := make([]string, len(processedModels))
+	providerBits := make(map[string][]uint64)
+	capabilityBits := make(map[string][]uint64)
+	words := (len(processedModels) + 63) / 64
+	setBit := func(index map[string][]uint64, key string, i int) {
+		if index[key] == nil {
+			index[key] = make([]uint64, words)
+		}
+		index[key][i/64] |= 1 << (i % 64)
+	}
+	for i, p := range processedModels {
+		ids[i] = p.ID
+		setBit(providerBits, strings.ToLower(p.Provider), i)
+		for _, c := range strings.Split(p.Features, " | ") {
+			if c != "0" {
+				setBit(capabilityBits, c, i)
+			}
+		}
+	}
+
+	// 10. Generate Code
+	if err := generateCode(processedModels, Indexes{
+		Alias:       aliasMap,
+		HuggingFace: hfMap,
+		Canonical:   canonicalMap,
+		IDs:         ids,
+		Provider:    bitsetLiterals(providerBits),
+		Capability:  bitsetLiterals(capabilityBits),
+		Normalized:  normalizedMap,
+		Loose:       looseMap,
+	}); err != nil {
 		log.Fatalf("Failed to generate code: %v", err)
 	}
 
 	log.Println("Generator finished successfully.")
 }
 
+// Indexes are the lookup maps emitted next to the model table.
+// Keys are lowercase; values are model IDs.
+type Indexes struct {
+	Alias       map[string]string
+	HuggingFace map[string]string
+	Canonical   map[string]string
+
+	// IDs lists the model IDs in ascending order. Provider and Capability
+	// map lowercase provider names and capability constants to Go bitset
+	// literals whose bit i refers to IDs[i].
+	IDs        []string
+	Provider   map[string]string
+	Capability map[string]string
+
+	// Normalized maps the strict modelid.Normalize keys of IDs, aliases and
+	// canonical slugs to the IDs of the models having them; Loose maps their
+	// looser keys. IDs are sorted.
+	Normalized map[string][]string
+	Loose      map[string][]string
+}
+
+// checkCollisions fails on a strict normalized key shared by several models,
+// so that every strict key names one model, whatever its vendor. It only
+// logs loose keys shared by several models, which Resolve reports as
+// ambiguous.
+func checkCollisions(normalized, loose map[string][]string) error {
+	keys := make([]string, 0, len(normalized)+len(loose))
+	for key := range normalized {
+		keys = append(keys, key)
+	}
+	for key := range loose {
+		if _, strict := normalized[key]; !strict {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var errs []error
+	for _, key := range keys {
+		ids, strict := normalized[key], true
+		if ids == nil {
+			ids, strict = loose[key], false
+		}
+		if len(ids) < 2 {
+			continue
+		}
+		if !strict {
+			log.Printf("Note: loose name %q is ambiguous between %s", key, strings.Join(ids, ", "))
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%q is shared by %s; set exact_only on all but one", key, strings.Join(ids, ", ")))
+	}
+	return errors.Join(errs...)
+}
+
+// bitsetLiterals renders bitsets as Go literals, dropping trailing zero words.
+func bitsetLiterals(index map[string][]uint64) map[string]string {
+	out := make(map[string]string, len(index))
+	for key, set := range index {
+		for len(set) > 0 && set[len(set)-1] == 0 {
+			set = set[:len(set)-1]
+		}
+		parts := make([]string, len(set))
+		for i, w := range set {
+			parts[i] = fmt.Sprintf("%#x", w)
+		}
+		out[key] = "{" + strings.Join(parts, ", ") + "}"
+	}
+	return out
+}
+
+// preferID reports whether candidate should replace current as the target of
+// a shared index key: base models beat variants, then shorter IDs win.
+func preferID(candidate, current string) bool {
+	cv, uv := strings.Contains(candidate, ":"), strings.Contains(current, ":")
+	if cv != uv {
+		return !cv
+	}
+	if len(candidate) != len(current) {
+		return len(candidate) < len(current)
+	}
+	return candidate < current
+}
+
 func syncToDisk(apiModels []OpenRouterModel, localModels map[string]ModelRegistry) error {
+	seen := make(map[string]bool, len(apiModels))
 	for _, m := range apiModels {
+		seen[m.ID] = true
 		local, _ := localModels[m.ID]
 
 		// Update fields from API
@@ -198,6 +553,51 @@ func syncToDisk(apiModels []OpenRouterModel, localModels map[string]ModelRegistr
 		local.ContextLen = m.ContextLength
 		local.MaxOutput = m.TopProvider.MaxCompletionTokens
 		local.Provider = normalizeProvider(strings.Split(m.ID, "/")[0])
+		if !local.isLocked("pricing") {
+			local.Pricing = Pricing(m.Pricing)
+		}
+		if !local.isLocked("supported_parameters") {
+			local.Parameters = m.SupportedParameters
+		}
+		if !local.isLocked("tokenizer") {
+			local.Tokenizer = m.Architecture.Tokenizer
+		}
+		if !local.isLocked("instruct_type") {
+			local.InstructType = m.Architecture.InstructType
+		}
+		if !local.isLocked("canonical_slug") {
+			local.CanonicalSlug = m.CanonicalSlug
+		}
+		if !local.isLocked("hugging_face_id") {
+			local.HuggingFaceID = m.HuggingFaceID
+		}
+
+		// Lifecycle
+		local.Source = "openrouter"
+		if !local.isLocked("released_at") && m.Created > 0 {
+			local.ReleasedAt = time.Unix(m.Created, 0).UTC()
+		}
+		if !local.isLocked("expires_at") {
+			local.ExpiresAt = time.Time{}
+			if t, err := time.Parse("2006-01-02", m.ExpirationDate); err == nil {
+				local.ExpiresAt = t
+			}
+		}
+		if !local.isLocked("status") {
+			local.Status = deriveStatus(local)
+		}
+		if !local.isLocked("default_parameters") {
+			local.Defaults = nil
+			for k, v := range m.DefaultParameters {
+				if v == nil {
+					continue
+				}
+				if local.Defaults == nil {
+					local.Defaults = make(map[string]float64)
+				}
+				local.Defaults[k] = *v
+			}
+		}
 
 		// Derived features from API (only if local features are empty)
 		if len(local.Features) == 0 {
@@ -218,20 +618,66 @@ func syncToDisk(apiModels []OpenRouterModel, localModels map[string]ModelRegistr
 			}
 		}
 
+		// Reasoning support is derived on every sync, since it was added after
+		// most local feature lists had been written.
+		if isReasoningModel(m) && !local.isLocked("features") && !hasString(local.Features, "CapReasoning") {
+			local.Features = append(local.Features, "CapReasoning")
+			sort.Strings(local.Features)
+		}
+
 		// Save back to disk
 		if err := saveModelToDisk(local); err != nil {
 			log.Printf("Error saving model %s: %v", m.ID, err)
 		}
 	}
+
+	// Retire synced models that are gone from the API
+	for id, local := range localModels {
+		if seen[id] || local.Source != "openrouter" || local.Status == "retired" || local.isLocked("status") {
+			continue
+		}
+		log.Printf("Model %s disappeared upstream, marking as retired", id)
+		local.Status = "retired"
+		if err := saveModelToDisk(local); err != nil {
+			log.Printf("Error saving model %s: %v", id, err)
+		}
+	}
 	return nil
 }
 
+var previewPattern = regexp.MustCompile(`(^|[-:/])(preview|beta|exp|experimental)([-:]|$)`)
+
+// deriveStatus computes the lifecycle status of a model present in the API.
+// Manual deprecations are kept; an expiration date always means deprecated.
+func deriveStatus(m ModelRegistry) string {
+	switch {
+	case !m.ExpiresAt.IsZero():
+		return "deprecated"
+	case m.Status == "retired":
+		// Back upstream
+		return ""
+	case m.Status == "" && previewPattern.MatchString(m.ID):
+		return "preview"
+	}
+	return m.Status
+}
+
+// statusConst maps YAML status names to Go constants.
+var statusConst = map[string]string{
+	"":           "",
+	"active":     "",
+	"preview":    "StatusPreview",
+	"deprecated": "StatusDeprecated",
+	"retired":    "StatusRetired",
+}
+
 func saveModelToDisk(m ModelRegistry) error {
 	parts := strings.SplitN(m.ID, "/", 2)
 	if len(parts) != 2 {
 		return fmt.Errorf("invalid model ID: %s", m.ID)
 	}
 	provider := parts[0]
+	// Variants are stored next to their base model as "<base>_<variant>.yaml"
 	modelName := parts[1]
 	safeModelName := strings.ReplaceAll(modelName, ":", "_")
 	safeModelName = strings.ReplaceAll(safeModelName, "/", "_")
@@ -253,18 +699,45 @@ func saveModelToDisk(m ModelRegistry) error {
 	return os.WriteFile(filePath, buf.Bytes(), 0644)
 }
 
+// splitVariant splits an OpenRouter ID such as "qwen/qwen3-coder:free" into
+// its base ID and variant suffix. The variant is empty for base models.
+func splitVariant(id string) (base, variant string) {
+	base, variant, _ = strings.Cut(id, ":")
+	return base, variant
+}
+
 type ProcessedModel struct {
 	ID            string
 	Name          string
+	NameCN        string
 	Provider      string
 	Description   string
 	DescriptionCN string
 	ContextLen    int
 	MaxOutput     int
-	PriceIn       float64
-	PriceOut      float64
+	Pricing       string // Go literal for template
 	Features      string // String representation for template
 	Aliases       []string
+	Parameters    []string
+	Defaults      string // Go literal for template, empty if none
+	Ranges        string // Go literal for template, empty if none
+	Reasoning     string // Go literal for template, empty if none
+	Tokenizer     string
+	InstructType  string
+	ReleasedAt    int64
+	ExpiresAt     int64
+	Status        string // Go constant name, empty for active
+	ReplacedBy    string
+	CanonicalSlug string
+	HuggingFaceID string
+	BaseVariant   string
+	Variants      []string
+	EquivalentTo  []string
+	ExactOnly     bool
+	Family        string
+	Version       string
+	SizeClass     string
+	SnapshotDate  int64
 }
 
 func calculateFeatures(m OpenRouterModel) string {
@@ -318,6 +791,10 @@ func calculateFeatures(m OpenRouterModel) string {
 		features = append(features, "CapFunctionCall")
 	}
 
+	if isReasoningModel(m) {
+		features = append(features, "CapReasoning")
+	}
+
 	// JSON Mode / Structured Outputs
 	for _, p := range m.SupportedParameters {
 		if p == "response_format" || p == "structured_outputs" {
@@ -353,6 +830,90 @@ func calculateFeatures(m OpenRouterModel) string {
 	return strings.Join(uniqueFeatures, " | ")
 }
 
+// isReasoningModel reports whether the API lets callers control or read reasoning tokens.
+func isReasoningModel(m OpenRouterModel) bool {
+	return hasString(m.SupportedParameters, "reasoning") || hasString(m.SupportedParameters, "include_reasoning")
+}
+
+// reasoningLiteral renders r as a ReasoningConfig literal, or "" if r is empty.
+func reasoningLiteral(r Reasoning) string {
+	var parts []string
+	if len(r.Efforts) > 0 {
+		parts = append(parts, fmt.Sprintf("Efforts: %#v", r.Efforts))
+	}
+	if r.MinBudgetTokens > 0 {
+		parts = append(parts, fmt.Sprintf("MinBudgetTokens: %d", r.MinBudgetTokens))
+	}
+	if r.MaxBudgetTokens > 0 {
+		parts = append(parts, fmt.Sprintf("MaxBudgetTokens: %d", r.MaxBudgetTokens))
+	}
+	if r.CountsTowardOutput {
+		parts = append(parts, "CountsTowardOutput: true")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "ReasoningConfig{" + strings.Join(parts, ", ") + "}"
+}
+
+// pricingLiteral renders p as a Pricing composite literal, listing only known prices.
+func pricingLiteral(p Pricing) string {
+	fields := []struct{ name, val string }{
+		{"Prompt", p.Prompt},
+		{"Completion", p.Completion},
+		{"InputCacheRead", p.InputCacheRead},
+		{"InputCacheWrite", p.InputCacheWrite},
+		{"InternalReasoning", p.InternalReasoning},
+		{"Image", p.Image},
+		{"Audio", p.Audio},
+		{"Request", p.Request},
+		{"WebSearch", p.WebSearch},
+	}
+	var parts []string
+	for _, f := range fields {
+		if f.val != "" {
+			parts = append(parts, fmt.Sprintf("%s: %q", f.name, f.val))
+		}
+	}
+	return "Pricing{" + strings.Join(parts, ", ") + "}"
+}
+
+// floatMapLiteral renders m as a map[string]float64 literal with sorted keys.
+func floatMapLiteral(m map[string]float64) string {
+	if len(m) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = fmt.Sprintf("%q: %s", k, strconv.FormatFloat(m[k], 'g', -1, 64))
+	}
+	return "map[string]float64{" + strings.Join(parts, ", ") + "}"
+}
+
+// rangesLiteral renders m as a map[string]ParamRange literal with sorted keys.
+func rangesLiteral(m map[string]ParamRange) string {
+	if len(m) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		r := m[k]
+		parts[i] = fmt.Sprintf("%q: {Min: %s, Max: %s}", k,
+			strconv.FormatFloat(r.Min, 'g', -1, 64), strconv.FormatFloat(r.Max, 'g', -1, 64))
+	}
+	return "map[string]ParamRange{" + strings.Join(parts, ", ") + "}"
+}
+
 func normalizeProvider(idPrefix string) string {
 	lower := strings.ToLower(idPrefix)
 	switch lower {
@@ -397,26 +958,125 @@ func init() {
 		"{{ .ID }}": {
 			IDVal:         "{{ .ID }}",
 			NameVal:       "{{ .Name }}",
+			{{- if .NameCN }}
+			NameCNVal:     {{ printf "%q" .NameCN }},
+			{{- end }}
 			ProviderVal:   "{{ .Provider }}",
 			DescVal:       {{ printf "%q" .Description }},
 			DescCNVal:     {{ printf "%q" .DescriptionCN }},
 			ContextLenVal: {{ .ContextLen }},
 			MaxOutputVal:  {{ .MaxOutput }},
+			PricingVal:    {{ .Pricing }},
 			FeaturesVal:   {{ .Features }},
 			AliasList:     []string{ {{ range $i, $alias := .Aliases }}{{ if $i }}, {{ end }}"{{ $alias }}"{{ end }} },
+			ParamList:     []string{ {{ range $i, $p := .Parameters }}{{ if $i }}, {{ end }}"{{ $p }}"{{ end }} },
+			{{- if .Tokenizer }}
+			TokenizerVal:  "{{ .Tokenizer }}",
+			{{- end }}
+			{{- if .InstructType }}
+			InstructVal:   "{{ .InstructType }}",
+			{{- end }}
+			{{- if .Defaults }}
+			DefaultParams: {{ .Defaults }},
+			{{- end }}
+			{{- if .Ranges }}
+			ParamRanges:   {{ .Ranges }},
+			{{- end }}
+			{{- if .Reasoning }}
+			ReasoningVal:  {{ .Reasoning }},
+			{{- end }}
+			{{- if .ReleasedAt }}
+			ReleasedVal:   {{ .ReleasedAt }},
+			{{- end }}
+			{{- if .ExpiresAt }}
+			ExpiresVal:    {{ .ExpiresAt }},
+			{{- end }}
+			{{- if .Status }}
+			StatusVal:     {{ .Status }},
+			{{- end }}
+			{{- if .ReplacedBy }}
+			ReplacedByVal: "{{ .ReplacedBy }}",
+			{{- end }}
+			{{- if .CanonicalSlug }}
+			CanonicalVal:  "{{ .CanonicalSlug }}",
+			{{- end }}
+			{{- if .HuggingFaceID }}
+			HFIDVal:       "{{ .HuggingFaceID }}",
+			{{- end }}
+			{{- if .BaseVariant }}
+			BaseVal:       "{{ .BaseVariant }}",
+			{{- end }}
+			{{- if .Variants }}
+			VariantList:   []string{ {{ range $i, $v := .Variants }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }} },
+			{{- end }}
+			FamilyVal:     "{{ .Family }}",
+			{{- if .Version }}
+			VersionVal:    "{{ .Version }}",
+			{{- end }}
+			{{- if .SizeClass }}
+			SizeVal:       "{{ .SizeClass }}",
+			{{- end }}
+			{{- if .SnapshotDate }}
+			SnapshotVal:   {{ .SnapshotDate }},
+			{{- end }}
+			{{- if .EquivalentTo }}
+			EquivList:     []string{ {{ range $i, $e := .EquivalentTo }}{{ if $i }}, {{ end }}"{{ $e }}"{{ end }} },
+			{{- end }}
 		},
 		{{- end }}
 	}
 
 	aliasIndex = map[string]string{
-		{{- range $alias, $id := .AliasMap }}
+		{{- range $alias, $id := .Indexes.Alias }}
 		"{{ $alias }}": "{{ $id }}",
 		{{- end }}
 	}
+
+	huggingFaceIndex = map[string]string{
+		{{- range $hf, $id := .Indexes.HuggingFace }}
+		"{{ $hf }}": "{{ $id }}",
+		{{- end }}
+	}
+
+	canonicalIndex = map[string]string{
+		{{- range $slug, $id := .Indexes.Canonical }}
+		"{{ $slug }}": "{{ $id }}",
+		{{- end }}
+	}
+
+	normalizedIndex = map[string][]string{
+		{{- range $key, $ids := .Indexes.Normalized }}
+		"{{ $key }}": { {{ range $i, $id := $ids }}{{ if $i }}, {{ end }}"{{ $id }}"{{ end }} },
+		{{- end }}
+	}
+
+	looseIndex = map[string][]string{
+		{{- range $key, $ids := .Indexes.Loose }}
+		"{{ $key }}": { {{ range $i, $id := $ids }}{{ if $i }}, {{ end }}"{{ $id }}"{{ end }} },
+		{{- end }}
+	}
+
+	staticIDs = []string{
+		{{- range .Indexes.IDs }}
+		"{{ . }}",
+		{{- end }}
+	}
+
+	providerIndex = map[string]bitset{
+		{{- range $provider, $bits := .Indexes.Provider }}
+		"{{ $provider }}": {{ $bits }},
+		{{- end }}
+	}
+
+	capabilityIndex = map[Capability]bitset{
+		{{- range $cap, $bits := .Indexes.Capability }}
+		{{ $cap }}: {{ $bits }},
+		{{- end }}
+	}
 }
 `
 
-func generateCode(models []*ProcessedModel, aliasMap map[string]string) error {
+func generateCode(models []*ProcessedModel, idx Indexes) error {
 	tmpl, err := template.New("gen").Parse(modelTemplate)
 	if err != nil {
 		return err
@@ -431,11 +1091,11 @@ func generateCode(models []*ProcessedModel, aliasMap map[string]string) error {
 	data := struct {
 		GeneratedAt string
 		Models      []*ProcessedModel
-		AliasMap    map[string]string
+		Indexes     Indexes
 	}{
 		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
 		Models:      models,
-		AliasMap:    aliasMap,
+		Indexes:     idx,
 	}
 
 	return tmpl.Execute(f, data)
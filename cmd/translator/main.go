@@ -30,6 +30,10 @@ type ModelRegistry struct {
 	Features      []string `yaml:"features,omitempty"`
 	Aliases       []string `yaml:"aliases,omitempty"`
 
+	// Extra keeps the keys this tool does not touch (pricing, locked, ...)
+	// so rewriting a file never drops generator-managed data.
+	Extra map[string]interface{} `yaml:",inline"`
+
 	// Internal helper
 	filePath string `yaml:"-"`
 }
@@ -0,0 +1,148 @@
+package llmspecs
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	// ErrNoPricing is returned when a model has no price for a billed item.
+	ErrNoPricing = errors.New("llmspecs: model has no pricing")
+	// ErrVariablePrice is returned when a billed item is priced per request
+	// by the upstream router and cannot be estimated ahead of time.
+	ErrVariablePrice = errors.New("llmspecs: price is decided per request")
+	// ErrInvalidUsage is returned for a Usage with a negative count.
+	ErrInvalidUsage = errors.New("llmspecs: invalid usage")
+)
+
+// Usage counts the billable units of one or more requests.
+// Token counts are disjoint: PromptTokens excludes cached tokens and
+// CompletionTokens excludes reasoning tokens.
+type Usage struct {
+	PromptTokens     int64
+	CompletionTokens int64
+	CacheReadTokens  int64 // input tokens served from cache
+	CacheWriteTokens int64 // input tokens written to cache
+	ReasoningTokens  int64
+	Images           int64
+	AudioTokens      int64
+	Requests         int64
+	WebSearches      int64
+}
+
+// Cost is an itemized USD cost. Every amount is exact.
+type Cost struct {
+	Prompt     Price
+	Completion Price
+	CacheRead  Price
+	CacheWrite Price
+	Reasoning  Price
+	Image      Price
+	Audio      Price
+	Request    Price
+	WebSearch  Price
+	Total      Price
+}
+
+// Add returns the item-wise sum of c and o.
+func (c Cost) Add(o Cost) Cost {
+	return Cost{
+		Prompt:     c.Prompt.Add(o.Prompt),
+		Completion: c.Completion.Add(o.Completion),
+		CacheRead:  c.CacheRead.Add(o.CacheRead),
+		CacheWrite: c.CacheWrite.Add(o.CacheWrite),
+		Reasoning:  c.Reasoning.Add(o.Reasoning),
+		Image:      c.Image.Add(o.Image),
+		Audio:      c.Audio.Add(o.Audio),
+		Request:    c.Request.Add(o.Request),
+		WebSearch:  c.WebSearch.Add(o.WebSearch),
+		Total:      c.Total.Add(o.Total),
+	}
+}
+
+// EstimateCost prices u with the pricing of m.
+// Cache and reasoning tokens fall back to the prompt and completion price
+// when the model has no dedicated price for them, matching how providers
+// bill models without a discount. Requests and web searches without a price
+// are not billed, since OpenRouter omits zero prices for them; a missing
+// token, image or audio price fails with ErrNoPricing. Negative counts fail
+// with ErrInvalidUsage.
+func EstimateCost(m Model, u Usage) (Cost, error) {
+	if m == nil {
+		return Cost{}, ErrNoPricing
+	}
+	if err := u.validate(); err != nil {
+		return Cost{}, err
+	}
+	p := m.Pricing()
+
+	var c Cost
+	items := []struct {
+		dst   *Price
+		count int64
+		price Price
+		name  string
+	}{
+		{&c.Prompt, u.PromptTokens, p.Prompt, "prompt"},
+		{&c.Completion, u.CompletionTokens, p.Completion, "completion"},
+		{&c.CacheRead, u.CacheReadTokens, orPrice(p.InputCacheRead, p.Prompt), "input_cache_read"},
+		{&c.CacheWrite, u.CacheWriteTokens, orPrice(p.InputCacheWrite, p.Prompt), "input_cache_write"},
+		{&c.Reasoning, u.ReasoningTokens, orPrice(p.InternalReasoning, p.Completion), "internal_reasoning"},
+		{&c.Image, u.Images, p.Image, "image"},
+		{&c.Audio, u.AudioTokens, p.Audio, "audio"},
+		{&c.Request, u.Requests, orPrice(p.Request, "0"), "request"},
+		{&c.WebSearch, u.WebSearches, orPrice(p.WebSearch, "0"), "web_search"},
+	}
+
+	total := new(big.Rat)
+	for _, it := range items {
+		if it.count == 0 {
+			*it.dst = "0"
+			continue
+		}
+		unit, ok := it.price.Rat()
+		if !ok {
+			return Cost{}, fmt.Errorf("%w: %s has no %s price", ErrNoPricing, m.ID(), it.name)
+		}
+		if unit.Sign() < 0 {
+			return Cost{}, fmt.Errorf("%w: %s %s", ErrVariablePrice, m.ID(), it.name)
+		}
+		amount := unit.Mul(unit, new(big.Rat).SetInt64(it.count))
+		*it.dst = priceFromRat(amount)
+		total.Add(total, amount)
+	}
+	c.Total = priceFromRat(total)
+	return c, nil
+}
+
+// validate rejects negative counts, which would price as refunds.
+func (u Usage) validate() error {
+	counts := []struct {
+		name string
+		n    int64
+	}{
+		{"PromptTokens", u.PromptTokens},
+		{"CompletionTokens", u.CompletionTokens},
+		{"CacheReadTokens", u.CacheReadTokens},
+		{"CacheWriteTokens", u.CacheWriteTokens},
+		{"ReasoningTokens", u.ReasoningTokens},
+		{"Images", u.Images},
+		{"AudioTokens", u.AudioTokens},
+		{"Requests", u.Requests},
+		{"WebSearches", u.WebSearches},
+	}
+	for _, c := range counts {
+		if c.n < 0 {
+			return fmt.Errorf("%w: %s is %d", ErrInvalidUsage, c.name, c.n)
+		}
+	}
+	return nil
+}
+
+func orPrice(p, fallback Price) Price {
+	if p == "" {
+		return fallback
+	}
+	return p
+}
@@ -0,0 +1,109 @@
+package llmspecs
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEstimateCost(t *testing.T) {
+	m := &modelData{
+		IDVal: "test/priced",
+		PricingVal: Pricing{
+			Prompt:         "0.0000025",
+			Completion:     "0.00001",
+			InputCacheRead: "0.00000125",
+			Request:        "0.005",
+		},
+	}
+
+	c, err := EstimateCost(m, Usage{
+		PromptTokens:     1000,
+		CompletionTokens: 500,
+		CacheReadTokens:  2000,
+		ReasoningTokens:  100, // billed at the completion price
+		Requests:         1,
+	})
+	if err != nil {
+		t.Fatalf("EstimateCost() error = %v", err)
+	}
+
+	want := Cost{
+		Prompt:     "0.0025",
+		Completion: "0.005",
+		CacheRead:  "0.0025",
+		CacheWrite: "0",
+		Reasoning:  "0.001",
+		Image:      "0",
+		Audio:      "0",
+		Request:    "0.005",
+		WebSearch:  "0",
+		Total:      "0.016",
+	}
+	if c != want {
+		t.Errorf("EstimateCost() = %+v, want %+v", c, want)
+	}
+}
+
+func TestEstimateCost_Exact(t *testing.T) {
+	// 0.1 + 0.2 style drift must not accumulate across many requests.
+	m := &modelData{IDVal: "test/exact", PricingVal: Pricing{Prompt: "0.0000001", Completion: "0.0000002"}}
+
+	var sum Cost
+	for i := 0; i < 1000; i++ {
+		c, err := EstimateCost(m, Usage{PromptTokens: 1, CompletionTokens: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum = sum.Add(c)
+	}
+	if sum.Total != "0.0003" {
+		t.Errorf("Total = %s, want 0.0003", sum.Total)
+	}
+}
+
+func TestEstimateCost_Errors(t *testing.T) {
+	m := &modelData{IDVal: "test/text-only", PricingVal: Pricing{Prompt: "0.000001", Completion: "0.000002"}}
+	if _, err := EstimateCost(m, Usage{Images: 1}); !errors.Is(err, ErrNoPricing) {
+		t.Errorf("Expected ErrNoPricing for unpriced images, got %v", err)
+	}
+
+	// Per-request and per-search prices are omitted when zero
+	c, err := EstimateCost(m, Usage{PromptTokens: 10, Requests: 1, WebSearches: 2})
+	if err != nil || c.Request != "0" || c.WebSearch != "0" || c.Total != "0.00001" {
+		t.Errorf("Expected requests and searches unbilled, got %+v, %v", c, err)
+	}
+	gpt4o, _ := Get("openai/gpt-4o")
+	if _, err := EstimateCost(gpt4o, Usage{PromptTokens: 10, Requests: 1}); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	router := &modelData{IDVal: "test/router", PricingVal: Pricing{Prompt: "-1", Completion: "-1"}}
+	if _, err := EstimateCost(router, Usage{PromptTokens: 10}); !errors.Is(err, ErrVariablePrice) {
+		t.Errorf("Expected ErrVariablePrice for router pricing, got %v", err)
+	}
+
+	if _, err := EstimateCost(m, Usage{PromptTokens: 10, CompletionTokens: -5}); !errors.Is(err, ErrInvalidUsage) {
+		t.Errorf("Expected ErrInvalidUsage for a negative count, got %v", err)
+	}
+
+	if _, err := EstimateCost(nil, Usage{}); !errors.Is(err, ErrNoPricing) {
+		t.Errorf("Expected ErrNoPricing for nil model, got %v", err)
+	}
+}
+
+func TestPrice_Add(t *testing.T) {
+	tests := []struct {
+		a, b Price
+		want Price
+	}{
+		{"0.1", "0.2", "0.3"},
+		{"", "0.00000008333333333333334", "0.00000008333333333333334"},
+		{"1", "2", "3"},
+		{"", "", "0"},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Add(tt.b); got != tt.want {
+			t.Errorf("%q.Add(%q) = %q, want %q", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package llmspecs
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kingfs/go-llm-specs/internal/modelid"
+)
+
+// Latest returns the newest model of a family, so a config can track "the
+// newest gpt-4o" on purpose rather than pin a snapshot by accident.
+//
+// family is parsed like a model ID: "claude-sonnet" matches every Claude
+// Sonnet generation, "gpt-4" only GPT-4 snapshots, and "qwen3-32b" only
+// Qwen3 models of that size. An "-instruct" flavor of a model that exists on
+// its own is a family of its own, e.g. "gpt-3.5-turbo-instruct". A vendor
+// prefix such as "anthropic/" restricts the provider. Among the matches, the
+// highest Version wins, then a generally available model over a preview of
+// that version, then the latest snapshot or release date, then the shortest
+// ID. Variants, deprecated and retired models are skipped.
+func Latest(family string) (Model, bool) {
+	want := modelid.ParseAmong(family, time.Time{}, builtinID)
+	vendor, _, hasVendor := strings.Cut(family, "/")
+
+	var best Model
+	forEach(func(m Model) bool {
+		if s := m.Status(); m.Variant() != "" || s == StatusDeprecated || s == StatusRetired {
+			return true
+		}
+		if !strings.EqualFold(m.Family(), want.Family) {
+			return true
+		}
+		if want.Version != "" && modelid.CompareVersions(m.Version(), want.Version) != 0 {
+			return true
+		}
+		if want.Size != "" && !strings.EqualFold(m.SizeClass(), want.Size) {
+			return true
+		}
+		if hasVendor && !strings.HasPrefix(strings.ToLower(m.ID()), strings.ToLower(vendor)+"/") {
+			return true
+		}
+		if best == nil || newer(m, best) {
+			best = m
+		}
+		return true
+	})
+	return best, best != nil
+}
+
+// builtinID reports whether a built-in model has the ID id, under any vendor
+// if id has none. Like the generator and FromSpec, it decides flavor families
+// by exact IDs among built-in models only, never by a looser lookup.
+func builtinID(id string) bool {
+	if _, ok := staticRegistry[id]; ok || strings.Contains(id, "/") {
+		return ok
+	}
+	for full := range staticRegistry {
+		if _, short, ok := strings.Cut(full, "/"); ok && short == id {
+			return true
+		}
+	}
+	return false
+}
+
+// newer reports whether a is a later release of its family than b.
+func newer(a, b Model) bool {
+	if c := modelid.CompareVersions(a.Version(), b.Version()); c != 0 {
+		return c > 0
+	}
+	if pa, pb := a.Status() == StatusPreview, b.Status() == StatusPreview; pa != pb {
+		return pb
+	}
+	da, db := a.SnapshotDate(), b.SnapshotDate()
+	if da.IsZero() {
+		da = a.ReleasedAt()
+	}
+	if db.IsZero() {
+		db = b.ReleasedAt()
+	}
+	if !da.Equal(db) {
+		return da.After(db)
+	}
+	if len(a.ID()) != len(b.ID()) {
+		return len(a.ID()) < len(b.ID())
+	}
+	return a.ID() < b.ID()
+}
@@ -0,0 +1,88 @@
+package llmspecs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFamilyMetadata(t *testing.T) {
+	tests := []struct {
+		id, family, version, size string
+		snapshot                  string
+	}{
+		{"openai/gpt-4-0314", "gpt", "4", "", "2023-03-14"},
+		{"openai/gpt-4.1-mini", "gpt-mini", "4.1", "", ""},
+		{"qwen/qwen3-32b", "qwen", "3", "32B", ""},
+		{"anthropic/claude-3.7-sonnet", "claude-sonnet", "3.7", "", ""},
+		{"openai/gpt-4o-2024-08-06", "gpt-4o", "", "", "2024-08-06"},
+		// Overridden in models/
+		{"deepseek/deepseek-chat", "deepseek", "3", "", ""},
+		{"allenai/olmo-2-0325-32b-instruct", "olmo", "2", "32B", "2025-03-01"},
+	}
+	for _, tt := range tests {
+		m, ok := Get(tt.id)
+		if !ok {
+			t.Fatalf("Model %s not found", tt.id)
+		}
+		var snapshot string
+		if d := m.SnapshotDate(); !d.IsZero() {
+			snapshot = d.Format(time.DateOnly)
+		}
+		if m.Family() != tt.family || m.Version() != tt.version || m.SizeClass() != tt.size || snapshot != tt.snapshot {
+			t.Errorf("%s: got %q %q %q %q, want %q %q %q %q", tt.id, m.Family(), m.Version(), m.SizeClass(), snapshot,
+				tt.family, tt.version, tt.size, tt.snapshot)
+		}
+	}
+}
+
+func TestLatest(t *testing.T) {
+	tests := map[string]string{
+		"gpt-4o":                        "openai/gpt-4o-2024-11-20",
+		"claude-sonnet":                 "anthropic/claude-sonnet-4.5",
+		"anthropic/claude-opus":         "anthropic/claude-opus-4.5",
+		"gpt-4":                         "openai/gpt-4", // not the newer preview
+		"gpt-3.5-turbo-instruct":        "openai/gpt-3.5-turbo-instruct",
+		"openai/gpt-3.5-turbo-instruct": "openai/gpt-3.5-turbo-instruct",
+		"qwen3-32b":                     "qwen/qwen3-32b",
+		"deepseek":                      "deepseek/deepseek-v3.2",
+		"mistral-large":                 "mistralai/mistral-large-2512",
+		"CLAUDE-SONNET":                 "anthropic/claude-sonnet-4.5",
+		"google/claude-sonnet":          "",
+		"gpt-4o-mini":                   "openai/gpt-4o-mini", // released with its snapshot; the shorter ID wins
+		"no-such-family-at-all":         "",
+	}
+	for family, want := range tests {
+		m, ok := Latest(family)
+		if want == "" {
+			if ok {
+				t.Errorf("Latest(%q) = %s, want none", family, m.ID())
+			}
+			continue
+		}
+		if !ok {
+			t.Errorf("Latest(%q) found nothing, want %s", family, want)
+		} else if m.ID() != want {
+			t.Errorf("Latest(%q) = %s, want %s", family, m.ID(), want)
+		}
+	}
+}
+
+func TestLatest_Runtime(t *testing.T) {
+	// Runtime models are parsed from their ID, so they join their family
+	m := FromSpec(ModelSpec{ID: "anthropic/claude-sonnet-9", Provider: "Anthropic"})
+	if m.Family() != "claude-sonnet" || m.Version() != "9" {
+		t.Fatalf("Unexpected parse %q %q", m.Family(), m.Version())
+	}
+	if err := Register(m); err != nil {
+		t.Fatal(err)
+	}
+	defer Unregister(m.ID())
+	if got, _ := Latest("claude-sonnet"); got.ID() != m.ID() {
+		t.Errorf("Expected the runtime model, got %s", got.ID())
+	}
+
+	spec := ModelSpec{ID: "acme/odd-name", Family: "acme-chat", Version: "2"}
+	if m := FromSpec(spec); m.Family() != "acme-chat" || m.Version() != "2" {
+		t.Errorf("Spec fields should override the parsed ones, got %q %q", m.Family(), m.Version())
+	}
+}
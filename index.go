@@ -0,0 +1,201 @@
+package llmspecs
+
+import (
+	"math/bits"
+	"strings"
+	"sync"
+)
+
+// staticIDs lists the built-in model IDs in ascending order. Bit i of the
+// bitsets in providerIndex and capabilityIndex refers to staticIDs[i].
+// This will be populated in models_gen.go.
+var staticIDs []string
+
+// providerIndex maps lowercase provider names to the models they serve.
+// This will be populated in models_gen.go.
+var providerIndex = map[string]bitset{}
+
+// capabilityIndex maps single capability bits to the models that have them.
+// This will be populated in models_gen.go.
+var capabilityIndex = map[Capability]bitset{}
+
+// staticModels resolves staticIDs to their models once, on first use.
+var staticModels = sync.OnceValue(func() []*modelData {
+	models := make([]*modelData, len(staticIDs))
+	for i, id := range staticIDs {
+		models[i] = staticRegistry[id]
+	}
+	return models
+})
+
+// bitset is a set of positions in staticIDs. Missing trailing words are zero.
+type bitset []uint64
+
+func (s bitset) word(i int) uint64 {
+	if i < len(s) {
+		return s[i]
+	}
+	return 0
+}
+
+// and intersects s with t in place.
+func (s bitset) and(t bitset) {
+	for i := range s {
+		s[i] &= t.word(i)
+	}
+}
+
+// andNot removes the members of t from s in place.
+func (s bitset) andNot(t bitset) {
+	for i := range s {
+		s[i] &^= t.word(i)
+	}
+}
+
+// or adds the members of t to s in place.
+func (s bitset) or(t bitset) {
+	for i := range s {
+		s[i] |= t.word(i)
+	}
+}
+
+func (s bitset) count() int {
+	n := 0
+	for _, w := range s {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// capabilityBits stores in dst the models having every bit of c if all is
+// true, or any bit of c otherwise.
+func capabilityBits(dst bitset, c Capability, all bool) {
+	if all {
+		dst.fill()
+	} else {
+		clear(dst)
+	}
+	for c != 0 {
+		bit := c & -c
+		c &^= bit
+		if all {
+			dst.and(capabilityIndex[bit])
+		} else {
+			dst.or(capabilityIndex[bit])
+		}
+	}
+}
+
+// fill sets the positions of every built-in model.
+func (s bitset) fill() {
+	for i := range s {
+		s[i] = ^uint64(0)
+	}
+	if r := len(staticIDs) % 64; r != 0 && len(s) > 0 {
+		s[len(s)-1] = 1<<r - 1
+	}
+}
+
+// indexWords is the bitset length that fits on the stack; larger
+// registries fall back to the heap.
+const indexWords = 64
+
+// staticCandidates narrows the built-in models to those passing the
+// provider and capability filters, using the generated bitmap indexes.
+func (q *QueryBuilder) staticCandidates(buf *[2][indexWords]uint64) bitset {
+	n := (len(staticIDs) + 63) / 64
+	var cand, scratch bitset
+	if n <= indexWords {
+		cand, scratch = buf[0][:n], buf[1][:n]
+	} else {
+		cand, scratch = make(bitset, n), make(bitset, n)
+	}
+	cand.fill()
+
+	if len(q.providers) > 0 {
+		clear(scratch)
+		for _, p := range q.providers {
+			scratch.or(providerIndex[strings.ToLower(p)])
+		}
+		cand.and(scratch)
+	}
+	for _, p := range q.noProvider {
+		cand.andNot(providerIndex[strings.ToLower(p)])
+	}
+	if q.capability != 0 {
+		capabilityBits(scratch, q.capability, true)
+		cand.and(scratch)
+	}
+	if q.without != 0 {
+		capabilityBits(scratch, q.without, false)
+		cand.andNot(scratch)
+	}
+	for _, group := range q.anyOf {
+		capabilityBits(scratch, group, false)
+		cand.and(scratch)
+	}
+	return cand
+}
+
+// listIndexed collects matching models in ID order: built-in candidates
+// from the bitmap indexes, then runtime models. It reports whether the
+// result is already sorted, i.e. no runtime model was added.
+func (q *QueryBuilder) listIndexed() (results []Model, sorted bool) {
+	var buf [2][indexWords]uint64
+	cand := q.staticCandidates(&buf)
+	models := staticModels()
+
+	// Filters may call back into the registry, so they run against a
+	// snapshot of the runtime models rather than under a lock.
+	runtime := currentOverlay().models
+	hidden := len(runtime) > 0
+	// Without runtime models or an explicit order, matches arrive in final
+	// order and the scan can stop once the requested page is full.
+	stop := -1
+	if !hidden && len(q.order) == 0 && q.limit > 0 {
+		stop = q.offset + q.limit
+	}
+
+	size := cand.count()
+	if stop >= 0 && stop < size {
+		size = stop
+	}
+	results = make([]Model, 0, size)
+	residual := q.hasResidual()
+	for w, word := range cand {
+		for word != 0 {
+			i := w*64 + bits.TrailingZeros64(word)
+			word &= word - 1
+			m := models[i]
+			if hidden {
+				if _, ok := runtime[m.IDVal]; ok {
+					continue
+				}
+			}
+			if residual && !q.matchResidual(m) {
+				continue
+			}
+			results = append(results, m)
+			if len(results) == stop {
+				return results, true
+			}
+		}
+	}
+	for _, m := range runtime {
+		if q.match(m) {
+			results = append(results, m)
+		}
+	}
+	return results, !hidden
+}
+
+// hasResidual reports whether any filter without an index is set.
+func (q *QueryBuilder) hasResidual() bool {
+	return q.tokenizer != "" || q.instruct != "" || q.excluded != 0 ||
+		q.variant != "" || q.noVariants ||
+		q.minContext > 0 || q.maxContext > 0 || q.minOutput > 0 ||
+		q.maxInPrice >= 0 || q.maxOutPrice >= 0 ||
+		!q.releasedAfter.IsZero() || !q.releasedBefore.IsZero() ||
+		len(q.idPatterns) > 0 || len(q.namePatterns) > 0 ||
+		len(q.params) > 0 || len(q.where) > 0
+}
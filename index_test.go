@@ -0,0 +1,190 @@
+package llmspecs
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// buildIndexes computes the indexes the generator emits for a registry.
+func buildIndexes(reg map[string]*modelData) (ids []string, providers map[string]bitset, caps map[Capability]bitset) {
+	for id := range reg {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	words := (len(ids) + 63) / 64
+	providers = map[string]bitset{}
+	caps = map[Capability]bitset{}
+	for i, id := range ids {
+		m := reg[id]
+		key := strings.ToLower(m.ProviderVal)
+		if providers[key] == nil {
+			providers[key] = make(bitset, words)
+		}
+		providers[key][i/64] |= 1 << (i % 64)
+		for c := m.FeaturesVal; c != 0; c &= c - 1 {
+			bit := c & -c
+			if caps[bit] == nil {
+				caps[bit] = make(bitset, words)
+			}
+			caps[bit][i/64] |= 1 << (i % 64)
+		}
+	}
+	trim := func(s bitset) bitset {
+		for len(s) > 0 && s[len(s)-1] == 0 {
+			s = s[:len(s)-1]
+		}
+		return s
+	}
+	for k, s := range providers {
+		providers[k] = trim(s)
+	}
+	for k, s := range caps {
+		caps[k] = trim(s)
+	}
+	return ids, providers, caps
+}
+
+func TestGeneratedIndexes(t *testing.T) {
+	ids, providers, caps := buildIndexes(staticRegistry)
+	if !reflect.DeepEqual(staticIDs, ids) {
+		t.Error("staticIDs is out of date with staticRegistry; run the generator")
+	}
+	if !reflect.DeepEqual(providerIndex, providers) {
+		t.Error("providerIndex is out of date with staticRegistry; run the generator")
+	}
+	if !reflect.DeepEqual(capabilityIndex, caps) {
+		t.Error("capabilityIndex is out of date with staticRegistry; run the generator")
+	}
+}
+
+// scanList is the unindexed reference implementation of QueryBuilder.List.
+func scanList(q *QueryBuilder) []Model {
+	var results []Model
+	forEach(func(m Model) bool {
+		if q.match(m) {
+			results = append(results, m)
+		}
+		return true
+	})
+	q.sortModels(results)
+	return q.paginate(results)
+}
+
+// baselineList is QueryBuilder.List as it was before the bitmap indexes: an
+// unordered scan of staticRegistry that only filters by provider and
+// capabilities. It is the reference for BenchmarkQueryScale.
+func baselineList(q *QueryBuilder) []Model {
+	var results []Model
+	for _, m := range staticRegistry {
+		if len(q.providers) > 0 && !containsFold(q.providers, m.ProviderVal) {
+			continue
+		}
+		if m.FeaturesVal&q.capability != q.capability {
+			continue
+		}
+		results = append(results, m)
+	}
+	return results
+}
+
+func TestQuery_IndexedMatchesScan(t *testing.T) {
+	queries := map[string]func() *QueryBuilder{
+		"all":       Query,
+		"provider":  func() *QueryBuilder { return Query().Providers("openai", "Anthropic") },
+		"exclude":   func() *QueryBuilder { return Query().ExcludeProviders("OpenAI").Has(CapReasoning) },
+		"has":       func() *QueryBuilder { return Query().Has(ModalityImageIn | CapFunctionCall) },
+		"without":   func() *QueryBuilder { return Query().Has(CapChat).Without(CapReasoning | ModalityImageIn) },
+		"any":       func() *QueryBuilder { return Query().HasAny(ModalityImageIn | ModalityVideoIn).HasAny(CapJsonMode) },
+		"residual":  func() *QueryBuilder { return Query().Has(CapChat).MinContext(100000).ExcludeVariants() },
+		"unknown":   func() *QueryBuilder { return Query().Provider("Nobody").Has(CapTTS) },
+		"page":      func() *QueryBuilder { return Query().Has(CapFunctionCall).Offset(3).Limit(4) },
+		"ordered":   func() *QueryBuilder { return Query().Provider("Qwen").OrderByDesc(SortByContext).Limit(5) },
+		"beyond":    func() *QueryBuilder { return Query().Provider("OpenAI").Offset(1000).Limit(5) },
+		"predicate": func() *QueryBuilder { return Query().Where(func(m Model) bool { return len(m.ID()) < 16 }) },
+	}
+	check := func(t *testing.T) {
+		for name, q := range queries {
+			got, want := q().List(), scanList(q())
+			if !slices.Equal(modelIDs(got), modelIDs(want)) {
+				t.Errorf("%s: indexed %v, scan %v", name, modelIDs(got), modelIDs(want))
+			}
+		}
+	}
+	check(t)
+
+	// Runtime models hide built-in ones and join the results
+	if err := Override(&modelData{IDVal: "openai/gpt-4o", ProviderVal: "Acme", FeaturesVal: CapChat}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Register(&modelData{IDVal: "acme/aaa", ProviderVal: "OpenAI", FeaturesVal: CapFunctionCall | ModalityImageIn}); err != nil {
+		t.Fatal(err)
+	}
+	defer Unregister("openai/gpt-4o")
+	defer Unregister("acme/aaa")
+	check(t)
+}
+
+func modelIDs(models []Model) []string {
+	ids := make([]string, len(models))
+	for i, m := range models {
+		ids[i] = m.ID()
+	}
+	return ids
+}
+
+// withScaledRegistry replaces the built-in registry and its indexes with
+// factor copies of every model for the duration of a benchmark.
+func withScaledRegistry(b *testing.B, factor int) {
+	oldReg, oldIDs, oldProviders, oldCaps, oldModels := staticRegistry, staticIDs, providerIndex, capabilityIndex, staticModels
+	b.Cleanup(func() {
+		staticRegistry, staticIDs, providerIndex, capabilityIndex, staticModels = oldReg, oldIDs, oldProviders, oldCaps, oldModels
+	})
+
+	reg := make(map[string]*modelData, len(oldReg)*factor)
+	for id, m := range oldReg {
+		for i := 0; i < factor; i++ {
+			c := *m
+			if i > 0 {
+				c.IDVal = fmt.Sprintf("%s-copy%d", id, i)
+			}
+			reg[c.IDVal] = &c
+		}
+	}
+	staticRegistry = reg
+	staticIDs, providerIndex, capabilityIndex = buildIndexes(reg)
+	models := make([]*modelData, len(staticIDs))
+	for i, id := range staticIDs {
+		models[i] = reg[id]
+	}
+	staticModels = func() []*modelData { return models }
+}
+
+func BenchmarkQueryScale(b *testing.B) {
+	queries := map[string]func() *QueryBuilder{
+		"Provider":     func() *QueryBuilder { return Query().Provider("Anthropic") },
+		"Capabilities": func() *QueryBuilder { return Query().Has(ModalityImageIn).Has(CapFunctionCall) },
+		"Rare":         func() *QueryBuilder { return Query().Has(CapEmbedding) },
+	}
+	for _, factor := range []int{1, 10} {
+		for _, name := range []string{"Provider", "Capabilities", "Rare"} {
+			q := queries[name]
+			b.Run(fmt.Sprintf("%s/x%d/indexed", name, factor), func(b *testing.B) {
+				withScaledRegistry(b, factor)
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					q().List()
+				}
+			})
+			b.Run(fmt.Sprintf("%s/x%d/baseline", name, factor), func(b *testing.B) {
+				withScaledRegistry(b, factor)
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					baselineList(q())
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,219 @@
+// Package modelid parses the family, version, size and snapshot date that
+// model IDs such as "openai/gpt-4-0314" or "qwen/qwen3-32b" encode, and
+// normalizes model names for lookup.
+//
+// It is shared by the generator, which stores the parsed fields and
+// normalized keys in models_gen.go, and by the registry, which handles
+// runtime models and looked-up names the same way.
+package modelid
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parts are the fields parsed from a model ID.
+type Parts struct {
+	// Family is the product line without version, size, date stamps and
+	// "-instruct"-style tags, e.g. "gpt" for "openai/gpt-4-0314",
+	// "claude-sonnet" for "anthropic/claude-3.7-sonnet" or "gpt-4o-mini".
+	Family string
+	// Version is the first bare number of the ID, e.g. "4", "3.5" or "0.3"
+	// for "mistral-7b-instruct-v0.3", or "" if there is none.
+	Version string
+	// Size is the parameter count, e.g. "32B" or "8x22B".
+	Size string
+	// Snapshot is the date stamp of the ID, e.g. 2023-03-14 for
+	// "gpt-4-0314". Month-day stamps take their year from released and
+	// are ignored when released is zero; month-only stamps use the 1st.
+	Snapshot time.Time
+}
+
+var (
+	sizeRe     = regexp.MustCompile(`^(\d+x)?\d+(\.\d+)?[bm]$|^e\d+b$`) // 32b, 8x22b, 0.6b, e4b
+	activeRe   = regexp.MustCompile(`^a\d+(\.\d+)?b$`)                  // a22b active parameters
+	versionRe  = regexp.MustCompile(`^v?(\d+(\.\d+)*)$`)                // 4, 3.5, v0.3
+	gluedRe    = regexp.MustCompile(`^([a-z]{2,})(\d+(\.\d+)*)$`)       // qwen3, step3
+	revisionRe = regexp.MustCompile(`^0\d\d$`)                          // 001
+)
+
+// tags are dropped from the family; they name a flavor or a release stage,
+// not a different product.
+var tags = map[string]bool{
+	"instruct": true, "it": true, "preview": true, "latest": true, "exp": true, "beta": true,
+}
+
+// flavors are the tags naming a tuned flavor of a base model rather than a
+// release stage. ParseAmong keeps them in the family when the base model
+// exists on its own.
+var flavors = map[string]bool{"instruct": true, "it": true}
+
+// Parse parses id, e.g. "openai/gpt-4-1106-preview" or just "gpt-4o". The
+// vendor prefix and ":variant" suffix are ignored. released is the release
+// date of the model, used to date month-day stamps such as "0314".
+func Parse(id string, released time.Time) Parts {
+	return ParseAmong(id, released, nil)
+}
+
+// ParseAmong is like Parse, but keeps a flavor tag such as "-instruct" in
+// the family if exists reports that the ID without it names another model,
+// e.g. "gpt-3.5-turbo-instruct" next to "gpt-3.5-turbo". exists is called
+// with the lowercase ID, vendor prefix included; it may be nil.
+func ParseAmong(id string, released time.Time, exists func(id string) bool) Parts {
+	slug := strings.ToLower(id)
+	var vendor string
+	if i := strings.LastIndex(slug, "/"); i >= 0 {
+		vendor, slug = slug[:i+1], slug[i+1:]
+	}
+	slug, _, _ = strings.Cut(slug, ":")
+	tokens := strings.FieldsFunc(slug, func(r rune) bool { return r == '-' || r == '_' })
+	// distinct reports whether tokens[i] is a flavor of a separate base model.
+	distinct := func(i int) bool {
+		if exists == nil || !flavors[tokens[i]] {
+			return false
+		}
+		base := slices.Concat(tokens[:i:i], tokens[i+1:])
+		return exists(vendor + strings.Join(base, "-"))
+	}
+
+	var p Parts
+	var family []string
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		if i > 0 {
+			if date, n, ok := parseDate(tokens[i:], released); ok {
+				if !date.IsZero() {
+					p.Snapshot = date
+				}
+				i += n - 1
+				continue
+			}
+		}
+		switch {
+		case tags[t] && distinct(i):
+			family = append(family, t)
+		case tags[t] || activeRe.MatchString(t) || (i > 0 && revisionRe.MatchString(t)):
+		case sizeRe.MatchString(t):
+			if p.Size == "" {
+				p.Size = strings.Replace(strings.ToUpper(t), "X", "x", 1)
+			}
+		case p.Version == "" && i > 0 && versionRe.MatchString(t):
+			p.Version = versionRe.FindStringSubmatch(t)[1]
+		case p.Version != "" && t[0] == 'v' && versionRe.MatchString(t):
+			// A revision of a generation, as in "nova-2-lite-v1"
+		case p.Version == "" && gluedRe.MatchString(t):
+			m := gluedRe.FindStringSubmatch(t)
+			family = append(family, m[1])
+			p.Version = m[2]
+		default:
+			family = append(family, t)
+		}
+	}
+	p.Family = strings.Join(family, "-")
+	if p.Family == "" {
+		p.Family = slug
+	}
+	return p
+}
+
+// parseDate recognizes a date stamp at the start of tokens and returns the
+// number of tokens it spans. The date is zero if its year is unknown.
+func parseDate(tokens []string, released time.Time) (time.Time, int, bool) {
+	num := func(i, digits int) (int, bool) {
+		if i >= len(tokens) || len(tokens[i]) != digits {
+			return 0, false
+		}
+		n, err := strconv.Atoi(tokens[i])
+		return n, err == nil
+	}
+	if y, ok := num(0, 4); ok && y >= 2000 {
+		// 2024-05-13
+		if m, ok := num(1, 2); ok {
+			if d, ok := num(2, 2); ok && validDay(m, d) {
+				return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC), 3, true
+			}
+		}
+	}
+	if s := tokens[0]; len(s) == 8 {
+		// 20250514
+		if y, err := strconv.Atoi(s[:4]); err == nil && y >= 2000 {
+			m, _ := strconv.Atoi(s[4:6])
+			d, _ := strconv.Atoi(s[6:])
+			if validDay(m, d) {
+				return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC), 1, true
+			}
+		}
+	}
+	if m, ok := num(0, 2); ok {
+		// 08-2024 month and year, or 05-06 month and day
+		if y, ok := num(1, 4); ok && y >= 2000 && validDay(m, 1) {
+			return time.Date(y, time.Month(m), 1, 0, 0, 0, 0, time.UTC), 2, true
+		}
+		if d, ok := num(1, 2); ok && validDay(m, d) {
+			return inferYear(m, d, released), 2, true
+		}
+	}
+	if n, ok := num(0, 4); ok {
+		// 2411 year and month from 2023 on, otherwise 0314 month and day
+		hi, lo := n/100, n%100
+		if hi >= 23 && validDay(lo, 1) {
+			return time.Date(2000+hi, time.Month(lo), 1, 0, 0, 0, 0, time.UTC), 1, true
+		}
+		if validDay(hi, lo) {
+			return inferYear(hi, lo, released), 1, true
+		}
+	}
+	return time.Time{}, 0, false
+}
+
+func validDay(m, d int) bool {
+	return m >= 1 && m <= 12 && d >= 1 && d <= 31
+}
+
+// inferYear dates a month-day stamp in the year up to released, on the
+// assumption that snapshots are published around the time the model is
+// listed. A month of slack covers models listed just before their stamp.
+func inferYear(m, d int, released time.Time) time.Time {
+	if released.IsZero() {
+		return time.Time{}
+	}
+	t := time.Date(released.Year(), time.Month(m), d, 0, 0, 0, 0, time.UTC)
+	if t.After(released.AddDate(0, 1, 0)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+	return t
+}
+
+// CompareVersions compares dotted version numbers numerically, so
+// "3.10" > "3.9" and "4" == "4.0". The empty version sorts first.
+func CompareVersions(a, b string) int {
+	if a == "" || b == "" {
+		switch {
+		case a == b:
+			return 0
+		case a == "":
+			return -1
+		}
+		return 1
+	}
+	pa, pb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(pa), len(pb)); i++ {
+		var x, y int
+		if i < len(pa) {
+			x, _ = strconv.Atoi(pa[i])
+		}
+		if i < len(pb) {
+			y, _ = strconv.Atoi(pb[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,142 @@
+package modelid
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	released := time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC)
+	day := func(y, m, d int) time.Time { return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		id   string
+		want Parts
+	}{
+		{"openai/gpt-4-0314", Parts{Family: "gpt", Version: "4", Snapshot: day(2023, 3, 14)}},
+		{"openai/gpt-4-1106-preview", Parts{Family: "gpt", Version: "4", Snapshot: day(2023, 11, 6)}},
+		{"openai/gpt-4.1-mini", Parts{Family: "gpt-mini", Version: "4.1"}},
+		{"openai/gpt-4o-2024-08-06", Parts{Family: "gpt-4o", Snapshot: day(2024, 8, 6)}},
+		{"openai/gpt-4o-mini", Parts{Family: "gpt-4o-mini"}},
+		{"qwen/qwen3-32b", Parts{Family: "qwen", Version: "3", Size: "32B"}},
+		{"qwen/qwen3-30b-a3b-instruct-2507", Parts{Family: "qwen", Version: "3", Size: "30B", Snapshot: day(2025, 7, 1)}},
+		{"anthropic/claude-3.7-sonnet:thinking", Parts{Family: "claude-sonnet", Version: "3.7"}},
+		{"anthropic/claude-sonnet-4.5", Parts{Family: "claude-sonnet", Version: "4.5"}},
+		{"mistralai/mistral-7b-instruct-v0.3", Parts{Family: "mistral", Version: "0.3", Size: "7B"}},
+		{"mistralai/mixtral-8x22b-instruct", Parts{Family: "mixtral", Size: "8x22B"}},
+		{"cohere/command-r-08-2024", Parts{Family: "command-r", Snapshot: day(2024, 8, 1)}},
+		{"google/gemini-2.0-flash-001", Parts{Family: "gemini-flash", Version: "2.0"}},
+		{"google/gemma-3n-e4b-it", Parts{Family: "gemma-3n", Size: "E4B"}},
+		{"qwen/qwen3-embedding-0.6b", Parts{Family: "qwen-embedding", Version: "3", Size: "0.6B"}},
+		{"amazon/nova-2-lite-v1", Parts{Family: "nova-lite", Version: "2"}},
+		{"moonshotai/kimi-k2-0211", Parts{Family: "kimi-k2", Snapshot: day(2024, 2, 11)}},
+		{"gpt-4o", Parts{Family: "gpt-4o"}},
+		{"openrouter/auto", Parts{Family: "auto"}},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.id, released); got != tt.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.id, got, tt.want)
+		}
+	}
+
+	// Month-day stamps cannot be dated without a release date
+	if got := Parse("openai/gpt-4-0314", time.Time{}); !got.Snapshot.IsZero() || got.Family != "gpt" {
+		t.Errorf("Unexpected parts %+v", got)
+	}
+}
+
+func TestParseAmong(t *testing.T) {
+	ids := map[string]bool{"openai/gpt-3.5-turbo": true, "mistralai/mixtral-8x22b": true}
+	exists := func(id string) bool { return ids[id] }
+	tests := []struct {
+		id   string
+		want Parts
+	}{
+		// The base model exists, so the flavor is a product of its own
+		{"openai/gpt-3.5-turbo-instruct", Parts{Family: "gpt-turbo-instruct", Version: "3.5"}},
+		{"mistralai/mixtral-8x22b-instruct", Parts{Family: "mixtral-instruct", Size: "8x22B"}},
+		{"openai/gpt-3.5-turbo", Parts{Family: "gpt-turbo", Version: "3.5"}},
+		// Release stages are dropped either way
+		{"openai/gpt-3.5-turbo-preview", Parts{Family: "gpt-turbo", Version: "3.5"}},
+		{"meta-llama/llama-3.1-8b-instruct", Parts{Family: "llama", Version: "3.1", Size: "8B"}},
+	}
+	for _, tt := range tests {
+		if got := ParseAmong(tt.id, time.Time{}, exists); got != tt.want {
+			t.Errorf("ParseAmong(%q) = %+v, want %+v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"3.10", "3.9", 1},
+		{"4", "4.0", 0},
+		{"3.5", "4", -1},
+		{"", "1", -1},
+		{"", "", 0},
+	}
+	for _, tt := range tests {
+		if got := CompareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name   string
+		vendor string
+		keys   []string
+	}{
+		{"anthropic/claude-3.5-sonnet", "anthropic", []string{"claude35sonnet"}},
+		{"claude-3-5-sonnet-20241022", "", []string{"claude35sonnet20241022", "claude35sonnet"}},
+		{"Claude 3.5 Sonnet (latest)", "", []string{"claude35sonnetlatest", "claude35sonnet"}},
+		{"gpt4o", "", []string{"gpt4o"}},
+		{"openai/gpt-4o-2024-08-06", "openai", []string{"gpt4o20240806", "gpt4o"}},
+		{"openai/gpt-4-0314", "openai", []string{"gpt40314", "gpt4"}},
+		{"Qwen/Qwen3-32B-Instruct", "qwen", []string{"qwen332binstruct", "qwen332b"}},
+		{"qwen/qwen3-30b-a3b-instruct-2507", "qwen", []string{"qwen330ba3binstruct2507", "qwen330ba3binstruct", "qwen330ba3b"}},
+		{"gpt-5-chat-latest", "", []string{"gpt5chatlatest", "gpt5chat", "gpt5"}},
+		{"google/gemma-3-27b-it:free", "google", []string{"gemma327bit:free", "gemma327b:free"}},
+		{"Meta-Llama/Llama-3.1-8B-Instruct", "metallama", []string{"llama318binstruct", "llama318b"}},
+		{"chat", "", []string{"chat"}},
+		{" -- ", "", nil},
+	}
+	for _, tt := range tests {
+		got := Normalize(tt.name)
+		if got.Vendor != tt.vendor || !slices.Equal(got.Keys, tt.keys) {
+			t.Errorf("Normalize(%q) = %+v, want %q %q", tt.name, got, tt.vendor, tt.keys)
+		}
+	}
+	if n := Normalize("gpt-5-chat-latest"); n.Key() != "gpt5chatlatest" || n.Loose() != "gpt5" {
+		t.Errorf("Unexpected Key %q or Loose %q", n.Key(), n.Loose())
+	}
+}
+
+func TestAppendKey(t *testing.T) {
+	names := []string{
+		"anthropic/claude-3.5-sonnet", "Claude 3.5 Sonnet (latest)", "google/gemma-3-27b-it:free",
+		"Meta-Llama/Llama-3.1-8B-Instruct", "openai/gpt-4o:", "x/:free", "a/b/c-1", " -- ", "",
+		"Qwen/Qwen3-32B-Instruct", "mistralai/Ministral 8B ü", "ÉCLAIR/é-1:Beta",
+	}
+	for _, name := range names {
+		n := Normalize(name)
+		if got := string(AppendKey(nil, name)); got != n.Key() {
+			t.Errorf("AppendKey(%q) = %q, want %q", name, got, n.Key())
+		}
+		if got := string(AppendVendor(nil, name)); n.Key() != "" && got != n.Vendor {
+			t.Errorf("AppendVendor(%q) = %q, want %q", name, got, n.Vendor)
+		}
+	}
+	var buf [64]byte
+	allocs := testing.AllocsPerRun(100, func() {
+		AppendKey(buf[:0], "anthropic/claude-3-5-sonnet:beta")
+		AppendVendor(buf[:0], "anthropic/claude-3-5-sonnet:beta")
+	})
+	if allocs != 0 {
+		t.Errorf("AppendKey allocated %v times for an ASCII name", allocs)
+	}
+}
@@ -0,0 +1,169 @@
+package modelid
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Name is a model name reduced to lookup keys by Normalize.
+type Name struct {
+	Vendor string // the vendor prefix, e.g. "metallama"; "" if there is none
+	// Keys runs from strict to loose: Keys[0] is the name without vendor,
+	// case and punctuation, and each further key drops the date stamps or
+	// one more trailing tag. It is empty if the name has no letters or digits.
+	Keys []string
+}
+
+// Key returns the strict key of n, or "".
+func (n Name) Key() string {
+	if len(n.Keys) == 0 {
+		return ""
+	}
+	return n.Keys[0]
+}
+
+// Loose returns the loosest key of n, or "".
+func (n Name) Loose() string {
+	if len(n.Keys) == 0 {
+		return ""
+	}
+	return n.Keys[len(n.Keys)-1]
+}
+
+// looseTags are dropped from the end of a name for its looser keys; they name
+// the chat-tuned flavor or a moving pointer, which is what a bare name
+// usually means anyway.
+var looseTags = map[string]bool{
+	"instruct": true, "chat": true, "it": true, "latest": true,
+}
+
+// Normalize reduces a model name to the keys it is matched by, so that the
+// spellings vendors, tools and configs use for the same model agree:
+//
+//  1. Case is folded.
+//  2. The vendor prefix up to the last "/" is split off into Vendor, so
+//     "qwen/qwen3-32b" and "Qwen3-32B" share their keys.
+//  3. A ":variant" suffix such as ":free" is kept as is, since variants
+//     are distinct models.
+//  4. Separators and punctuation are dropped: "claude-3.5-sonnet",
+//     "claude-3-5-sonnet" and "Claude 3.5 Sonnet" all give "claude35sonnet",
+//     and "gpt-4o" and "gpt4o" give "gpt4o".
+//  5. For the looser keys, date stamps ("-20241022", "-2024-08-06", "-0314")
+//     are dropped, then trailing "-instruct", "-chat", "-it" and "-latest"
+//     tags one at a time, so "Qwen3-32B-Instruct" loosens to "qwen332b"
+//     like "qwen3-32b", and "gpt-5-chat-latest" to "gpt5chat" before "gpt5".
+//
+// Names differing only in what Normalize drops collide; callers must treat
+// a key shared by several models as ambiguous.
+func Normalize(name string) Name {
+	slug := strings.ToLower(strings.TrimSpace(name))
+	var n Name
+	if i := strings.LastIndex(slug, "/"); i >= 0 {
+		n.Vendor = compact(slug[:i])
+		slug = slug[i+1:]
+	}
+	var variant string
+	if base, v, ok := strings.Cut(slug, ":"); ok {
+		slug = base
+		if v = compact(v); v != "" {
+			variant = ":" + v
+		}
+	}
+
+	tokens := strings.FieldsFunc(slug, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(tokens) == 0 {
+		return Name{}
+	}
+	n.Keys = []string{strings.Join(tokens, "") + variant}
+
+	loose := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		if i > 0 {
+			if _, span, ok := parseDate(tokens[i:], time.Time{}); ok {
+				i += span - 1
+				continue
+			}
+		}
+		loose = append(loose, tokens[i])
+	}
+	if len(loose) < len(tokens) {
+		n.Keys = append(n.Keys, strings.Join(loose, "")+variant)
+	}
+	for len(loose) > 1 && looseTags[loose[len(loose)-1]] {
+		loose = loose[:len(loose)-1]
+		n.Keys = append(n.Keys, strings.Join(loose, "")+variant)
+	}
+	return n
+}
+
+// AppendKey appends the strict key of name, Normalize(name).Key(), to dst.
+// It does not allocate for ASCII names, so lookups can rule out a name
+// cheaply before normalizing it fully.
+func AppendKey(dst []byte, name string) []byte {
+	if !isASCII(name) {
+		return append(dst, Normalize(name).Key()...)
+	}
+	slug := name
+	if i := strings.LastIndexByte(slug, '/'); i >= 0 {
+		slug = slug[i+1:]
+	}
+	base, variant, _ := strings.Cut(slug, ":")
+	start := len(dst)
+	if dst = appendCompact(dst, base); len(dst) == start {
+		return dst
+	}
+	mark := len(dst)
+	if dst = appendCompact(append(dst, ':'), variant); len(dst) == mark+1 {
+		dst = dst[:mark]
+	}
+	return dst
+}
+
+// AppendVendor appends the vendor of name, Normalize(name).Vendor, to dst,
+// without allocating for ASCII names like AppendKey.
+func AppendVendor(dst []byte, name string) []byte {
+	if !isASCII(name) {
+		return append(dst, Normalize(name).Vendor...)
+	}
+	i := strings.LastIndexByte(name, '/')
+	if i < 0 {
+		return dst
+	}
+	return appendCompact(dst, name[:i])
+}
+
+// appendCompact appends the lowercased ASCII letters and digits of s.
+func appendCompact(dst []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case 'a' <= c && c <= 'z', '0' <= c && c <= '9':
+			dst = append(dst, c)
+		case 'A' <= c && c <= 'Z':
+			dst = append(dst, c+'a'-'A')
+		}
+	}
+	return dst
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// compact drops everything but lowercase letters and digits from s.
+func compact(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
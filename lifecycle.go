@@ -0,0 +1,60 @@
+package llmspecs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Status is the lifecycle stage of a model.
+type Status uint8
+
+const (
+	StatusActive     Status = iota // generally available
+	StatusPreview                  // preview, beta or experimental release
+	StatusDeprecated               // still served, but scheduled for removal
+	StatusRetired                  // no longer served
+)
+
+var statusNames = [...]string{
+	StatusActive:     "active",
+	StatusPreview:    "preview",
+	StatusDeprecated: "deprecated",
+	StatusRetired:    "retired",
+}
+
+// String returns the lowercase name used in the models/ YAML schema.
+func (s Status) String() string {
+	if int(s) < len(statusNames) {
+		return statusNames[s]
+	}
+	return fmt.Sprintf("Status(%d)", uint8(s))
+}
+
+// ParseStatus parses a status name such as "deprecated". Matching is case-insensitive
+// and the empty string means StatusActive.
+func ParseStatus(s string) (Status, error) {
+	if s == "" {
+		return StatusActive, nil
+	}
+	for i, name := range statusNames {
+		if strings.EqualFold(s, name) {
+			return Status(i), nil
+		}
+	}
+	return 0, fmt.Errorf("llmspecs: unknown status %q", s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseStatus.
+func (s *Status) UnmarshalText(text []byte) error {
+	v, err := ParseStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*s = v
+	return nil
+}
@@ -0,0 +1,78 @@
+package llmspecs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseStatus(t *testing.T) {
+	for _, s := range []Status{StatusActive, StatusPreview, StatusDeprecated, StatusRetired} {
+		got, err := ParseStatus(s.String())
+		if err != nil || got != s {
+			t.Errorf("ParseStatus(%q) = %v, %v", s.String(), got, err)
+		}
+	}
+	if got, err := ParseStatus("Deprecated"); err != nil || got != StatusDeprecated {
+		t.Errorf("ParseStatus should be case-insensitive, got %v, %v", got, err)
+	}
+	if got, err := ParseStatus(""); err != nil || got != StatusActive {
+		t.Errorf("Empty status should be active, got %v, %v", got, err)
+	}
+	if _, err := ParseStatus("sunset"); err == nil {
+		t.Error("Expected error for unknown status")
+	}
+}
+
+func TestModelStatus(t *testing.T) {
+	past := time.Now().Add(-24 * time.Hour).Unix()
+	future := time.Now().Add(24 * time.Hour).Unix()
+
+	tests := []struct {
+		name string
+		m    *modelData
+		want Status
+	}{
+		{"默认可用", &modelData{}, StatusActive},
+		{"预览版", &modelData{StatusVal: StatusPreview}, StatusPreview},
+		{"即将下线", &modelData{StatusVal: StatusDeprecated, ExpiresVal: future}, StatusDeprecated},
+		{"已过期", &modelData{StatusVal: StatusDeprecated, ExpiresVal: past}, StatusRetired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Status(); got != tt.want {
+				t.Errorf("Status() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	m := &modelData{ReleasedVal: 1745875945}
+	if got := m.ReleasedAt(); !got.Equal(time.Date(2025, 4, 28, 21, 32, 25, 0, time.UTC)) {
+		t.Errorf("ReleasedAt() = %v", got)
+	}
+	if !m.ExpiresAt().IsZero() {
+		t.Error("ExpiresAt() should be zero when unknown")
+	}
+}
+
+func TestQuery_ExcludeDeprecated(t *testing.T) {
+	all := Query().List()
+	active := Query().ExcludeDeprecated().List()
+	if len(active) == 0 || len(active) >= len(all) {
+		t.Fatalf("Expected some deprecated or retired models, got %d of %d", len(active), len(all))
+	}
+	for _, m := range active {
+		if s := m.Status(); s == StatusDeprecated || s == StatusRetired {
+			t.Errorf("Model %s has status %v", m.ID(), s)
+		}
+	}
+
+	m, ok := Get("google/gemini-2.0-flash-exp:free")
+	if !ok || m.Status() != StatusRetired || m.ReplacedBy() != "google/gemini-2.0-flash-001" {
+		t.Error("google/gemini-2.0-flash-exp:free should be retired in favor of google/gemini-2.0-flash-001")
+	}
+	for _, r := range Query().ExcludeRetired().List() {
+		if r.ID() == m.ID() {
+			t.Error("ExcludeRetired() returned a retired model")
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package llmspecs
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadOverlay reads model definitions from the .yaml, .yml and .json files
+// under dir and registers them with Override, so they replace built-in models
+// with the same ID. Files use the models/ schema (see ModelSpec) and hold
+// either a single model or a "models:" map keyed by ID. Keys only used by
+// sync, such as source and locked, are ignored.
+//
+// All files are parsed before any model is registered: on error, the
+// registry is left unchanged. The models are registered at once, so
+// concurrent readers see either none or all of them.
+func LoadOverlay(fsys fs.FS, dir string) error {
+	var models []*modelData
+	err := fs.WalkDir(fsys, dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch path.Ext(p) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		specs, err := decodeSpecs(data)
+		if err != nil {
+			return fmt.Errorf("llmspecs: %s: %w", p, err)
+		}
+		for _, spec := range specs {
+			// Like the generator, mark image and video input as multimodal
+			if spec.Features.Has(ModalityImageIn | ModalityVideoIn) {
+				spec.Features |= CapMultimodal
+			}
+			models = append(models, spec.model())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range models {
+		if err := validateModel(m); err != nil {
+			return err
+		}
+	}
+	return updateOverlay(func(s *overlayState) error {
+		for _, m := range models {
+			s.remove(m.IDVal)
+			s.put(m)
+		}
+		return nil
+	})
+}
+
+// decodeSpecs parses a file holding either a "models:" map or a single
+// model. JSON is accepted as a subset of YAML.
+func decodeSpecs(data []byte) ([]ModelSpec, error) {
+	var probe struct {
+		Models yaml.Node `yaml:"models"`
+	}
+	if err := yaml.Unmarshal(data, &probe); err != nil {
+		return nil, err
+	}
+	if probe.Models.Kind != 0 {
+		var set map[string]ModelSpec
+		if err := probe.Models.Decode(&set); err != nil {
+			return nil, err
+		}
+		specs := make([]ModelSpec, 0, len(set))
+		for id, spec := range set {
+			if spec.ID == "" {
+				spec.ID = id
+			}
+			specs = append(specs, spec)
+		}
+		return specs, nil
+	}
+
+	var single ModelSpec
+	if err := yaml.Unmarshal(data, &single); err != nil {
+		return nil, err
+	}
+	if single.ID == "" {
+		return nil, errors.New("model has no id")
+	}
+	return []ModelSpec{single}, nil
+}
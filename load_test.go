@@ -0,0 +1,139 @@
+package llmspecs
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadOverlay(t *testing.T) {
+	fsys := fstest.MapFS{
+		"overlay/acme/coder.yaml": {Data: []byte(`
+id: acme/coder-7b
+name: "Acme: Coder 7B"
+provider: Acme
+context_length: 65536
+features:
+  - CapChat
+  - ModalityTextIn
+  - ModalityImageIn
+aliases:
+  - acme-coder
+pricing:
+  prompt: "0.0000001"
+released_at: 2025-06-01T00:00:00Z
+status: preview
+`)},
+		"overlay/acme/all.yml": {Data: []byte(`
+models:
+  acme/coder-7b:free:
+    name: "Acme: Coder 7B (free)"
+    provider: Acme
+  openai/gpt-4:
+    name: "GPT-4 (gateway)"
+    provider: OpenAI
+    context_length: 32768
+`)},
+		"overlay/embed.json": {Data: []byte(`{"id": "acme/embed", "provider": "Acme", "features": ["CapEmbedding"]}`)},
+		"overlay/README.md":  {Data: []byte("not a model")},
+	}
+	if err := LoadOverlay(fsys, "overlay"); err != nil {
+		t.Fatalf("LoadOverlay failed: %v", err)
+	}
+	defer func() {
+		for _, id := range []string{"acme/coder-7b", "acme/coder-7b:free", "acme/embed", "openai/gpt-4"} {
+			Unregister(id)
+		}
+	}()
+
+	m, ok := Get("acme-coder")
+	if !ok {
+		t.Fatal("Expected acme/coder-7b by alias")
+	}
+	if m.Features() != CapChat|ModalityTextIn|ModalityImageIn|CapMultimodal {
+		t.Errorf("Unexpected features %v", m.Features())
+	}
+	if m.ContextLength() != 65536 || m.Pricing().Prompt != "0.0000001" || m.Status() != StatusPreview {
+		t.Errorf("Fields not loaded: %d %q %v", m.ContextLength(), m.Pricing().Prompt, m.Status())
+	}
+	if m.ReleasedAt().Year() != 2025 {
+		t.Errorf("Unexpected release date %v", m.ReleasedAt())
+	}
+	if v := m.Variants(); len(v) != 1 || v[0] != "acme/coder-7b:free" {
+		t.Errorf("Expected the free variant to be linked, got %v", v)
+	}
+
+	if m, _ := Get("openai/gpt-4"); m.ContextLength() != 32768 {
+		t.Error("Overlay should override built-in models")
+	}
+	if got := Query().Has(CapEmbedding).Provider("Acme").List(); len(got) != 1 {
+		t.Errorf("Expected the JSON model, got %v", got)
+	}
+}
+
+func TestLoadOverlay_Errors(t *testing.T) {
+	fsys := fstest.MapFS{
+		"bad/a.yaml": {Data: []byte("id: acme/a\nfeatures: [CapTelepathy]\n")},
+		"bad/b.yaml": {Data: []byte("id: acme/b\n")},
+	}
+	if err := LoadOverlay(fsys, "bad"); err == nil {
+		t.Error("Expected an error for an unknown feature")
+	}
+	if _, ok := Get("acme/b"); ok {
+		t.Error("A failed load must not register any model")
+	}
+
+	fsys = fstest.MapFS{"map/a.yaml": {Data: []byte("models:\n  acme/a:\n    status: sunset\n")}}
+	if err := LoadOverlay(fsys, "map"); err == nil || !strings.Contains(err.Error(), "sunset") {
+		t.Errorf("Expected the status error from the models: map, got %v", err)
+	}
+
+	fsys = fstest.MapFS{"noid/a.yaml": {Data: []byte("name: nameless\n")}}
+	if err := LoadOverlay(fsys, "noid"); err == nil {
+		t.Error("Expected an error for a model without id")
+	}
+}
+
+func TestLoadOverlay_Atomic(t *testing.T) {
+	const n = 50
+	fsys := fstest.MapFS{}
+	for i := range n {
+		fsys[fmt.Sprintf("batch/m%d.yaml", i)] = &fstest.MapFile{Data: []byte(fmt.Sprintf("id: acme/batch-%d\n", i))}
+	}
+	defer func() {
+		for i := range n {
+			Unregister(fmt.Sprintf("acme/batch-%d", i))
+		}
+	}()
+
+	// A concurrent reader sees the whole batch or none of it
+	before := Total()
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if got := Total(); got != before && got != before+n {
+				t.Errorf("Saw a partial batch: %d models", got-before)
+				return
+			}
+		}
+	}()
+	err := LoadOverlay(fsys, "batch")
+	close(done)
+	wg.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Total() != before+n {
+		t.Errorf("Expected %d new models, got %d", n, Total()-before)
+	}
+}
@@ -1,41 +1,222 @@
 package llmspecs
 
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
 // Model is an interface for reading model metadata.
 type Model interface {
 	ID() string
 	Name() string
+	NameCN() string // the Chinese display name, or "" if there is none
 	Provider() string
 	Description() string
 	DescriptionCN() string
 
 	ContextLength() int
 	MaxOutput() int
+	Pricing() Pricing
+
+	// Tokenizer names the tokenizer family, e.g. "GPT", "Claude", "Llama3" or "Qwen3".
+	Tokenizer() string
+	// InstructType names the chat template of open-weight models, e.g. "chatml";
+	// it is empty for models only served through an API.
+	InstructType() string
 
 	HasCapability(c Capability) bool
 	Features() Capability
 	Aliases() []string
+
+	// SupportedParameters lists the request parameters the model honors,
+	// using OpenRouter names such as "tools", "seed" or "top_k".
+	SupportedParameters() []string
+	SupportsParameter(name string) bool
+	// DefaultParameters returns the sampling parameters the provider applies
+	// when a request omits them, such as "temperature" or "top_p".
+	DefaultParameters() map[string]float64
+	// ParameterRange returns the accepted range of a numeric parameter,
+	// falling back to OpenRouter's documented range when the model has none.
+	ParameterRange(name string) (ParamRange, bool)
+
+	// Reasoning returns the thinking controls of models with CapReasoning.
+	Reasoning() ReasoningConfig
+
+	// ReleasedAt and ExpiresAt return the zero time when unknown.
+	ReleasedAt() time.Time
+	ExpiresAt() time.Time
+	// Status reports the lifecycle stage. Models past ExpiresAt are retired.
+	Status() Status
+	// ReplacedBy returns the ID of the recommended successor, if any.
+	ReplacedBy() string
+
+	// HuggingFaceID returns the open-weights repository, e.g. "Qwen/Qwen3-32B".
+	HuggingFaceID() string
+	// CanonicalSlug returns the dated identity behind the ID,
+	// e.g. "openai/gpt-5.2-codex-20260114".
+	CanonicalSlug() string
+
+	// Variant returns the OpenRouter suffix of the ID, e.g. "free" for
+	// "qwen/qwen3-coder:free", or "" for base models.
+	Variant() string
+	// BaseVariant returns the ID of the base model of a variant, or "" if
+	// this is a base model or the base is not in the registry.
+	BaseVariant() string
+	// Variants returns the IDs of the variants of a base model in the
+	// registry, including those registered at runtime.
+	Variants() []string
+	// EquivalentTo returns the IDs declared interchangeable with this model
+	// in models/, e.g. the same weights served by another host.
+	EquivalentTo() []string
+
+	// Family returns the product line parsed from the ID, e.g. "gpt" for
+	// "openai/gpt-4-0314", "claude-sonnet" for "anthropic/claude-3.7-sonnet"
+	// or "gpt-4o-mini". models/ can override it with family.
+	Family() string
+	// Version returns the generation within the family, e.g. "4" or "3.7",
+	// or "" if the ID has none.
+	Version() string
+	// SizeClass returns the parameter count named in the ID, e.g. "32B" or
+	// "8x22B", or "" for models that do not disclose it.
+	SizeClass() string
+	// SnapshotDate returns the date stamp of pinned snapshots such as
+	// "openai/gpt-4o-2024-08-06", or the zero time.
+	SnapshotDate() time.Time
 }
 
 // modelData is the internal implementation of the Model interface.
 type modelData struct {
 	IDVal         string
 	NameVal       string
+	NameCNVal     string
 	ProviderVal   string
 	DescVal       string
 	DescCNVal     string
 	ContextLenVal int
 	MaxOutputVal  int
+	PricingVal    Pricing
+	TokenizerVal  string
+	InstructVal   string
 	FeaturesVal   Capability
 	AliasList     []string
+	ParamList     []string
+	DefaultParams map[string]float64
+	ParamRanges   map[string]ParamRange
+	ReasoningVal  ReasoningConfig
+	ReleasedVal   int64 // Unix seconds, 0 if unknown
+	ExpiresVal    int64 // Unix seconds, 0 if none
+	StatusVal     Status
+	ReplacedByVal string
+	CanonicalVal  string
+	HFIDVal       string
+	BaseVal       string
+	VariantList   []string
+	EquivList     []string
+	FamilyVal     string
+	VersionVal    string
+	SizeVal       string
+	SnapshotVal   int64 // Unix seconds, 0 if none
+}
+
+func (m *modelData) ID() string                            { return m.IDVal }
+func (m *modelData) Name() string                          { return m.NameVal }
+func (m *modelData) NameCN() string                        { return m.NameCNVal }
+func (m *modelData) Provider() string                      { return m.ProviderVal }
+func (m *modelData) Description() string                   { return m.DescVal }
+func (m *modelData) DescriptionCN() string                 { return m.DescCNVal }
+func (m *modelData) ContextLength() int                    { return m.ContextLenVal }
+func (m *modelData) MaxOutput() int                        { return m.MaxOutputVal }
+func (m *modelData) Pricing() Pricing                      { return m.PricingVal }
+func (m *modelData) Tokenizer() string                     { return m.TokenizerVal }
+func (m *modelData) InstructType() string                  { return m.InstructVal }
+func (m *modelData) HasCapability(c Capability) bool       { return m.FeaturesVal&c != 0 }
+func (m *modelData) Features() Capability                  { return m.FeaturesVal }
+func (m *modelData) Aliases() []string                     { return m.AliasList }
+func (m *modelData) SupportedParameters() []string         { return m.ParamList }
+func (m *modelData) DefaultParameters() map[string]float64 { return m.DefaultParams }
+func (m *modelData) Reasoning() ReasoningConfig            { return m.ReasoningVal }
+func (m *modelData) ReleasedAt() time.Time                 { return unixTime(m.ReleasedVal) }
+func (m *modelData) ExpiresAt() time.Time                  { return unixTime(m.ExpiresVal) }
+func (m *modelData) ReplacedBy() string                    { return m.ReplacedByVal }
+func (m *modelData) HuggingFaceID() string                 { return m.HFIDVal }
+func (m *modelData) EquivalentTo() []string                { return m.EquivList }
+func (m *modelData) Family() string                        { return m.FamilyVal }
+func (m *modelData) Version() string                       { return m.VersionVal }
+func (m *modelData) SizeClass() string                     { return m.SizeVal }
+func (m *modelData) SnapshotDate() time.Time               { return unixTime(m.SnapshotVal) }
+
+func (m *modelData) SupportsParameter(name string) bool {
+	for _, p := range m.ParamList {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *modelData) ParameterRange(name string) (ParamRange, bool) {
+	if r, ok := m.ParamRanges[name]; ok {
+		return r, true
+	}
+	r, ok := standardRanges[name]
+	return r, ok
+}
+
+func (m *modelData) Status() Status {
+	if m.ExpiresVal != 0 && time.Now().Unix() >= m.ExpiresVal {
+		return StatusRetired
+	}
+	return m.StatusVal
+}
+
+func unixTime(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0).UTC()
+}
+
+func (m *modelData) CanonicalSlug() string {
+	if m.CanonicalVal == "" {
+		return m.IDVal
+	}
+	return m.CanonicalVal
+}
+
+// BaseVariant resolves the base of runtime models when asked, so it does
+// not depend on whether the base was registered first.
+func (m *modelData) BaseVariant() string {
+	if m.BaseVal != "" {
+		return m.BaseVal
+	}
+	base, _, ok := strings.Cut(m.IDVal, ":")
+	if !ok {
+		return ""
+	}
+	if _, found := lookupID(base); !found {
+		return ""
+	}
+	return base
 }
 
-func (m *modelData) ID() string                      { return m.IDVal }
-func (m *modelData) Name() string                    { return m.NameVal }
-func (m *modelData) Provider() string                { return m.ProviderVal }
-func (m *modelData) Description() string             { return m.DescVal }
-func (m *modelData) DescriptionCN() string           { return m.DescCNVal }
-func (m *modelData) ContextLength() int              { return m.ContextLenVal }
-func (m *modelData) MaxOutput() int                  { return m.MaxOutputVal }
-func (m *modelData) HasCapability(c Capability) bool { return m.FeaturesVal&c != 0 }
-func (m *modelData) Features() Capability            { return m.FeaturesVal }
-func (m *modelData) Aliases() []string               { return m.AliasList }
+// Variants merges the built-in variants of the ID with those registered at
+// runtime, so an override of a base model keeps its variants.
+func (m *modelData) Variants() []string {
+	variants := m.VariantList
+	if d, ok := staticRegistry[m.IDVal]; ok {
+		variants = d.VariantList
+	}
+	runtime := currentOverlay().variants[m.IDVal]
+	if len(runtime) == 0 {
+		return variants
+	}
+	merged := slices.Concat(variants, runtime)
+	slices.Sort(merged)
+	return slices.Compact(merged)
+}
+
+func (m *modelData) Variant() string {
+	_, v, _ := strings.Cut(m.IDVal, ":")
+	return v
+}
@@ -11,8 +11,12 @@ func TestModelDataGetters(t *testing.T) {
 		DescCNVal:     "测试模型",
 		ContextLenVal: 100,
 		MaxOutputVal:  50,
+		PricingVal:    Pricing{Prompt: "0.000001"},
+		TokenizerVal:  "Llama3",
+		InstructVal:   "llama3",
 		FeaturesVal:   ModalityTextIn,
 		AliasList:     []string{"tm"},
+		ParamList:     []string{"seed", "tools"},
 	}
 
 	if m.ID() != "test/model" {
@@ -36,10 +40,19 @@ func TestModelDataGetters(t *testing.T) {
 	if m.MaxOutput() != 50 {
 		t.Error("Getter MaxOutput fail")
 	}
+	if m.Pricing().Prompt != "0.000001" {
+		t.Error("Getter Pricing fail")
+	}
+	if m.Tokenizer() != "Llama3" || m.InstructType() != "llama3" {
+		t.Error("Getter Tokenizer/InstructType fail")
+	}
 	if !m.HasCapability(ModalityTextIn) {
 		t.Error("Getter HasCapability fail")
 	}
 	if m.Aliases()[0] != "tm" {
 		t.Error("Getter Aliases fail")
 	}
+	if len(m.SupportedParameters()) != 2 || !m.SupportsParameter("seed") || m.SupportsParameter("top_k") {
+		t.Error("Getter SupportedParameters fail")
+	}
 }
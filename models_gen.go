@@ -1,5 +1,5 @@
 // Code generated by llm-specs-gen. DO NOT EDIT.
-// Generated at: 2026-01-31T03:55:39Z
+// Generated at: 2026-10-16T07:19:58Z
 
 package llmspecs
 
@@ -13,8 +13,15 @@ func init() {
 			DescCNVal:     "Jamba Large 1.7 是 Jamba 开源系列的最新模型，在事实依据、指令遵循和整体效率方面均有提升。该模型基于混合 SSM-Transformer 架构，支持 256K 上下文窗口，相比前代版本可提供更准确、上下文关联更强的响应以及更优的可控性。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000008"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"jamba-large-1.7"},
+			ParamList:     []string{"max_tokens", "response_format", "stop", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1754669020,
+			HFIDVal:       "ai21labs/AI21-Jamba-Large-1.7",
+			FamilyVal:     "jamba-large",
+			VersionVal:    "1.7",
 		},
 		"ai21/jamba-mini-1.7": {
 			IDVal:         "ai21/jamba-mini-1.7",
@@ -24,8 +31,15 @@ func init() {
 			DescCNVal:     "Jamba Mini 1.7 是 Jamba 开源模型家族中一款紧凑高效的成员，在保持 SSM-Transformer 混合架构和 256K 上下文窗口优势的同时，显著提升了事实依据能力和指令遵循能力。尽管体积小巧，仍能提供准确、上下文关联性强的响应及增强的可控性。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000004"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"jamba-mini-1.7"},
+			ParamList:     []string{"max_tokens", "response_format", "stop", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1754670601,
+			HFIDVal:       "ai21labs/AI21-Jamba-Mini-1.7",
+			FamilyVal:     "jamba-mini",
+			VersionVal:    "1.7",
 		},
 		"aion-labs/aion-1.0": {
 			IDVal:         "aion-labs/aion-1.0",
@@ -35,8 +49,14 @@ func init() {
 			DescCNVal:     "Aion-1.0 是一个多模型系统，旨在在推理、编码等多种任务上实现高性能。该系统基于 DeepSeek-R1 构建，并融合了思维树（Tree of Thoughts, ToT）和混合专家（Mixture of Experts, MoE）等额外模型与技术，是 Aion Lab 最强大的推理模型。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  32768,
-			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.000004", Completion: "0.000008"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"aion-1.0"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "temperature", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1738697557,
+			FamilyVal:     "aion",
+			VersionVal:    "1.0",
 		},
 		"aion-labs/aion-1.0-mini": {
 			IDVal:         "aion-labs/aion-1.0-mini",
@@ -46,8 +66,15 @@ func init() {
 			DescCNVal:     "Aion-1.0-Mini 是一个 32B 参数模型，为 DeepSeek-R1 模型的蒸馏版本，专为数学、编码和逻辑等推理领域提供强大性能。该模型是 FuseAI 模型的一个改进变体，在基准测试中优于 R1-Distill-Qwen-32B 和 R1-Distill-Llama-70B，其基准结果可在其 [Hugging Face 页面](https://huggingface.co/FuseAI/FuseO1-DeepSeekR1-QwQ-SkyT1-32B-Preview) 查阅，并已由第三方独立复现验证。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  32768,
-			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000007", Completion: "0.0000014"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"aion-1.0-mini"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "temperature", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1738697107,
+			HFIDVal:       "FuseAI/FuseO1-DeepSeekR1-QwQ-SkyT1-32B-Preview",
+			FamilyVal:     "aion-mini",
+			VersionVal:    "1.0",
 		},
 		"aion-labs/aion-rp-llama-3.1-8b": {
 			IDVal:         "aion-labs/aion-rp-llama-3.1-8b",
@@ -57,8 +84,15 @@ func init() {
 			DescCNVal:     "Aion-RP-Llama-3.1-8B 在 RPBench-Auto 基准的角色扮演评估部分中排名第一。RPBench-Auto 是 Arena-Hard-Auto 的角色扮演专用变体，采用大语言模型相互评估回复质量。该模型是一个经过微调的基础模型（非指令微调模型），旨在生成更自然、更多样化的文本。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.0000008", Completion: "0.0000016"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"aion-rp-llama-3.1-8b"},
+			ParamList:     []string{"max_tokens", "temperature", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1738696718,
+			FamilyVal:     "aion-rp-llama",
+			VersionVal:    "3.1",
+			SizeVal:       "8B",
 		},
 		"alfredpros/codellama-7b-instruct-solidity": {
 			IDVal:         "alfredpros/codellama-7b-instruct-solidity",
@@ -68,8 +102,16 @@ func init() {
 			DescCNVal:     "基于 PEFT 库提供的 4 位 QLoRA 微调方法，对拥有 70 亿参数的 Code LLaMA - Instruct 模型进行微调，专用于生成 Solidity 智能合约。",
 			ContextLenVal: 4096,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.0000008", Completion: "0.0000012"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"codellama-7b-instruct-solidity"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			InstructVal:   "alpaca",
+			ReleasedVal:   1744641874,
+			HFIDVal:       "AlfredPros/CodeLlama-7b-Instruct-Solidity",
+			FamilyVal:     "codellama-solidity",
+			SizeVal:       "7B",
 		},
 		"alibaba/tongyi-deepresearch-30b-a3b": {
 			IDVal:         "alibaba/tongyi-deepresearch-30b-a3b",
@@ -79,8 +121,15 @@ func init() {
 			DescCNVal:     "通义深度研究（Tongyi DeepResearch）是通义实验室研发的智能体大语言模型，总参数量为300亿，每token仅激活30亿参数。该模型专为长周期、深度信息检索任务优化，在Humanity's Last Exam、BrowserComp、BrowserComp-ZH、WebWalkerQA、GAIA、xbench-DeepSearch和FRAMES等基准测试中达到业界领先水平，相较前代模型在复杂智能体搜索、推理及多步问题求解方面表现更优。\n\n该模型采用全自动合成数据流水线，支持可扩展的预训练、微调与强化学习。通过大规模持续预训练多样化智能体数据，提升推理能力并保持知识时效性。同时，模型引入端到端在线策略强化学习，采用定制化的分组相对策略优化（Group Relative Policy Optimization），结合token级梯度与负样本过滤机制，确保训练稳定性。模型支持ReAct用于核心能力验证，并提供基于IterResearch的“重型”模式，通过测试时扩展实现极致性能，适用于高级研究智能体、工具调用及高负载推理工作流。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000009", Completion: "0.0000004"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"tongyi-deepresearch-30b-a3b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1758210804,
+			HFIDVal:       "Alibaba-NLP/Tongyi-DeepResearch-30B-A3B",
+			FamilyVal:     "tongyi-deepresearch",
+			SizeVal:       "30B",
 		},
 		"allenai/molmo-2-8b:free": {
 			IDVal:         "allenai/molmo-2-8b:free",
@@ -90,8 +139,17 @@ func init() {
 			DescCNVal:     "Molmo2-8B 是艾伦人工智能研究所（AI2）开发的开源视觉语言模型，属于 Molmo2 系列，支持图像、视频及多图理解与定位。该模型基于 Qwen3-8B 构建，采用 SigLIP 2 作为视觉主干网络，在短视频、计数和图像描述等任务上优于其他开源权重与开源数据的模型，同时在长视频任务中仍保持竞争力。",
 			ContextLenVal: 36864,
 			MaxOutputVal:  36864,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"molmo-2-8b:free"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1767996672,
+			CanonicalVal:  "allenai/molmo-2-8b-20260109",
+			HFIDVal:       "allenai/Molmo2-8B",
+			FamilyVal:     "molmo",
+			VersionVal:    "2",
+			SizeVal:       "8B",
 		},
 		"allenai/olmo-2-0325-32b-instruct": {
 			IDVal:         "allenai/olmo-2-0325-32b-instruct",
@@ -101,8 +159,17 @@ func init() {
 			DescCNVal:     "OLMo-2 32B Instruct 是 OLMo-2 32B（2025年3月基础模型）的监督指令微调版本，在 GSM8K、MATH、IFEval 等复杂推理与指令遵循基准测试及通用 NLP 评估中表现卓越。该模型由 AI2 开发，属于一项开放、面向研究的计划，主要基于英文数据集训练，旨在推动开源语言模型的理解与发展。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000005", Completion: "0.0000002"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"olmo-2-0325-32b-instruct"},
+			ParamList:     []string{},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1741988556,
+			HFIDVal:       "allenai/OLMo-2-0325-32B-Instruct",
+			FamilyVal:     "olmo",
+			VersionVal:    "2",
+			SizeVal:       "32B",
+			SnapshotVal:   1740787200,
 		},
 		"allenai/olmo-3-32b-think": {
 			IDVal:         "allenai/olmo-3-32b-think",
@@ -112,8 +179,18 @@ func init() {
 			DescCNVal:     "Olmo 3 32B Think 是一款专为深度推理、复杂逻辑链和高级指令遵循场景设计的大规模语言模型，参数量达320亿。其强大的能力使其在高难度评估任务和高度细致的对话推理中表现出色。该模型由艾伦人工智能研究所（AI2）基于 Apache 2.0 许可证开发，体现了 Olmo 项目对开放性的承诺，全面公开了模型权重、代码及训练方法。",
 			ContextLenVal: 65536,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000005"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"olmo-3-32b-think"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.6, "top_p": 0.95},
+			ReleasedVal:   1763758276,
+			CanonicalVal:  "allenai/olmo-3-32b-think-20251121",
+			HFIDVal:       "allenai/Olmo-3-32B-Think",
+			FamilyVal:     "olmo-think",
+			VersionVal:    "3",
+			SizeVal:       "32B",
 		},
 		"allenai/olmo-3-7b-instruct": {
 			IDVal:         "allenai/olmo-3-7b-instruct",
@@ -123,8 +200,18 @@ func init() {
 			DescCNVal:     "Olmo 3 7B Instruct 是 Olmo 3 7B 基础模型的监督指令微调版本，专为指令遵循、问答和自然对话交互优化。通过高质量指令数据与开源训练流程，该模型在日常 NLP 任务中表现优异，同时保持易于集成和使用。由 AI2 基于 Apache 2.0 许可证开发，为指令驱动型应用提供透明且社区友好的选择。",
 			ContextLenVal: 65536,
 			MaxOutputVal:  65536,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000002"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"olmo-3-7b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.6, "top_p": 0.95},
+			ReleasedVal:   1763758273,
+			CanonicalVal:  "allenai/olmo-3-7b-instruct-20251121",
+			HFIDVal:       "allenai/Olmo-3-7B-Instruct",
+			FamilyVal:     "olmo",
+			VersionVal:    "3",
+			SizeVal:       "7B",
 		},
 		"allenai/olmo-3-7b-think": {
 			IDVal:         "allenai/olmo-3-7b-think",
@@ -134,8 +221,18 @@ func init() {
 			DescCNVal:     "Olmo 3 7B Think 是 Olmo 系列中面向研究的语言模型，专为高级推理和指令驱动任务设计，在多步问题求解、逻辑推理及维持连贯对话上下文方面表现卓越。该模型由 AI2 基于 Apache 2.0 许可证开发，支持完全透明的开放式实验，为学术研究和实用 NLP 工作流提供轻量但功能强大的基础。",
 			ContextLenVal: 65536,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000012", Completion: "0.0000002"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"olmo-3-7b-think"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.6, "top_p": 0.95},
+			ReleasedVal:   1763758270,
+			CanonicalVal:  "allenai/olmo-3-7b-think-20251121",
+			HFIDVal:       "allenai/Olmo-3-7B-Think",
+			FamilyVal:     "olmo-think",
+			VersionVal:    "3",
+			SizeVal:       "7B",
 		},
 		"allenai/olmo-3.1-32b-instruct": {
 			IDVal:         "allenai/olmo-3.1-32b-instruct",
@@ -145,8 +242,18 @@ func init() {
 			DescCNVal:     "Olmo 3.1 32B Instruct 是一款大规模、320亿参数的指令微调语言模型，专为高性能对话式 AI、多轮对话及实用指令遵循而设计。作为 Olmo 3.1 系列的成员，该变体强调对复杂用户指令的响应能力与稳健的聊天交互，同时在推理和编程基准测试中保持强大性能。该模型由 AI2 在 Apache 2.0 许可下开发，体现了 Olmo 计划对开放性与透明度的承诺。",
 			ContextLenVal: 65536,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"olmo-3.1-32b-instruct"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.6, "top_p": 0.95},
+			ReleasedVal:   1767728554,
+			CanonicalVal:  "allenai/olmo-3.1-32b-instruct-20251215",
+			HFIDVal:       "allenai/Olmo-3.1-32B-Instruct",
+			FamilyVal:     "olmo",
+			VersionVal:    "3.1",
+			SizeVal:       "32B",
 		},
 		"allenai/olmo-3.1-32b-think": {
 			IDVal:         "allenai/olmo-3.1-32b-think",
@@ -156,8 +263,18 @@ func init() {
 			DescCNVal:     "Olmo 3.1 32B Think 是一款大规模、320亿参数的模型，专为深度推理、复杂多步逻辑及高级指令遵循而设计。基于 Olmo 3 系列构建，3.1 版本在严苛评估和细致对话任务中展现出更精细的推理行为与更强的性能。该模型由 AI2 在 Apache 2.0 许可下开发，延续了 Olmo 计划对开放性的承诺，全面公开模型权重、代码及训练方法。",
 			ContextLenVal: 65536,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000005"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"olmo-3.1-32b-think"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.6, "top_p": 0.95},
+			ReleasedVal:   1765907719,
+			CanonicalVal:  "allenai/olmo-3.1-32b-think-20251215",
+			HFIDVal:       "allenai/Olmo-3.1-32B-Think",
+			FamilyVal:     "olmo-think",
+			VersionVal:    "3.1",
+			SizeVal:       "32B",
 		},
 		"alpindale/goliath-120b": {
 			IDVal:         "alpindale/goliath-120b",
@@ -167,8 +284,16 @@ func init() {
 			DescCNVal:     "一款大型语言模型，通过将两个经过微调的 Llama 70B 模型合并为一个 120B 模型构建而成，融合了 Xwin 与 Euryale。\n\n致谢：\n- [@chargoddard](https://huggingface.co/chargoddard) 开发了用于模型合并的框架 [mergekit](https://github.com/cg123/mergekit)。\n- [@Undi95](https://huggingface.co/Undi95) 协助确定了模型合并比例。\n\n#merge",
 			ContextLenVal: 6144,
 			MaxOutputVal:  1024,
+			PricingVal:    Pricing{Prompt: "0.00000375", Completion: "0.0000075"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"goliath-120b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_a", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Llama2",
+			InstructVal:   "airoboros",
+			ReleasedVal:   1699574400,
+			HFIDVal:       "alpindale/goliath-120b",
+			FamilyVal:     "goliath",
+			SizeVal:       "120B",
 		},
 		"amazon/nova-2-lite-v1": {
 			IDVal:         "amazon/nova-2-lite-v1",
@@ -178,8 +303,14 @@ func init() {
 			DescCNVal:     "Nova 2 Lite 是一款快速、高性价比的推理模型，适用于日常工作负载，可处理文本、图像和视频以生成文本。\n\nNova 2 Lite 在文档处理、视频信息提取、代码生成、提供准确的事实依据型答案以及自动化多步骤智能体工作流方面表现出色。",
 			ContextLenVal: 1000000,
 			MaxOutputVal:  65535,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000025"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"nova-2-lite-v1"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Nova",
+			ReleasedVal:   1764696672,
+			FamilyVal:     "nova-lite",
+			VersionVal:    "2",
 		},
 		"amazon/nova-lite-v1": {
 			IDVal:         "amazon/nova-lite-v1",
@@ -189,8 +320,14 @@ func init() {
 			DescCNVal:     "Amazon Nova Lite 1.0 是亚马逊推出的超低成本多模态模型，专注于快速处理图像、视频和文本输入以生成文本输出。该模型可高精度处理实时客户交互、文档分析和视觉问答任务。\n\n凭借 30 万 token 的输入上下文长度，它可在单次输入中分析多张图像或最多 30 分钟的视频。",
 			ContextLenVal: 300000,
 			MaxOutputVal:  5120,
+			PricingVal:    Pricing{Prompt: "0.00000006", Completion: "0.00000024"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"nova-lite-v1"},
+			ParamList:     []string{"max_tokens", "stop", "temperature", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Nova",
+			ReleasedVal:   1733437363,
+			FamilyVal:     "nova-lite",
+			VersionVal:    "1",
 		},
 		"amazon/nova-micro-v1": {
 			IDVal:         "amazon/nova-micro-v1",
@@ -200,8 +337,14 @@ func init() {
 			DescCNVal:     "Amazon Nova Micro 1.0 是纯文本模型，在 Amazon Nova 系列中提供最低延迟的响应，且成本极低。其上下文长度达 12.8 万 token，针对速度与成本进行了优化，擅长文本摘要、翻译、内容分类、交互式聊天和头脑风暴等任务，并具备基础的数学推理与编码能力。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  5120,
+			PricingVal:    Pricing{Prompt: "0.000000035", Completion: "0.00000014"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"nova-micro-v1"},
+			ParamList:     []string{"max_tokens", "stop", "temperature", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Nova",
+			ReleasedVal:   1733437237,
+			FamilyVal:     "nova-micro",
+			VersionVal:    "1",
 		},
 		"amazon/nova-premier-v1": {
 			IDVal:         "amazon/nova-premier-v1",
@@ -211,8 +354,14 @@ func init() {
 			DescCNVal:     "Amazon Nova Premier 是亚马逊多模态模型中能力最强的版本，专为复杂推理任务设计，同时也是蒸馏定制模型的最佳教师模型。",
 			ContextLenVal: 1000000,
 			MaxOutputVal:  32000,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.0000125", InputCacheRead: "0.000000625"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"nova-premier-v1"},
+			ParamList:     []string{"max_tokens", "stop", "temperature", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Nova",
+			ReleasedVal:   1761950332,
+			FamilyVal:     "nova-premier",
+			VersionVal:    "1",
 		},
 		"amazon/nova-pro-v1": {
 			IDVal:         "amazon/nova-pro-v1",
@@ -222,8 +371,14 @@ func init() {
 			DescCNVal:     "Amazon Nova Pro 1.0 是亚马逊推出的高性能多模态模型，旨在为广泛任务提供精度、速度与成本的最佳平衡。截至 2024 年 12 月，该模型在关键基准测试中达到业界领先水平，包括视觉问答（TextVQA）和视频理解（VATEX）。\n\nAmazon Nova Pro 在处理视觉与文本信息以及分析金融文档方面展现出强大能力。\n\n**注意**：当前暂不支持视频输入。",
 			ContextLenVal: 300000,
 			MaxOutputVal:  5120,
+			PricingVal:    Pricing{Prompt: "0.0000008", Completion: "0.0000032"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"nova-pro-v1"},
+			ParamList:     []string{"max_tokens", "stop", "temperature", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Nova",
+			ReleasedVal:   1733436303,
+			FamilyVal:     "nova-pro",
+			VersionVal:    "1",
 		},
 		"anthracite-org/magnum-v4-72b": {
 			IDVal:         "anthracite-org/magnum-v4-72b",
@@ -233,8 +388,17 @@ func init() {
 			DescCNVal:     "该系列模型旨在复现 Claude 3 系列模型（特别是 Sonnet（https://openrouter.ai/anthropic/claude-3.5-sonnet）和 Opus（https://openrouter.ai/anthropic/claude-3-opus））的散文质量。\n\n本模型基于 [Qwen2.5 72B](https://openrouter.ai/qwen/qwen-2.5-72b-instruct) 进行微调。",
 			ContextLenVal: 16384,
 			MaxOutputVal:  2048,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000005"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"magnum-v4-72b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_a", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Qwen",
+			InstructVal:   "chatml",
+			ReleasedVal:   1729555200,
+			HFIDVal:       "anthracite-org/magnum-v4-72b",
+			FamilyVal:     "magnum",
+			VersionVal:    "4",
+			SizeVal:       "72B",
 		},
 		"anthropic/claude-3-haiku": {
 			IDVal:         "anthropic/claude-3-haiku",
@@ -244,8 +408,15 @@ func init() {
 			DescCNVal:     "Claude 3 Haiku 是 Anthropic 推出的最快、最紧凑的模型，可实现近乎即时的响应，兼具快速性与精准的定向性能。\n\n详见发布公告及基准测试结果：[此处](https://www.anthropic.com/news/claude-3-haiku)\n\n#multimodal",
 			ContextLenVal: 200000,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.00000025", Completion: "0.00000125", InputCacheRead: "0.00000003", InputCacheWrite: "0.0000003"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-3-haiku"},
+			ParamList:     []string{"max_tokens", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Claude",
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReleasedVal:   1710288000,
+			FamilyVal:     "claude-haiku",
+			VersionVal:    "3",
 		},
 		"anthropic/claude-3.5-haiku": {
 			IDVal:         "anthropic/claude-3.5-haiku",
@@ -255,8 +426,16 @@ func init() {
 			DescCNVal:     "Claude 3.5 Haiku 在速度、代码准确性和工具使用方面能力显著增强。专为实时应用场景优化，可提供快速响应，适用于聊天交互和即时代码建议等动态任务。\n\n因此，该模型非常适合对速度与精度均有高要求的场景，如软件开发、客户服务机器人和数据管理系统。\n\n当前此模型指向 [Claude 3.5 Haiku (2024-10-22)](/anthropic/claude-3-5-haiku-20241022)。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.0000008", Completion: "0.000004", InputCacheRead: "0.00000008", InputCacheWrite: "0.000001", WebSearch: "0.01"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-3.5-haiku"},
+			ParamList:     []string{"max_tokens", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Claude",
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReleasedVal:   1730678400,
+			CanonicalVal:  "anthropic/claude-3-5-haiku",
+			FamilyVal:     "claude-haiku",
+			VersionVal:    "3.5",
 		},
 		"anthropic/claude-3.5-sonnet": {
 			IDVal:         "anthropic/claude-3.5-sonnet",
@@ -266,8 +445,15 @@ func init() {
 			DescCNVal:     "全新 Claude 3.5 Sonnet 在性能上超越 Opus，速度优于原有 Sonnet，价格维持 Sonnet 水平。Sonnet 尤其擅长以下领域：\n\n- 编程：在 SWE-Bench Verified 上得分约 49%，高于此前最佳成绩，且无需复杂的提示工程；\n- 数据科学：增强人类数据科学家的专业能力，能结合多种工具从非结构化数据中提取洞见；\n- 视觉处理：擅长解读图表、图形和图像，不仅能准确转录文本，还能从中挖掘超越文本本身的深层信息；\n- 智能体任务：具备卓越的工具调用能力，非常适合执行需与其他系统交互的复杂多步问题求解任务。\n\n#多模态",
 			ContextLenVal: 200000,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.000006", Completion: "0.00003"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-3.5-sonnet"},
+			ParamList:     []string{"max_tokens", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Claude",
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReleasedVal:   1729555200,
+			FamilyVal:     "claude-sonnet",
+			VersionVal:    "3.5",
 		},
 		"anthropic/claude-3.7-sonnet": {
 			IDVal:         "anthropic/claude-3.7-sonnet",
@@ -277,8 +463,18 @@ func init() {
 			DescCNVal:     "Claude 3.7 Sonnet 是一款先进的大语言模型，在推理、编程和问题解决能力方面均有显著提升。该模型引入了混合推理方法，允许用户在快速响应与针对复杂任务的逐步深入处理之间进行选择。其在编程方面表现尤为突出，尤其在前端开发和全栈更新场景中，并在智能体工作流（agentic workflows）中表现出色，能够自主执行多步骤流程。\n\nClaude 3.7 Sonnet 在标准模式下保持与其前代模型相当的性能，同时提供扩展推理模式，以在数学、编程及指令遵循任务中实现更高精度。\n\n更多详情请参阅[此博客文章](https://www.anthropic.com/news/claude-3-7-sonnet)",
 			ContextLenVal: 200000,
 			MaxOutputVal:  64000,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000015", InputCacheRead: "0.0000003", InputCacheWrite: "0.00000375", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-3.7-sonnet"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Claude",
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReasoningVal:  ReasoningConfig{MinBudgetTokens: 1024, CountsTowardOutput: true},
+			ReleasedVal:   1740422110,
+			CanonicalVal:  "anthropic/claude-3-7-sonnet-20250219",
+			VariantList:   []string{"anthropic/claude-3.7-sonnet:thinking"},
+			FamilyVal:     "claude-sonnet",
+			VersionVal:    "3.7",
 		},
 		"anthropic/claude-3.7-sonnet:thinking": {
 			IDVal:         "anthropic/claude-3.7-sonnet:thinking",
@@ -288,8 +484,18 @@ func init() {
 			DescCNVal:     "Claude 3.7 Sonnet 是一款先进的大语言模型，在推理、编程和问题解决能力方面均有显著提升。该模型引入了混合推理方法，允许用户在快速响应与针对复杂任务的逐步深入处理之间进行选择。其在编程方面表现尤为突出，尤其在前端开发和全栈更新场景中，并在智能体工作流（agentic workflows）中表现出色，能够自主执行多步骤流程。\n\nClaude 3.7 Sonnet 在标准模式下保持与其前代模型相当的性能，同时提供扩展推理模式，以在数学、编程及指令遵循任务中实现更高精度。\n\n更多详情请参阅[此博客文章](https://www.anthropic.com/news/claude-3-7-sonnet)",
 			ContextLenVal: 200000,
 			MaxOutputVal:  64000,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000015", InputCacheRead: "0.0000003", InputCacheWrite: "0.00000375", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-3.7-sonnet:thinking"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "stop", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Claude",
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReasoningVal:  ReasoningConfig{MinBudgetTokens: 1024, CountsTowardOutput: true},
+			ReleasedVal:   1740422110,
+			CanonicalVal:  "anthropic/claude-3-7-sonnet-20250219",
+			BaseVal:       "anthropic/claude-3.7-sonnet",
+			FamilyVal:     "claude-sonnet",
+			VersionVal:    "3.7",
 		},
 		"anthropic/claude-haiku-4.5": {
 			IDVal:         "anthropic/claude-haiku-4.5",
@@ -299,8 +505,17 @@ func init() {
 			DescCNVal:     "Claude Haiku 4.5 是 Anthropic 推出的最快、最高效的模型，以远低于更大规模 Claude 模型的成本和延迟提供接近前沿水平的智能。其在推理、编码和计算机使用任务上的表现媲美 Claude Sonnet 4，将前沿能力带入实时和高吞吐量应用场景。\n\n该模型首次为 Haiku 系列引入扩展思考能力，支持可控的推理深度、摘要式或交错式思维输出，以及全面支持编码、Bash、网页搜索和计算机使用工具的工具辅助工作流。在 SWE-bench Verified 基准上得分超过 73%，Haiku 4.5 跻身全球顶尖编码模型之列，同时在子代理、并行执行和规模化部署中保持卓越响应速度。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  64000,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000001", Completion: "0.000005", InputCacheRead: "0.0000001", InputCacheWrite: "0.00000125", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-haiku-4.5"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Claude",
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReasoningVal:  ReasoningConfig{MinBudgetTokens: 1024, CountsTowardOutput: true},
+			ReleasedVal:   1760547638,
+			CanonicalVal:  "anthropic/claude-4.5-haiku-20251001",
+			FamilyVal:     "claude-haiku",
+			VersionVal:    "4.5",
 		},
 		"anthropic/claude-opus-4": {
 			IDVal:         "anthropic/claude-opus-4",
@@ -310,8 +525,17 @@ func init() {
 			DescCNVal:     "Claude Opus 4 在发布时被公认为全球最强的编程模型，可在复杂、长时间运行的任务和智能体工作流中保持稳定性能。该模型在软件工程领域树立了新标杆，在 SWE-bench（72.5%）和 Terminal-bench（43.2%）上均取得领先成绩。Opus 4 支持扩展型智能体工作流，可连续数小时处理数千个任务步骤而性能不衰减。\n\n[在此处阅读博客文章](https://www.anthropic.com/news/claude-4)",
 			ContextLenVal: 200000,
 			MaxOutputVal:  32000,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000015", Completion: "0.000075", InputCacheRead: "0.0000015", InputCacheWrite: "0.00001875", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-opus-4"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Claude",
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReasoningVal:  ReasoningConfig{MinBudgetTokens: 1024, CountsTowardOutput: true},
+			ReleasedVal:   1747931245,
+			CanonicalVal:  "anthropic/claude-4-opus-20250522",
+			FamilyVal:     "claude-opus",
+			VersionVal:    "4",
 		},
 		"anthropic/claude-opus-4.1": {
 			IDVal:         "anthropic/claude-opus-4.1",
@@ -321,19 +545,38 @@ func init() {
 			DescCNVal:     "Claude Opus 4.1 是 Anthropic 旗舰模型的更新版本，在编码、推理和智能体任务方面性能显著提升。该模型在 SWE-bench Verified 上达到 74.5% 的准确率，并在多文件代码重构、调试精度和细节导向推理方面取得显著进步。模型支持最多 64K tokens 的扩展推理，专为研究、数据分析和工具辅助推理等任务优化。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  32000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000015", Completion: "0.000075", InputCacheRead: "0.0000015", InputCacheWrite: "0.00001875", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-opus-4.1"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Claude",
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReasoningVal:  ReasoningConfig{MinBudgetTokens: 1024, CountsTowardOutput: true},
+			ReleasedVal:   1754411591,
+			CanonicalVal:  "anthropic/claude-4.1-opus-20250805",
+			FamilyVal:     "claude-opus",
+			VersionVal:    "4.1",
 		},
 		"anthropic/claude-opus-4.5": {
 			IDVal:         "anthropic/claude-opus-4.5",
 			NameVal:       "Anthropic: Claude Opus 4.5",
+			NameCNVal:     "Claude 4.5 Opus",
 			ProviderVal:   "Anthropic",
 			DescVal:       "Claude Opus 4.5 is Anthropic’s frontier reasoning model optimized for complex software engineering, agentic workflows, and long-horizon computer use. It offers strong multimodal capabilities, competitive performance across real-world coding and reasoning benchmarks, and improved robustness to prompt injection. The model is designed to operate efficiently across varied effort levels, enabling developers to trade off speed, depth, and token usage depending on task requirements. It comes with a new parameter to control token efficiency, which can be accessed using the OpenRouter Verbosity parameter with low, medium, or high.\n\nOpus 4.5 supports advanced tool use, extended context management, and coordinated multi-agent setups, making it well-suited for autonomous research, debugging, multi-step planning, and spreadsheet/browser manipulation. It delivers substantial gains in structured reasoning, execution reliability, and alignment compared to prior Opus generations, while reducing token overhead and improving performance on long-running tasks.",
 			DescCNVal:     "Anthropic 最强大的模型，具备极高的推理能力。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  64000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000005", Completion: "0.000025", InputCacheRead: "0.0000005", InputCacheWrite: "0.00000625", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-opus-4.5", "opus-4.5"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "verbosity"},
+			TokenizerVal:  "Claude",
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReasoningVal:  ReasoningConfig{MinBudgetTokens: 1024, CountsTowardOutput: true},
+			ReleasedVal:   1764010580,
+			CanonicalVal:  "anthropic/claude-4.5-opus-20251124",
+			FamilyVal:     "claude-opus",
+			VersionVal:    "4.5",
 		},
 		"anthropic/claude-sonnet-4": {
 			IDVal:         "anthropic/claude-sonnet-4",
@@ -343,8 +586,17 @@ func init() {
 			DescCNVal:     "Claude Sonnet 4 相较前代 Sonnet 3.7 显著提升，在编程与推理任务中展现出更高的精度与可控性。该模型在 SWE-bench（72.7%）上达到业界领先水平，兼顾强大能力与计算效率，适用于从日常编码到复杂软件开发的广泛场景。关键改进包括更优的自主代码库导航能力、更低的智能体工作流错误率，以及对复杂指令更强的遵循可靠性。Sonnet 4 针对日常实用场景优化，在各类内外部应用中提供先进推理能力的同时，保持高效响应。\n\n[在此处阅读博客文章](https://www.anthropic.com/news/claude-4)",
 			ContextLenVal: 1000000,
 			MaxOutputVal:  64000,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000015", InputCacheRead: "0.0000003", InputCacheWrite: "0.00000375", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-sonnet-4"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Claude",
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReasoningVal:  ReasoningConfig{MinBudgetTokens: 1024, CountsTowardOutput: true},
+			ReleasedVal:   1747930371,
+			CanonicalVal:  "anthropic/claude-4-sonnet-20250522",
+			FamilyVal:     "claude-sonnet",
+			VersionVal:    "4",
 		},
 		"anthropic/claude-sonnet-4.5": {
 			IDVal:         "anthropic/claude-sonnet-4.5",
@@ -354,8 +606,18 @@ func init() {
 			DescCNVal:     "Claude Sonnet 4.5 是 Anthropic 迄今最先进的 Sonnet 模型，专为现实世界智能体和编码工作流优化。该模型在 SWE-bench Verified 等编码基准测试中达到业界领先水平，在系统设计、代码安全性和规范遵循方面均有显著提升。其设计支持长时间自主运行，可在会话间保持任务连续性，并提供基于事实的进度追踪。\n\nSonnet 4.5 还增强了智能体能力，包括改进的工具编排、推测性并行执行以及更高效的上下文与内存管理。凭借强化的上下文追踪能力和对工具调用中 token 使用情况的感知，该模型特别适用于多上下文及长时间运行的工作流。典型应用场景涵盖软件工程、网络安全、金融分析、研究智能体及其他需要持续推理与工具调用的领域。",
 			ContextLenVal: 1000000,
 			MaxOutputVal:  64000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000015", InputCacheRead: "0.0000003", InputCacheWrite: "0.00000375", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"claude-sonnet-4.5"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Claude",
+			DefaultParams: map[string]float64{"temperature": 1, "top_p": 1},
+			ParamRanges:   map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+			ReasoningVal:  ReasoningConfig{MinBudgetTokens: 1024, CountsTowardOutput: true},
+			ReleasedVal:   1759161676,
+			CanonicalVal:  "anthropic/claude-4.5-sonnet-20250929",
+			FamilyVal:     "claude-sonnet",
+			VersionVal:    "4.5",
 		},
 		"arcee-ai/coder-large": {
 			IDVal:         "arcee-ai/coder-large",
@@ -365,8 +627,13 @@ func init() {
 			DescCNVal:     "Coder‑Large 是基于 Qwen\u202f2.5‑Instruct 微调的 320 亿参数模型，进一步在采用宽松许可证的 GitHub、CodeSearchNet 及合成缺陷修复语料库上训练而成。该模型支持 32k 上下文窗口，可在单次调用中完成多文件重构或长差异审查，并支持 30 多种编程语言，尤其针对 TypeScript、Go 和 Terraform 进行了优化。内部基准测试表明，得益于强化学习阶段对可编译输出的奖励机制，其在 HumanEval 上比 CodeLlama‑34B‑Python 高出 5–8 分，在 BugFix 任务上表现同样优异。模型默认在代码块旁生成结构化解释，既适用于教育工具，也适用于生产级编程助手场景。在成本方面，Together AI 的定价远低于主流闭源竞品，使团队能在控制支出的同时规模化部署交互式编码功能。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000005", Completion: "0.0000008"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"coder-large"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1746478663,
+			FamilyVal:     "coder-large",
 		},
 		"arcee-ai/maestro-reasoning": {
 			IDVal:         "arcee-ai/maestro-reasoning",
@@ -376,8 +643,13 @@ func init() {
 			DescCNVal:     "Maestro Reasoning 是 Arcee 旗舰级分析模型：基于 Qwen 2.5‑32B 构建的 320 亿参数模型，采用 DPO 与思维链强化学习（chain‑of‑thought RL）进行微调，专精于逐步逻辑推理。相比早期 70 亿参数预览版，正式发布的 320 亿参数版本将上下文窗口扩展至 128k tokens，并在 MATH 与 GSM‑8K 基准测试中的通过率翻倍，同时提升了代码补全准确率。其指令风格鼓励生成结构化的“思考 → 答案”轨迹，用户可根据偏好选择解析或隐藏该轨迹。这种透明性特别契合金融、医疗等注重审计的行业，因其需追溯推理路径。在 Arcee Conductor 中，Maestro 会自动用于处理小型 SLM 无法应对的复杂多约束查询。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  32000,
+			PricingVal:    Pricing{Prompt: "0.0000009", Completion: "0.0000033"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"maestro-reasoning"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1746481269,
+			FamilyVal:     "maestro-reasoning",
 		},
 		"arcee-ai/spotlight": {
 			IDVal:         "arcee-ai/spotlight",
@@ -387,8 +659,13 @@ func init() {
 			DescCNVal:     "Spotlight 是一款 70 亿参数的视觉语言模型，基于 Qwen 2.5‑VL 开发，并由 Arcee AI 针对紧密图文对齐任务进行微调。该模型支持 32k tokens 的上下文窗口，可实现融合长篇文档与单张或多张图像的丰富多模态对话。训练重点在于消费级 GPU 上的快速推理，同时保持强大的图像描述、视觉问答（VQA）及图表分析准确性。因此，Spotlight 能无缝嵌入智能体工作流，实时解读截图、图表或 UI 原型。早期基准测试显示，其在主流 VQA 与 POPE 对齐测试中表现媲美甚至超越 LLaVA‑1.6 13B 等更大规模的视觉语言模型。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  65537,
+			PricingVal:    Pricing{Prompt: "0.00000018", Completion: "0.00000018"},
 			FeaturesVal:   CapChat | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"spotlight"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1746481552,
+			FamilyVal:     "spotlight",
 		},
 		"arcee-ai/trinity-large-preview:free": {
 			IDVal:         "arcee-ai/trinity-large-preview:free",
@@ -398,8 +675,17 @@ func init() {
 			DescCNVal:     "Trinity-Large-Preview 是 Arcee 推出的前沿级开源权重语言模型，采用稀疏混合专家（Mixture-of-Experts）架构，总参数量达 4000 亿，每 token 激活 130 亿参数，使用 256 个专家中选择 4 个的路由机制。\n\n该模型在创意写作、故事叙述、角色扮演、聊天场景及实时语音助手等任务上表现卓越，远超常规推理模型。同时，我们还引入了部分新型智能体（agentic）能力：模型经过专门训练，可在 OpenCode、Cline 和 Kilo Code 等智能体框架中高效运行，并能处理复杂的工具链及包含大量约束条件的长提示。\n\n其架构原生支持高达 512k token 的上下文窗口；当前 Preview API 以 8 位量化方式部署，提供 128k 上下文长度，兼顾实用性与性能。Trinity-Large-Preview 体现了 Arcee 以效率优先的设计理念，是一款面向生产环境的前沿开源模型，具备宽松的许可协议，适用于实际应用与实验探索。",
 			ContextLenVal: 131000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"trinity-large-preview:free"},
+			ParamList:     []string{"max_tokens", "response_format", "structured_outputs", "temperature", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.8, "top_p": 0.8},
+			ReleasedVal:   1769552670,
+			StatusVal:     StatusPreview,
+			CanonicalVal:  "arcee-ai/trinity-large-preview",
+			HFIDVal:       "arcee-ai/Trinity-Large-Preview",
+			FamilyVal:     "trinity-large",
 		},
 		"arcee-ai/trinity-mini": {
 			IDVal:         "arcee-ai/trinity-mini",
@@ -409,8 +695,17 @@ func init() {
 			DescCNVal:     "Trinity Mini 是一款稀疏混合专家（MoE）语言模型，总参数量260亿（每 token 激活约30亿），包含128个专家，每 token 激活其中8个。专为高效处理长上下文（131k tokens）而设计，具备强大的函数调用能力和多步智能体工作流支持。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.000000045", Completion: "0.00000015"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"trinity-mini"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.15, "top_p": 0.75},
+			ReleasedVal:   1764601720,
+			CanonicalVal:  "arcee-ai/trinity-mini-20251201",
+			HFIDVal:       "arcee-ai/Trinity-Mini",
+			VariantList:   []string{"arcee-ai/trinity-mini:free"},
+			FamilyVal:     "trinity-mini",
 		},
 		"arcee-ai/trinity-mini:free": {
 			IDVal:         "arcee-ai/trinity-mini:free",
@@ -420,8 +715,17 @@ func init() {
 			DescCNVal:     "Trinity Mini 是一款稀疏混合专家（MoE）语言模型，总参数量260亿（每 token 激活约30亿），包含128个专家，每 token 激活其中8个。专为高效处理长上下文（131k tokens）而设计，具备强大的函数调用能力和多步智能体工作流支持。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"trinity-mini:free"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.15, "top_p": 0.75},
+			ReleasedVal:   1764601720,
+			CanonicalVal:  "arcee-ai/trinity-mini-20251201",
+			HFIDVal:       "arcee-ai/Trinity-Mini",
+			BaseVal:       "arcee-ai/trinity-mini",
+			FamilyVal:     "trinity-mini",
 		},
 		"arcee-ai/virtuoso-large": {
 			IDVal:         "arcee-ai/virtuoso-large",
@@ -431,8 +735,13 @@ func init() {
 			DescCNVal:     "Virtuoso‑Large 是 Arcee 旗下 720 亿参数的顶级通用大语言模型，专为跨领域推理、创意写作及企业级问答任务优化。不同于多数 700 亿级同类模型，它保留了源自 Qwen 2.5 的 128k 上下文窗口，可一次性处理整本书籍、代码库或财务文件。训练过程融合 DeepSeek R1 蒸馏、多轮监督微调及最终的 DPO/RLHF 对齐阶段，在 BIG‑Bench‑Hard、GSM‑8K 及长上下文“大海捞针”测试中表现卓越。企业常将其作为 Conductor 流水线中的“兜底”智能核心，当其他小型 SLM 置信度不足时自动启用。尽管模型规模庞大，但凭借激进的 KV 缓存优化，在 8× H100 节点上首 token 延迟仍控制在低秒级，是一款实用的生产级高性能模型。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  64000,
+			PricingVal:    Pricing{Prompt: "0.00000075", Completion: "0.0000012"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"virtuoso-large"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1746478885,
+			FamilyVal:     "virtuoso-large",
 		},
 		"baidu/ernie-4.5-21b-a3b": {
 			IDVal:         "baidu/ernie-4.5-21b-a3b",
@@ -442,8 +751,17 @@ func init() {
 			DescCNVal:     "这是一款先进的纯文本混合专家（MoE）模型，总参数量达 210 亿，每 token 激活 30 亿参数，通过异构 MoE 结构与模态隔离路由机制实现卓越的多模态理解与生成能力。模型支持长达 131K token 的上下文，并借助多专家并行协作与量化技术实现高效推理；结合 SFT、DPO 和 UPO 等先进后训练方法，辅以专用路由与均衡损失函数，确保在各类应用场景中均具备优异性能。",
 			ContextLenVal: 120000,
 			MaxOutputVal:  8000,
+			PricingVal:    Pricing{Prompt: "0.00000007", Completion: "0.00000028"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"ernie-4.5-21b-a3b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.8, "top_p": 0.8},
+			ReleasedVal:   1755034167,
+			HFIDVal:       "baidu/ERNIE-4.5-21B-A3B-PT",
+			FamilyVal:     "ernie",
+			VersionVal:    "4.5",
+			SizeVal:       "21B",
 		},
 		"baidu/ernie-4.5-21b-a3b-thinking": {
 			IDVal:         "baidu/ernie-4.5-21b-a3b-thinking",
@@ -453,8 +771,17 @@ func init() {
 			DescCNVal:     "ERNIE-4.5-21B-A3B-Thinking 是百度推出的升级版轻量级 MoE 模型，经过优化以提升推理深度与质量，在逻辑谜题、数学、科学、编程、文本生成及专家级学术基准测试中表现卓越。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000007", Completion: "0.00000028"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"ernie-4.5-21b-a3b-thinking"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.6, "top_p": 0.95},
+			ReleasedVal:   1760048887,
+			HFIDVal:       "baidu/ERNIE-4.5-21B-A3B-Thinking",
+			FamilyVal:     "ernie-thinking",
+			VersionVal:    "4.5",
+			SizeVal:       "21B",
 		},
 		"baidu/ernie-4.5-300b-a47b": {
 			IDVal:         "baidu/ernie-4.5-300b-a47b",
@@ -464,8 +791,16 @@ func init() {
 			DescCNVal:     "ERNIE-4.5-300B-A47B 是百度推出的 ERNIE 4.5 系列中的 3000 亿参数混合专家（MoE）语言模型，每 token 激活 470 亿参数，支持中英文文本生成。该模型采用异构 MoE 架构，结合先进的路由机制与量化策略（包括 FP8 和 2-bit 格式），针对高吞吐推理和高效扩展进行了优化。此版本专为纯语言任务微调，支持推理、工具调用及最长 131k tokens 的上下文长度，适用于对推理能力和吞吐量要求较高的通用大模型应用场景。",
 			ContextLenVal: 123000,
 			MaxOutputVal:  12000,
+			PricingVal:    Pricing{Prompt: "0.00000028", Completion: "0.0000011"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"ernie-4.5-300b-a47b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1751300139,
+			HFIDVal:       "baidu/ERNIE-4.5-300B-A47B-PT",
+			FamilyVal:     "ernie",
+			VersionVal:    "4.5",
+			SizeVal:       "300B",
 		},
 		"baidu/ernie-4.5-vl-28b-a3b": {
 			IDVal:         "baidu/ernie-4.5-vl-28b-a3b",
@@ -475,8 +810,16 @@ func init() {
 			DescCNVal:     "这是一款强大的多模态混合专家（MoE）对话模型，总参数量达 280 亿，每 token 激活 30 亿参数，依托创新的异构 MoE 架构与模态隔离路由机制，实现卓越的文本与视觉理解能力。模型基于高吞吐训练与推理的高效扩展基础设施构建，采用 SFT、DPO 和 UPO 等先进后训练技术优化性能，支持高达 131K 的上下文长度，并通过 RLVR 对齐机制显著提升跨模态推理与生成能力。",
 			ContextLenVal: 30000,
 			MaxOutputVal:  8000,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000014", Completion: "0.00000056"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"ernie-4.5-vl-28b-a3b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1755032836,
+			HFIDVal:       "baidu/ERNIE-4.5-VL-28B-A3B-PT",
+			FamilyVal:     "ernie-vl",
+			VersionVal:    "4.5",
+			SizeVal:       "28B",
 		},
 		"baidu/ernie-4.5-vl-424b-a47b": {
 			IDVal:         "baidu/ernie-4.5-vl-424b-a47b",
@@ -486,8 +829,16 @@ func init() {
 			DescCNVal:     "ERNIE-4.5-VL-424B-A47B 是百度 ERNIE 4.5 系列中的多模态混合专家（MoE）模型，总参数量达 4240 亿，每 token 激活 470 亿参数。该模型基于异构 MoE 架构，采用模态隔离路由机制，在文本与图像数据上联合训练，实现高保真跨模态推理、图像理解及长达 131k tokens 的上下文生成。通过 SFT、DPO、UPO 和 RLVR 等技术微调，支持“思考”与非思考两种推理模式，专为中英文视觉-语言任务设计，并针对高效扩展优化，可在 4-bit/8-bit 量化下运行。",
 			ContextLenVal: 123000,
 			MaxOutputVal:  16000,
-			FeaturesVal:   CapChat | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000042", Completion: "0.00000125"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"ernie-4.5-vl-424b-a47b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1751300903,
+			HFIDVal:       "baidu/ERNIE-4.5-VL-424B-A47B-PT",
+			FamilyVal:     "ernie-vl",
+			VersionVal:    "4.5",
+			SizeVal:       "424B",
 		},
 		"bytedance-seed/seed-1.6": {
 			IDVal:         "bytedance-seed/seed-1.6",
@@ -497,8 +848,15 @@ func init() {
 			DescCNVal:     "Seed 1.6 是字节跳动 Seed 团队发布的一款通用模型，具备多模态能力与自适应深度思考功能，上下文窗口达 256K。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  32768,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000025", Completion: "0.000002"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"seed-1.6"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "reasoning", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1766504997,
+			CanonicalVal:  "bytedance-seed/seed-1.6-20250625",
+			FamilyVal:     "seed",
+			VersionVal:    "1.6",
 		},
 		"bytedance-seed/seed-1.6-flash": {
 			IDVal:         "bytedance-seed/seed-1.6-flash",
@@ -508,8 +866,15 @@ func init() {
 			DescCNVal:     "Seed 1.6 Flash 是字节跳动 Seed 团队推出的超高速多模态深度思考模型，支持文本与视觉理解，具备 256K 上下文窗口，并可生成最多 16K 个输出 token。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  32768,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000000075", Completion: "0.0000003"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"seed-1.6-flash"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "reasoning", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1766505011,
+			CanonicalVal:  "bytedance-seed/seed-1.6-flash-20250625",
+			FamilyVal:     "seed-flash",
+			VersionVal:    "1.6",
 		},
 		"bytedance/ui-tars-1.5-7b": {
 			IDVal:         "bytedance/ui-tars-1.5-7b",
@@ -519,8 +884,16 @@ func init() {
 			DescCNVal:     "UI-TARS-1.5 是一款专为图形用户界面（GUI）环境优化的多模态视觉语言智能体，适用于桌面界面、网页浏览器、移动系统及游戏场景。该模型由字节跳动开发，在 UI-TARS 框架基础上引入基于强化学习的推理机制，可在各类虚拟界面中实现稳健的动作规划与执行。\n\n该模型在多项交互式与具身智能基准测试中达到业界领先水平，包括 OSworld、WebVoyager、AndroidWorld 和 ScreenSpot。此外，它在多种 Poki 游戏中实现了完美任务完成率，并在《我的世界》（Minecraft）智能体任务中显著超越先前模型。UI-TARS-1.5 支持推理过程中的思维分解，并在不同规模版本中展现出强大的性能扩展能力，其中 1.5 版本的性能明显优于早期的 72B 和 7B 检查点。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  2048,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000002"},
 			FeaturesVal:   CapChat | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"ui-tars-1.5-7b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1753205056,
+			HFIDVal:       "ByteDance-Seed/UI-TARS-1.5-7B",
+			FamilyVal:     "ui-tars",
+			VersionVal:    "1.5",
+			SizeVal:       "7B",
 		},
 		"cognitivecomputations/dolphin-mistral-24b-venice-edition:free": {
 			IDVal:         "cognitivecomputations/dolphin-mistral-24b-venice-edition:free",
@@ -530,8 +903,16 @@ func init() {
 			DescCNVal:     "Venice Uncensored Dolphin Mistral 24B Venice Edition 是 Mistral-Small-24B-Instruct-2501 的微调变体，由 dphn.ai 与 Venice.ai 联合开发。该模型定位为“无审查”的指令微调大语言模型，保留用户对对齐策略、系统提示及行为模式的完全控制权。面向高级且无限制的应用场景，Venice Uncensored 强调可引导性与行为透明性，移除了主流助手模型中常见的默认安全与对齐机制。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"dolphin-mistral-24b-venice-edition:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1752094966,
+			CanonicalVal:  "venice/uncensored",
+			HFIDVal:       "cognitivecomputations/Dolphin-Mistral-24B-Venice-Edition",
+			FamilyVal:     "dolphin-mistral-venice-edition",
+			SizeVal:       "24B",
 		},
 		"cohere/command-a": {
 			IDVal:         "cohere/command-a",
@@ -541,8 +922,15 @@ func init() {
 			DescCNVal:     "Command A 是一款开源权重的 1110 亿参数模型，支持 256k 上下文窗口，专注于在智能体、多语言和编程等应用场景中提供卓越性能。相较于其他主流闭源及开源模型，Command A 在显著降低硬件成本的同时实现最高性能，尤其擅长处理对业务至关重要的智能体与多语言任务。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.00001"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"command-a"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1741894342,
+			CanonicalVal:  "cohere/command-a-03-2025",
+			HFIDVal:       "CohereForAI/c4ai-command-a-03-2025",
+			FamilyVal:     "command-a",
 		},
 		"cohere/command-r-08-2024": {
 			IDVal:         "cohere/command-r-08-2024",
@@ -552,8 +940,14 @@ func init() {
 			DescCNVal:     "command-r-08-2024 是 [Command R](/models/cohere/command-r) 的更新版本，在多语言检索增强生成（RAG）和工具使用方面性能更优。总体而言，该模型在数学、代码和推理能力上均有提升，性能可与上一代更大规模的 Command R+ 模型相媲美。\n\n发布详情请参阅[此处](https://docs.cohere.com/changelog/command-gets-refreshed)。\n\n使用本模型需遵守 Cohere 的[使用政策](https://docs.cohere.com/docs/usage-policy)和[SaaS 协议](https://cohere.com/saas-agreement)。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  4000,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"command-r-08-2024"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Cohere",
+			ReleasedVal:   1724976000,
+			FamilyVal:     "command-r",
+			SnapshotVal:   1722470400,
 		},
 		"cohere/command-r-plus-08-2024": {
 			IDVal:         "cohere/command-r-plus-08-2024",
@@ -563,8 +957,14 @@ func init() {
 			DescCNVal:     "command-r-plus-08-2024 是 [Command R+](/models/cohere/command-r-plus) 的更新版本，相较于前代 Command R+，吞吐量提升约 50%，延迟降低 25%，同时保持相同的硬件资源占用。\n\n发布详情请参阅[此处](https://docs.cohere.com/changelog/command-gets-refreshed)。\n\n使用本模型需遵守 Cohere 的[使用政策](https://docs.cohere.com/docs/usage-policy)和[SaaS 协议](https://cohere.com/saas-agreement)。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  4000,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.00001"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"command-r-plus-08-2024"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Cohere",
+			ReleasedVal:   1724976000,
+			FamilyVal:     "command-r-plus",
+			SnapshotVal:   1722470400,
 		},
 		"cohere/command-r7b-12-2024": {
 			IDVal:         "cohere/command-r7b-12-2024",
@@ -574,8 +974,14 @@ func init() {
 			DescCNVal:     "Command R7B（2024 年 12 月版）是 Command R+ 模型的小幅快速更新版本，于 2024 年 12 月发布。该模型在检索增强生成（RAG）、工具调用、智能体等需要复杂推理和多步骤操作的任务中表现卓越。\n\n使用本模型需遵守 Cohere 的[使用政策](https://docs.cohere.com/docs/usage-policy)和[SaaS 协议](https://cohere.com/saas-agreement)。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  4000,
+			PricingVal:    Pricing{Prompt: "0.0000000375", Completion: "0.00000015"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"command-r7b-12-2024"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Cohere",
+			ReleasedVal:   1734158152,
+			FamilyVal:     "command-r7b",
+			SnapshotVal:   1733011200,
 		},
 		"deepcogito/cogito-v2-preview-llama-109b-moe": {
 			IDVal:         "deepcogito/cogito-v2-preview-llama-109b-moe",
@@ -585,8 +991,18 @@ func init() {
 			DescCNVal:     "一款基于 Llama-4-Scout-17B-16E 构建的指令微调混合推理专家混合（Mixture-of-Experts）模型。Cogito v2 可直接作答，也可启用扩展的“思考”阶段，其对齐机制由迭代蒸馏与放大（IDA）引导。该模型专注于编程、STEM、指令遵循和通用助理性任务，在多语言能力、工具调用和推理性能方面均优于同等规模的基线模型。支持长上下文使用（最高达 1000 万 tokens）及标准 Transformers 工作流。用户可通过 `reasoning` 的 `enabled` 布尔值控制推理行为。[详见文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)",
 			ContextLenVal: 32767,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000018", Completion: "0.00000059"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"cogito-v2-preview-llama-109b-moe"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama4",
+			ReleasedVal:   1756831568,
+			ExpiresVal:    1770163200,
+			StatusVal:     StatusDeprecated,
+			HFIDVal:       "deepcogito/cogito-v2-preview-llama-109B-MoE",
+			FamilyVal:     "cogito-llama-moe",
+			VersionVal:    "2",
+			SizeVal:       "109B",
 		},
 		"deepcogito/cogito-v2-preview-llama-405b": {
 			IDVal:         "deepcogito/cogito-v2-preview-llama-405b",
@@ -596,8 +1012,18 @@ func init() {
 			DescCNVal:     "Cogito v2 405B 是一种密集型混合推理模型，兼具直接回答能力与高级自省机制。该模型采用密集架构，在性能上可与领先的闭源模型相媲美，代表了迈向前沿智能的重要一步。这一先进推理系统结合策略优化与超大规模，展现出卓越能力。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000035", Completion: "0.0000035"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"cogito-v2-preview-llama-405b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			ReleasedVal:   1760709933,
+			ExpiresVal:    1770163200,
+			StatusVal:     StatusDeprecated,
+			HFIDVal:       "deepcogito/cogito-v2-preview-llama-405B",
+			FamilyVal:     "cogito-llama",
+			VersionVal:    "2",
+			SizeVal:       "405B",
 		},
 		"deepcogito/cogito-v2-preview-llama-70b": {
 			IDVal:         "deepcogito/cogito-v2-preview-llama-70b",
@@ -607,8 +1033,18 @@ func init() {
 			DescCNVal:     "Cogito v2 70B 是一款稠密型混合推理模型，兼具直接作答能力与高级自省机制。通过迭代策略优化构建，在保持较短推理链和更强直觉的同时，在各类推理任务中展现出卓越性能。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000088", Completion: "0.00000088"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"cogito-v2-preview-llama-70b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			ReleasedVal:   1756831784,
+			ExpiresVal:    1770163200,
+			StatusVal:     StatusDeprecated,
+			HFIDVal:       "deepcogito/cogito-v2-preview-llama-70B",
+			FamilyVal:     "cogito-llama",
+			VersionVal:    "2",
+			SizeVal:       "70B",
 		},
 		"deepcogito/cogito-v2.1-671b": {
 			IDVal:         "deepcogito/cogito-v2.1-671b",
@@ -618,8 +1054,16 @@ func init() {
 			DescCNVal:     "Cogito v2.1 671B MoE 是全球最强的开源模型之一，性能媲美前沿闭源与开源模型。该模型通过自博弈强化学习训练，在指令遵循、编程、长查询及创意写作等多个领域达到业界领先水平，展现了通过策略优化迈向可扩展超级智能的重要进展。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00000125"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"cogito-v2.1-671b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1763071233,
+			CanonicalVal:  "deepcogito/cogito-v2.1-671b-20251118",
+			FamilyVal:     "cogito",
+			VersionVal:    "2.1",
+			SizeVal:       "671B",
 		},
 		"deepseek/deepseek-chat": {
 			IDVal:         "deepseek/deepseek-chat",
@@ -629,8 +1073,16 @@ func init() {
 			DescCNVal:     "DeepSeek-V3 是 DeepSeek 团队推出的最新模型，在前代版本的指令遵循与编码能力基础上进一步提升。该模型在近 15 万亿 token 上完成预训练，公开评测显示其性能超越其他开源模型，并可媲美主流闭源模型。\n\n有关模型详情，请访问 [DeepSeek-V3 代码仓库](https://github.com/deepseek-ai/DeepSeek-V3) 或参阅[发布公告](https://api-docs.deepseek.com/news/news1226)。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  163840,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000012"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-chat"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			ReleasedVal:   1735241320,
+			CanonicalVal:  "deepseek/deepseek-chat-v3",
+			HFIDVal:       "deepseek-ai/DeepSeek-V3",
+			FamilyVal:     "deepseek",
+			VersionVal:    "3",
 		},
 		"deepseek/deepseek-chat-v3-0324": {
 			IDVal:         "deepseek/deepseek-chat-v3-0324",
@@ -640,8 +1092,16 @@ func init() {
 			DescCNVal:     "DeepSeek V3 是 DeepSeek 团队最新推出的旗舰级对话模型系列的最新版本，采用混合专家（Mixture-of-Experts）架构，参数量达 6850 亿。\n\n该模型继 [DeepSeek V3](/deepseek/deepseek-chat-v3) 之后推出，在各类任务中均表现出色。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000019", Completion: "0.00000087"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-chat-v3-0324"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			ReleasedVal:   1742824755,
+			HFIDVal:       "deepseek-ai/DeepSeek-V3-0324",
+			FamilyVal:     "deepseek",
+			VersionVal:    "3",
+			SnapshotVal:   1742774400,
 		},
 		"deepseek/deepseek-chat-v3.1": {
 			IDVal:         "deepseek/deepseek-chat-v3.1",
@@ -651,8 +1111,16 @@ func init() {
 			DescCNVal:     "DeepSeek-V3.1 是一款大型混合推理模型（总参数 6710 亿，激活参数 370 亿），通过提示模板支持“思考”与“非思考”两种模式。该模型在 DeepSeek-V3 基础上采用两阶段长上下文训练流程，支持最多 128K tokens，并利用 FP8 微缩放技术实现高效推理。用户可通过 `reasoning` `enabled` 布尔值控制推理行为。[了解更多](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)\n\n该模型在工具调用、代码生成和推理效率方面均有提升，在高难度基准测试中性能媲美 DeepSeek-R1，同时响应速度更快。支持结构化工具调用、代码代理和搜索代理，适用于科研、编程及智能体工作流。\n\n此模型接替 [DeepSeek V3-0324](/deepseek/deepseek-chat-v3-0324)，在多种任务上表现优异。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  7168,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.00000075"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-chat-v3.1"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			InstructVal:   "deepseek-v3.1",
+			ReleasedVal:   1755779628,
+			HFIDVal:       "deepseek-ai/DeepSeek-V3.1",
+			FamilyVal:     "deepseek",
+			VersionVal:    "3.1",
 		},
 		"deepseek/deepseek-r1": {
 			IDVal:         "deepseek/deepseek-r1",
@@ -662,8 +1130,15 @@ func init() {
 			DescCNVal:     "DeepSeek R1 现已发布：性能媲美 [OpenAI o1](/openai/o1)，但完全开源且推理 token 全部开放。模型总参数量达 6710 亿，单次推理激活 370 亿参数。\n\n完全开源模型及[技术报告](https://api-docs.deepseek.com/news/news250120)。\n\n采用 MIT 许可证：可自由蒸馏与商业化！",
 			ContextLenVal: 64000,
 			MaxOutputVal:  16000,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000007", Completion: "0.0000025"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-r1"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			InstructVal:   "deepseek-r1",
+			ReleasedVal:   1737381095,
+			HFIDVal:       "deepseek-ai/DeepSeek-R1",
+			FamilyVal:     "deepseek-r1",
 		},
 		"deepseek/deepseek-r1-0528": {
 			IDVal:         "deepseek/deepseek-r1-0528",
@@ -673,8 +1148,17 @@ func init() {
 			DescCNVal:     "5月28日更新版[原始 DeepSeek R1](/deepseek/deepseek-r1)，性能与[OpenAI o1](/openai/o1)相当，但完全开源且推理过程中的所有推理 token 均公开。模型总参数量为6710亿，单次推理激活370亿参数。\n\n完全开源模型。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.00000175"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-r1-0528"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			InstructVal:   "deepseek-r1",
+			ReleasedVal:   1748455170,
+			HFIDVal:       "deepseek-ai/DeepSeek-R1-0528",
+			VariantList:   []string{"deepseek/deepseek-r1-0528:free"},
+			FamilyVal:     "deepseek-r1",
+			SnapshotVal:   1748390400,
 		},
 		"deepseek/deepseek-r1-0528:free": {
 			IDVal:         "deepseek/deepseek-r1-0528:free",
@@ -684,8 +1168,18 @@ func init() {
 			DescCNVal:     "5月28日更新版[原始 DeepSeek R1](/deepseek/deepseek-r1)，性能与[OpenAI o1](/openai/o1)相当，但完全开源且推理过程中的所有推理 token 均公开。模型总参数量为6710亿，单次推理激活370亿参数。\n\n完全开源模型。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-r1-0528:free"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "temperature"},
+			TokenizerVal:  "DeepSeek",
+			InstructVal:   "deepseek-r1",
+			ReleasedVal:   1748455170,
+			CanonicalVal:  "deepseek/deepseek-r1-0528",
+			HFIDVal:       "deepseek-ai/DeepSeek-R1-0528",
+			BaseVal:       "deepseek/deepseek-r1-0528",
+			FamilyVal:     "deepseek-r1",
+			SnapshotVal:   1748390400,
 		},
 		"deepseek/deepseek-r1-distill-llama-70b": {
 			IDVal:         "deepseek/deepseek-r1-distill-llama-70b",
@@ -695,8 +1189,16 @@ func init() {
 			DescCNVal:     "DeepSeek R1 Distill Llama 70B 是基于 [Llama-3.3-70B-Instruct](/meta-llama/llama-3.3-70b-instruct) 并利用 [DeepSeek R1](/deepseek/deepseek-r1) 输出蒸馏而成的大语言模型。该模型融合先进蒸馏技术，在多项基准测试中表现卓越，包括：\n\n- AIME 2024 pass@1：70.0\n- MATH-500 pass@1：94.5\n- CodeForces 评分：1633\n\n通过 DeepSeek R1 输出的微调，该模型实现了可与更大规模前沿模型相媲美的竞争力。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000003", Completion: "0.00000011"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-r1-distill-llama-70b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "deepseek-r1",
+			ReleasedVal:   1737663169,
+			HFIDVal:       "deepseek-ai/DeepSeek-R1-Distill-Llama-70B",
+			FamilyVal:     "deepseek-r1-distill-llama",
+			SizeVal:       "70B",
 		},
 		"deepseek/deepseek-r1-distill-qwen-32b": {
 			IDVal:         "deepseek/deepseek-r1-distill-qwen-32b",
@@ -706,8 +1208,16 @@ func init() {
 			DescCNVal:     "DeepSeek R1 Distill Qwen 32B 是基于 [Qwen 2.5 32B](https://huggingface.co/Qwen/Qwen2.5-32B) 并利用 [DeepSeek R1](/deepseek/deepseek-r1) 输出蒸馏而成的大语言模型。该模型在多项基准测试中超越 OpenAI o1-mini，为稠密模型树立了新的性能标杆。\n\n其他基准测试结果包括：\n\n- AIME 2024 pass@1：72.6\n- MATH-500 pass@1：94.3\n- CodeForces 评分：1691\n\n通过 DeepSeek R1 输出的微调，该模型实现了可与更大规模前沿模型相媲美的竞争力。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000029", Completion: "0.00000029"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-r1-distill-qwen-32b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Qwen",
+			InstructVal:   "deepseek-r1",
+			ReleasedVal:   1738194830,
+			HFIDVal:       "deepseek-ai/DeepSeek-R1-Distill-Qwen-32B",
+			FamilyVal:     "deepseek-r1-distill-qwen",
+			SizeVal:       "32B",
 		},
 		"deepseek/deepseek-v3.1-terminus": {
 			IDVal:         "deepseek/deepseek-v3.1-terminus",
@@ -717,8 +1227,17 @@ func init() {
 			DescCNVal:     "DeepSeek-V3.1 Terminus 是 [DeepSeek V3.1](/deepseek/deepseek-chat-v3.1) 的一次更新，在保留模型原有能力的同时，解决了用户反馈的问题（包括语言一致性和智能体能力），并进一步优化了其在代码和搜索智能体方面的性能。该模型是一个大型混合推理模型（总参数 671B，激活参数 37B），支持“思考”与“非思考”两种模式。它在 DeepSeek-V3 基础上通过两阶段长上下文训练流程扩展至最多 128K tokens，并采用 FP8 微缩放技术实现高效推理。用户可通过 `reasoning` `enabled` 布尔值控制推理行为。[详见文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)\n\n该模型提升了工具使用、代码生成和推理效率，在高难度基准测试中表现媲美 DeepSeek-R1，同时响应速度更快。它支持结构化工具调用、代码智能体和搜索智能体，适用于研究、编码及智能体工作流。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000021", Completion: "0.00000079", InputCacheRead: "0.000000168"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-v3.1-terminus"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			InstructVal:   "deepseek-v3.1",
+			ReleasedVal:   1758548275,
+			HFIDVal:       "deepseek-ai/DeepSeek-V3.1-Terminus",
+			VariantList:   []string{"deepseek/deepseek-v3.1-terminus:exacto"},
+			FamilyVal:     "deepseek-terminus",
+			VersionVal:    "3.1",
 		},
 		"deepseek/deepseek-v3.1-terminus:exacto": {
 			IDVal:         "deepseek/deepseek-v3.1-terminus:exacto",
@@ -728,8 +1247,18 @@ func init() {
 			DescCNVal:     "DeepSeek-V3.1 Terminus 是 [DeepSeek V3.1](/deepseek/deepseek-chat-v3.1) 的一次更新，在保留模型原有能力的同时，解决了用户反馈的问题（包括语言一致性和智能体能力），并进一步优化了其在代码和搜索智能体方面的性能。该模型是一个大型混合推理模型（总参数 671B，激活参数 37B），支持“思考”与“非思考”两种模式。它在 DeepSeek-V3 基础上通过两阶段长上下文训练流程扩展至最多 128K tokens，并采用 FP8 微缩放技术实现高效推理。用户可通过 `reasoning` `enabled` 布尔值控制推理行为。[详见文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)\n\n该模型提升了工具使用、代码生成和推理效率，在高难度基准测试中表现媲美 DeepSeek-R1，同时响应速度更快。它支持结构化工具调用、代码智能体和搜索智能体，适用于研究、编码及智能体工作流。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000021", Completion: "0.00000079", InputCacheRead: "0.000000168"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-v3.1-terminus:exacto"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			InstructVal:   "deepseek-v3.1",
+			ReleasedVal:   1758548275,
+			CanonicalVal:  "deepseek/deepseek-v3.1-terminus",
+			HFIDVal:       "deepseek-ai/DeepSeek-V3.1-Terminus",
+			BaseVal:       "deepseek/deepseek-v3.1-terminus",
+			FamilyVal:     "deepseek-terminus",
+			VersionVal:    "3.1",
 		},
 		"deepseek/deepseek-v3.2": {
 			IDVal:         "deepseek/deepseek-v3.2",
@@ -739,8 +1268,17 @@ func init() {
 			DescCNVal:     "DeepSeek-V3.2 是一款在计算效率与强推理及智能体工具使用能力之间取得平衡的大语言模型。它引入了 DeepSeek 稀疏注意力（DSA）机制——一种细粒度稀疏注意力方法，在长上下文场景中显著降低训练与推理成本的同时保持性能。通过可扩展的强化学习后训练框架进一步提升推理能力，据报告其性能达到 GPT-5 级别，并在2025年国际数学奥林匹克（IMO）和国际信息学奥林匹克（IOI）中斩获金牌。V3.2 还采用大规模智能体任务合成流水线，将推理能力更有效地融入工具使用场景，从而提升交互环境中的指令遵循性与泛化能力。\n\n用户可通过 `reasoning` 的 `enabled` 布尔值控制推理行为。[详见文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)",
 			ContextLenVal: 163840,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000025", Completion: "0.00000038"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-v3.2"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			DefaultParams: map[string]float64{"temperature": 1, "top_p": 0.95},
+			ReleasedVal:   1764594642,
+			CanonicalVal:  "deepseek/deepseek-v3.2-20251201",
+			HFIDVal:       "deepseek-ai/DeepSeek-V3.2",
+			FamilyVal:     "deepseek",
+			VersionVal:    "3.2",
 		},
 		"deepseek/deepseek-v3.2-exp": {
 			IDVal:         "deepseek/deepseek-v3.2-exp",
@@ -750,8 +1288,18 @@ func init() {
 			DescCNVal:     "DeepSeek-V3.2-Exp 是 DeepSeek 发布的实验性大语言模型，作为 V3.1 与未来架构之间的中间版本。该模型引入了 DeepSeek 稀疏注意力（DSA）机制——一种细粒度稀疏注意力机制，旨在长上下文场景下提升训练与推理效率，同时保持输出质量。用户可通过 `reasoning` `enabled` 布尔参数控制推理行为。[详见文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)\n\n该模型在与 V3.1-Terminus 对齐的条件下训练，便于直接对比。基准测试表明，其在推理、编码及智能体工具使用任务上的性能大致与 V3.1 相当，不同领域略有取舍。本次发布重点在于验证面向扩展上下文长度的架构优化，而非提升原始任务准确率，因此主要作为研究导向模型，用于探索高效 Transformer 设计。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000021", Completion: "0.00000032", InputCacheRead: "0.00000021"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-v3.2-exp"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			InstructVal:   "deepseek-v3.1",
+			DefaultParams: map[string]float64{"temperature": 0.6, "top_p": 0.95},
+			ReleasedVal:   1759150481,
+			StatusVal:     StatusPreview,
+			HFIDVal:       "deepseek-ai/DeepSeek-V3.2-Exp",
+			FamilyVal:     "deepseek",
+			VersionVal:    "3.2",
 		},
 		"deepseek/deepseek-v3.2-speciale": {
 			IDVal:         "deepseek/deepseek-v3.2-speciale",
@@ -761,8 +1309,17 @@ func init() {
 			DescCNVal:     "DeepSeek-V3.2-Speciale 是 DeepSeek-V3.2 的高性能计算变体，专为极致推理与智能体性能优化。它基于 DeepSeek 稀疏注意力（DSA）实现高效的长上下文处理，并通过更大规模的强化学习后训练进一步超越基础模型的能力。评估结果显示，Speciale 在高难度推理任务上优于 GPT-5，能力接近 Gemini-3.0-Pro，同时保持出色的代码生成与工具使用可靠性。与 V3.2 一样，它也受益于大规模智能体任务合成流水线，显著提升交互环境中的指令遵循性与泛化能力。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000027", Completion: "0.00000041"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-v3.2-speciale"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			DefaultParams: map[string]float64{"temperature": 1, "top_p": 0.95},
+			ReleasedVal:   1764594837,
+			CanonicalVal:  "deepseek/deepseek-v3.2-speciale-20251201",
+			HFIDVal:       "deepseek-ai/DeepSeek-V3.2-Speciale",
+			FamilyVal:     "deepseek-speciale",
+			VersionVal:    "3.2",
 		},
 		"eleutherai/llemma_7b": {
 			IDVal:         "eleutherai/llemma_7b",
@@ -772,8 +1329,16 @@ func init() {
 			DescCNVal:     "Llemma 7B 是一款面向数学领域的语言模型。该模型以 Code Llama 7B 的权重初始化，并在 Proof-Pile-2 数据集上训练了 2000 亿个 token。Llemma 系列模型在数学领域的思维链推理以及使用 Python 和形式化定理证明器等计算工具方面表现尤为突出。",
 			ContextLenVal: 4096,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.0000008", Completion: "0.0000012"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llemma_7b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			InstructVal:   "code-llama",
+			ReleasedVal:   1744643225,
+			HFIDVal:       "EleutherAI/llemma_7b",
+			FamilyVal:     "llemma",
+			SizeVal:       "7B",
 		},
 		"essentialai/rnj-1-instruct": {
 			IDVal:         "essentialai/rnj-1-instruct",
@@ -783,8 +1348,15 @@ func init() {
 			DescCNVal:     "Rnj-1 是由 Essential AI 开发的 80 亿参数密集型开源权重模型系列，从零开始训练，专注于编程、数学和科学推理。该模型在多种编程语言、工具调用工作流及智能体执行环境（如 mini-SWE-agent）中均展现出强大性能。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.00000015"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"rnj-1-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1765094847,
+			HFIDVal:       "EssentialAI/rnj-1-instruct",
+			FamilyVal:     "rnj",
+			VersionVal:    "1",
 		},
 		"google/gemini-2.0-flash-001": {
 			IDVal:         "google/gemini-2.0-flash-001",
@@ -794,8 +1366,16 @@ func init() {
 			DescCNVal:     "Gemini Flash 2.0 相较于 [Gemini Flash 1.5](/google/gemini-flash-1.5) 显著缩短了首令牌延迟（TTFT），同时保持与 [Gemini Pro 1.5](/google/gemini-pro-1.5) 等更大模型相当的质量。该版本在多模态理解、编码能力、复杂指令遵循和函数调用方面均有显著增强，共同带来更流畅、更稳健的智能体体验。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000004", InputCacheRead: "0.000000025", InputCacheWrite: "0.00000008333333333333334", InternalReasoning: "0.0000004", Image: "0.0000001", Audio: "0.0000007"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"gemini-2.0-flash-001"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1738769413,
+			ExpiresVal:    1774915200,
+			StatusVal:     StatusDeprecated,
+			FamilyVal:     "gemini-flash",
+			VersionVal:    "2.0",
 		},
 		"google/gemini-2.0-flash-exp:free": {
 			IDVal:         "google/gemini-2.0-flash-exp:free",
@@ -805,8 +1385,14 @@ func init() {
 			DescCNVal:     "Gemini Flash 2.0 相较于 [Gemini Flash 1.5](/google/gemini-flash-1.5) 显著缩短了首 token 延迟（TTFT），同时保持与 [Gemini Pro 1.5](/google/gemini-pro-1.5) 等更大模型相当的质量。该版本在多模态理解、编码能力、复杂指令遵循及函数调用方面均有显著增强，共同带来更流畅、稳健的智能体体验。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gemini-2.0-flash-exp:free"},
+			ParamList:     []string{},
+			StatusVal:     StatusRetired,
+			ReplacedByVal: "google/gemini-2.0-flash-001",
+			FamilyVal:     "gemini-flash",
+			VersionVal:    "2.0",
 		},
 		"google/gemini-2.0-flash-lite-001": {
 			IDVal:         "google/gemini-2.0-flash-lite-001",
@@ -816,8 +1402,16 @@ func init() {
 			DescCNVal:     "Gemini 2.0 Flash Lite 相较于 [Gemini Flash 1.5](/google/gemini-flash-1.5) 显著缩短了首 token 延迟（TTFT），同时在输出质量上媲美 [Gemini Pro 1.5](/google/gemini-pro-1.5) 等更大规模模型，并以极具性价比的 token 价格提供服务。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.000000075", Completion: "0.0000003", InternalReasoning: "0.0000003", Image: "0.000000075", Audio: "0.000000075"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"gemini-2.0-flash-lite-001"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1740506212,
+			ExpiresVal:    1772496000,
+			StatusVal:     StatusDeprecated,
+			FamilyVal:     "gemini-flash-lite",
+			VersionVal:    "2.0",
 		},
 		"google/gemini-2.5-flash": {
 			IDVal:         "google/gemini-2.5-flash",
@@ -827,8 +1421,14 @@ func init() {
 			DescCNVal:     "Gemini 2.5 Flash 是 Google 最先进的主力模型，专为高级推理、编程、数学和科学任务设计。其内置“思考”能力，可提供更高准确性和更精细的上下文处理。\n\n此外，Gemini 2.5 Flash 可通过“推理最大 token 数”参数进行配置，详见文档（https://openrouter.ai/docs/use-cases/reasoning-tokens#max-tokens-for-reasoning）。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  65535,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000025", InputCacheRead: "0.00000003", InputCacheWrite: "0.00000008333333333333334", InternalReasoning: "0.0000025", Image: "0.0000003", Audio: "0.000001"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"gemini-2.5-flash"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1750172488,
+			FamilyVal:     "gemini-flash",
+			VersionVal:    "2.5",
 		},
 		"google/gemini-2.5-flash-image": {
 			IDVal:         "google/gemini-2.5-flash-image",
@@ -838,8 +1438,14 @@ func init() {
 			DescCNVal:     "Gemini 2.5 Flash Image（又称“Nano Banana”）现已正式上线。这是一款具备上下文理解能力的前沿图像生成模型，支持图像生成、编辑及多轮对话。可通过 [image_config API 参数](https://openrouter.ai/docs/features/multimodal/image-generation#image-aspect-ratio-configuration) 控制图像宽高比。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000025", InputCacheRead: "0.00000003", InputCacheWrite: "0.00000008333333333333334", InternalReasoning: "0.0000025", Image: "0.0000003", Audio: "0.000001"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityImageOut | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gemini-2.5-flash-image"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "structured_outputs", "temperature", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1759870431,
+			FamilyVal:     "gemini-flash-image",
+			VersionVal:    "2.5",
 		},
 		"google/gemini-2.5-flash-lite": {
 			IDVal:         "google/gemini-2.5-flash-lite",
@@ -849,8 +1455,14 @@ func init() {
 			DescCNVal:     "Gemini 2.5 Flash-Lite 是 Gemini 2.5 系列中的轻量级推理模型，专为超低延迟与高成本效益而优化。相比早期 Flash 模型，它在吞吐量、令牌生成速度及常见基准测试性能方面均有提升。默认情况下，“思考”功能（即多轮推理）已禁用以优先保障速度，但开发者可通过 [Reasoning API 参数](https://openrouter.ai/docs/use-cases/reasoning-tokens) 启用该功能，在特定场景下以成本换取更高智能水平。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  65535,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000004", InputCacheRead: "0.00000001", InputCacheWrite: "0.00000008333333333333334", InternalReasoning: "0.0000004", Image: "0.0000001", Audio: "0.0000003"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"gemini-2.5-flash-lite"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1753200276,
+			FamilyVal:     "gemini-flash-lite",
+			VersionVal:    "2.5",
 		},
 		"google/gemini-2.5-flash-lite-preview-09-2025": {
 			IDVal:         "google/gemini-2.5-flash-lite-preview-09-2025",
@@ -860,8 +1472,16 @@ func init() {
 			DescCNVal:     "Gemini 2.5 Flash-Lite 是 Gemini 2.5 系列中的轻量级推理模型，专为超低延迟和成本效益优化。相比早期 Flash 模型，它在吞吐量、令牌生成速度及常见基准测试性能方面均有提升。默认禁用“思考”（即多轮推理）以优先保障速度，但开发者可通过 [Reasoning API 参数](https://openrouter.ai/docs/use-cases/reasoning-tokens) 启用该功能，在成本与智能之间进行权衡。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  65535,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000004", InputCacheRead: "0.00000001", InputCacheWrite: "0.00000008333333333333334", InternalReasoning: "0.0000004", Image: "0.0000001", Audio: "0.0000003"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"gemini-2.5-flash-lite-preview-09-2025"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1758819686,
+			StatusVal:     StatusPreview,
+			FamilyVal:     "gemini-flash-lite",
+			VersionVal:    "2.5",
+			SnapshotVal:   1756684800,
 		},
 		"google/gemini-2.5-flash-preview-09-2025": {
 			IDVal:         "google/gemini-2.5-flash-preview-09-2025",
@@ -871,8 +1491,17 @@ func init() {
 			DescCNVal:     "Gemini 2.5 Flash Preview（2025 年 9 月检查点）是 Google 最先进的主力模型，专为高级推理、编程、数学和科学任务设计。它内置“思考”能力，可提供更高准确度和更精细的上下文处理。\n\n此外，Gemini 2.5 Flash 可通过“max tokens for reasoning”参数进行配置，详情参见文档（https://openrouter.ai/docs/use-cases/reasoning-tokens#max-tokens-for-reasoning）。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000025", InputCacheRead: "0.00000003", InputCacheWrite: "0.00000008333333333333334", InternalReasoning: "0.0000025", Image: "0.0000003", Audio: "0.000001"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"gemini-2.5-flash-preview-09-2025"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1758820178,
+			ExpiresVal:    1771286400,
+			StatusVal:     StatusDeprecated,
+			FamilyVal:     "gemini-flash",
+			VersionVal:    "2.5",
+			SnapshotVal:   1756684800,
 		},
 		"google/gemini-2.5-pro": {
 			IDVal:         "google/gemini-2.5-pro",
@@ -882,8 +1511,14 @@ func init() {
 			DescCNVal:     "Gemini 2.5 Pro 是 Google 最先进的 AI 模型，专为高级推理、编程、数学和科学任务设计。该模型具备“思考”能力，能以更高的准确性和更精细的上下文理解生成响应。Gemini 2.5 Pro 在多项基准测试中表现卓越，包括在 LMArena 排行榜上位列第一，体现出优异的人类偏好对齐能力和复杂问题解决能力。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00001", InputCacheRead: "0.000000125", InputCacheWrite: "0.000000375", InternalReasoning: "0.00001", Image: "0.00000125", Audio: "0.00000125"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"gemini-2.5-pro"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1750169544,
+			FamilyVal:     "gemini-pro",
+			VersionVal:    "2.5",
 		},
 		"google/gemini-2.5-pro-preview": {
 			IDVal:         "google/gemini-2.5-pro-preview",
@@ -893,8 +1528,16 @@ func init() {
 			DescCNVal:     "Gemini 2.5 Pro 是 Google 最先进的 AI 模型，专为高级推理、编程、数学和科学任务设计。该模型具备“思考”能力，能以更高的准确性和更精细的上下文理解生成响应。Gemini 2.5 Pro 在多项基准测试中表现卓越，包括在 LMArena 排行榜上位列第一，体现出优异的人类偏好对齐能力和复杂问题解决能力。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00001", InputCacheRead: "0.000000125", InputCacheWrite: "0.000000375", InternalReasoning: "0.00001", Image: "0.00000125", Audio: "0.00000125"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gemini-2.5-pro-preview"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1749137257,
+			StatusVal:     StatusPreview,
+			CanonicalVal:  "google/gemini-2.5-pro-preview-06-05",
+			FamilyVal:     "gemini-pro",
+			VersionVal:    "2.5",
 		},
 		"google/gemini-2.5-pro-preview-05-06": {
 			IDVal:         "google/gemini-2.5-pro-preview-05-06",
@@ -904,8 +1547,17 @@ func init() {
 			DescCNVal:     "Gemini 2.5 Pro 是 Google 最先进的 AI 模型，专为高级推理、编程、数学及科学任务设计。其具备“思考”能力，可通过增强的准确性与细致的上下文处理进行推理。Gemini 2.5 Pro 在多项基准测试中表现顶尖，包括在 LMArena 排行榜上位列第一，体现出卓越的人类偏好对齐能力与复杂问题解决实力。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  65535,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00001", InputCacheRead: "0.000000125", InputCacheWrite: "0.000000375", InternalReasoning: "0.00001", Image: "0.00000125", Audio: "0.00000125"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"gemini-2.5-pro-preview-05-06"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1746578513,
+			StatusVal:     StatusPreview,
+			CanonicalVal:  "google/gemini-2.5-pro-preview-03-25",
+			FamilyVal:     "gemini-pro",
+			VersionVal:    "2.5",
+			SnapshotVal:   1746489600,
 		},
 		"google/gemini-3-flash-preview": {
 			IDVal:         "google/gemini-3-flash-preview",
@@ -915,8 +1567,16 @@ func init() {
 			DescCNVal:     "Gemini 3 Flash Preview 是一款高速、高性价比的思维模型，专为智能体工作流、多轮对话和编程辅助而设计。其推理与工具使用性能接近 Pro 级别，但延迟显著低于更大的 Gemini 变体，非常适合交互式开发、长时间运行的智能体循环及协作编程任务。相比 Gemini 2.5 Flash，它在推理、多模态理解及可靠性方面均有全面提升。\n\n该模型支持 100 万 token 的上下文窗口，可处理包括文本、图像、音频、视频和 PDF 在内的多模态输入，并输出文本。支持通过思维层级（minimal、low、medium、high）配置推理强度、结构化输出、工具调用及自动上下文缓存。Gemini 3 Flash Preview 面向希望获得强大推理与智能体行为，同时避免前沿大模型高昂成本与延迟的用户。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  65535,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000005", Completion: "0.000003", InputCacheRead: "0.00000005", InputCacheWrite: "0.00000008333333333333334", InternalReasoning: "0.000003", Image: "0.0000005", Audio: "0.000001"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"gemini-3-flash-preview"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1765987078,
+			StatusVal:     StatusPreview,
+			CanonicalVal:  "google/gemini-3-flash-preview-20251217",
+			FamilyVal:     "gemini-flash",
+			VersionVal:    "3",
 		},
 		"google/gemini-3-pro-image-preview": {
 			IDVal:         "google/gemini-3-pro-image-preview",
@@ -926,8 +1586,16 @@ func init() {
 			DescCNVal:     "Nano Banana Pro 是谷歌基于 Gemini 3 Pro 构建的最先进图像生成与编辑模型。相比初代 Nano Banana，它在多模态推理、现实世界对齐和高保真视觉合成方面显著提升。该模型可生成富含上下文的图形，涵盖信息图、示意图到电影级合成图像，并可通过搜索对齐整合实时信息。\n\n其在图像中文本渲染（包括长段落与多语言排版）、多图一致性融合以及最多五个主体的身份精准保留方面处于行业领先地位。Nano Banana Pro 新增细粒度创意控制功能，如局部编辑、光照与焦点调节、相机视角变换，并支持 2K/4K 输出及灵活宽高比。该模型专为专业级设计、产品可视化、分镜脚本及复杂多元素构图打造，同时兼顾通用图像创作工作流的高效性。",
 			ContextLenVal: 65536,
 			MaxOutputVal:  32768,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityImageOut | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000012", InputCacheRead: "0.0000002", InputCacheWrite: "0.000000375", InternalReasoning: "0.000012", Image: "0.000002", Audio: "0.000002"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityImageIn | ModalityImageOut | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gemini-3-pro-image-preview"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1763653797,
+			StatusVal:     StatusPreview,
+			CanonicalVal:  "google/gemini-3-pro-image-preview-20251120",
+			FamilyVal:     "gemini-pro-image",
+			VersionVal:    "3",
 		},
 		"google/gemini-3-pro-preview": {
 			IDVal:         "google/gemini-3-pro-preview",
@@ -937,8 +1605,16 @@ func init() {
 			DescCNVal:     "Gemini 3 Pro 是谷歌面向高精度多模态推理的旗舰前沿模型，融合文本、图像、视频、音频和代码领域的强大能力，并支持 100 万 token 的上下文窗口。使用多轮工具调用时必须保留推理细节，详见文档：https://openrouter.ai/docs/use-cases/reasoning-tokens#preserving-reasoning-blocks。该模型在通用推理、STEM 问题求解、事实问答及多模态理解等基准测试中表现卓越，在 LMArena、GPQA Diamond、MathArena Apex、MMMU-Pro 和 Video-MMMU 等评测中均取得领先分数。其交互强调深度与可解释性：模型旨在以最少提示推断用户意图，并输出直接、聚焦洞察的回应。\n\n专为高级开发与智能体工作流构建，Gemini 3 Pro 提供强大的工具调用能力、长周期规划稳定性，以及在复杂 UI、可视化和编程任务中的出色零样本生成能力。它在智能体编程（SWE-Bench Verified、Terminal-Bench 2.0）、多模态分析及结构化长文本任务（如研究综述、规划与交互式学习体验）方面尤为突出。适用场景包括自主智能体、编程助手、多模态分析、科学推理及高上下文信息处理。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000012", InputCacheRead: "0.0000002", InputCacheWrite: "0.000000375", InternalReasoning: "0.000012", Image: "0.000002", Audio: "0.000002"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityAudioIn | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"gemini-3-pro-preview"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			ReleasedVal:   1763474668,
+			StatusVal:     StatusPreview,
+			CanonicalVal:  "google/gemini-3-pro-preview-20251117",
+			FamilyVal:     "gemini-pro",
+			VersionVal:    "3",
 		},
 		"google/gemma-2-27b-it": {
 			IDVal:         "google/gemma-2-27b-it",
@@ -948,8 +1624,17 @@ func init() {
 			DescCNVal:     "Google 推出的 Gemma 2 27B 是一款开源模型，基于与 [Gemini 系列模型](/models?q=gemini) 相同的研究和技术构建。\n\nGemma 模型适用于多种文本生成任务，包括问答、摘要和推理。\n\n更多详情请参阅 [发布公告](https://blog.google/technology/developers/google-gemma-2/)。Gemma 的使用需遵守 Google 的 [Gemma 使用条款](https://ai.google.dev/gemma/terms)。",
 			ContextLenVal: 8192,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000065", Completion: "0.00000065"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gemma-2-27b-it"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "stop", "structured_outputs", "temperature", "top_p"},
+			TokenizerVal:  "Gemini",
+			InstructVal:   "gemma",
+			ReleasedVal:   1720828800,
+			HFIDVal:       "google/gemma-2-27b-it",
+			FamilyVal:     "gemma",
+			VersionVal:    "2",
+			SizeVal:       "27B",
 		},
 		"google/gemma-2-9b-it": {
 			IDVal:         "google/gemma-2-9b-it",
@@ -959,8 +1644,17 @@ func init() {
 			DescCNVal:     "Google 推出的 Gemma 2 9B 是一款先进的开源语言模型，在其规模级别中树立了效率与性能的新标杆。\n\n该模型专为广泛任务设计，赋能开发者和研究人员构建创新应用，同时兼顾可访问性、安全性与成本效益。\n\n更多详情请参阅 [发布公告](https://blog.google/technology/developers/google-gemma-2/)。Gemma 的使用需遵守 Google 的 [Gemma 使用条款](https://ai.google.dev/gemma/terms)。",
 			ContextLenVal: 8192,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000003", Completion: "0.00000009"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gemma-2-9b-it"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Gemini",
+			InstructVal:   "gemma",
+			ReleasedVal:   1719532800,
+			HFIDVal:       "google/gemma-2-9b-it",
+			FamilyVal:     "gemma",
+			VersionVal:    "2",
+			SizeVal:       "9B",
 		},
 		"google/gemma-3-12b-it": {
 			IDVal:         "google/gemma-3-12b-it",
@@ -970,8 +1664,18 @@ func init() {
 			DescCNVal:     "Gemma 3 引入多模态能力，支持视觉-语言输入与文本输出，可处理长达 128k token 的上下文，支持超过 140 种语言，并在数学、推理和对话能力方面均有提升，包括结构化输出与函数调用功能。Gemma 3 12B 是 Gemma 3 系列中仅次于 [Gemma 3 27B](google/gemma-3-27b-it) 的第二大模型。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
+			PricingVal:    Pricing{Prompt: "0.00000003", Completion: "0.0000001"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gemma-3-12b-it"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Gemini",
+			InstructVal:   "gemma",
+			ReleasedVal:   1741902625,
+			HFIDVal:       "google/gemma-3-12b-it",
+			VariantList:   []string{"google/gemma-3-12b-it:free"},
+			FamilyVal:     "gemma",
+			VersionVal:    "3",
+			SizeVal:       "12B",
 		},
 		"google/gemma-3-12b-it:free": {
 			IDVal:         "google/gemma-3-12b-it:free",
@@ -981,8 +1685,19 @@ func init() {
 			DescCNVal:     "Gemma 3 引入多模态能力，支持视觉-语言输入与文本输出，可处理长达 128k token 的上下文，支持超过 140 种语言，并在数学、推理和对话能力方面均有提升，包括结构化输出与函数调用功能。Gemma 3 12B 是 Gemma 3 系列中仅次于 [Gemma 3 27B](google/gemma-3-27b-it) 的第二大模型。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gemma-3-12b-it:free"},
+			ParamList:     []string{"max_tokens", "seed", "stop", "temperature", "top_p"},
+			TokenizerVal:  "Gemini",
+			InstructVal:   "gemma",
+			ReleasedVal:   1741902625,
+			CanonicalVal:  "google/gemma-3-12b-it",
+			HFIDVal:       "google/gemma-3-12b-it",
+			BaseVal:       "google/gemma-3-12b-it",
+			FamilyVal:     "gemma",
+			VersionVal:    "3",
+			SizeVal:       "12B",
 		},
 		"google/gemma-3-27b-it": {
 			IDVal:         "google/gemma-3-27b-it",
@@ -992,8 +1707,18 @@ func init() {
 			DescCNVal:     "Gemma 3 引入多模态能力，支持视觉-语言输入与文本输出，可处理长达 128k token 的上下文，支持超过 140 种语言，并在数学、推理和对话能力方面均有提升，包括结构化输出与函数调用功能。Gemma 3 27B 是 Google 最新推出的开源模型，为 [Gemma 2](google/gemma-2-27b-it) 的继任者。",
 			ContextLenVal: 96000,
 			MaxOutputVal:  96000,
+			PricingVal:    Pricing{Prompt: "0.00000004", Completion: "0.00000015"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gemma-3-27b-it"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Gemini",
+			InstructVal:   "gemma",
+			ReleasedVal:   1741756359,
+			HFIDVal:       "google/gemma-3-27b-it",
+			VariantList:   []string{"google/gemma-3-27b-it:free"},
+			FamilyVal:     "gemma",
+			VersionVal:    "3",
+			SizeVal:       "27B",
 		},
 		"google/gemma-3-27b-it:free": {
 			IDVal:         "google/gemma-3-27b-it:free",
@@ -1003,8 +1728,19 @@ func init() {
 			DescCNVal:     "Gemma 3 引入多模态能力，支持视觉-语言输入与文本输出，可处理长达 128k token 的上下文，支持超过 140 种语言，并在数学、推理和对话能力方面均有提升，包括结构化输出与函数调用功能。Gemma 3 27B 是 Google 最新推出的开源模型，为 [Gemma 2](google/gemma-2-27b-it) 的继任者。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gemma-3-27b-it:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Gemini",
+			InstructVal:   "gemma",
+			ReleasedVal:   1741756359,
+			CanonicalVal:  "google/gemma-3-27b-it",
+			HFIDVal:       "google/gemma-3-27b-it",
+			BaseVal:       "google/gemma-3-27b-it",
+			FamilyVal:     "gemma",
+			VersionVal:    "3",
+			SizeVal:       "27B",
 		},
 		"google/gemma-3-4b-it": {
 			IDVal:         "google/gemma-3-4b-it",
@@ -1014,8 +1750,18 @@ func init() {
 			DescCNVal:     "Gemma 3 引入多模态能力，支持视觉-语言输入与文本输出，可处理长达 128k token 的上下文，支持超过 140 种语言，并在数学、推理和对话能力方面均有提升，包括结构化输出与函数调用功能。",
 			ContextLenVal: 96000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000001703012", Completion: "0.0000000681536"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gemma-3-4b-it"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Gemini",
+			InstructVal:   "gemma",
+			ReleasedVal:   1741905510,
+			HFIDVal:       "google/gemma-3-4b-it",
+			VariantList:   []string{"google/gemma-3-4b-it:free"},
+			FamilyVal:     "gemma",
+			VersionVal:    "3",
+			SizeVal:       "4B",
 		},
 		"google/gemma-3-4b-it:free": {
 			IDVal:         "google/gemma-3-4b-it:free",
@@ -1025,8 +1771,19 @@ func init() {
 			DescCNVal:     "Gemma 3 引入多模态能力，支持视觉-语言输入与文本输出，可处理长达 128k token 的上下文，支持超过 140 种语言，并在数学、推理和对话能力方面均有提升，包括结构化输出与函数调用功能。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gemma-3-4b-it:free"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "stop", "temperature", "top_p"},
+			TokenizerVal:  "Gemini",
+			InstructVal:   "gemma",
+			ReleasedVal:   1741905510,
+			CanonicalVal:  "google/gemma-3-4b-it",
+			HFIDVal:       "google/gemma-3-4b-it",
+			BaseVal:       "google/gemma-3-4b-it",
+			FamilyVal:     "gemma",
+			VersionVal:    "3",
+			SizeVal:       "4B",
 		},
 		"google/gemma-3n-e2b-it:free": {
 			IDVal:         "google/gemma-3n-e2b-it:free",
@@ -1036,8 +1793,16 @@ func init() {
 			DescCNVal:     "Gemma 3n E2B IT 是 Google DeepMind 开发的多模态指令微调模型，基于 60 亿参数架构，有效参数规模约为 20 亿。该模型采用 MatFormer 架构，支持嵌套子模型及通过 Mix-and-Match 框架进行模块化组合。Gemma 3n 系列针对低资源部署优化，提供 32K 上下文长度，在主流基准测试中展现出卓越的多语言能力与推理性能。此变体在包含代码、数学、网页及多模态数据的多样化语料上进行训练。",
 			ContextLenVal: 8192,
 			MaxOutputVal:  2048,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gemma-3n-e2b-it:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "temperature", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1752074904,
+			CanonicalVal:  "google/gemma-3n-e2b-it",
+			HFIDVal:       "google/gemma-3n-E2B-it",
+			FamilyVal:     "gemma-3n",
+			SizeVal:       "E2B",
 		},
 		"google/gemma-3n-e4b-it": {
 			IDVal:         "google/gemma-3n-e4b-it",
@@ -1047,8 +1812,16 @@ func init() {
 			DescCNVal:     "Gemma 3n E4B-it 针对手机、笔记本电脑和平板等移动及低资源设备的高效执行进行了优化。该模型支持多模态输入——包括文本、视觉数据和音频——可执行文本生成、语音识别、翻译及图像分析等多样化任务。借助逐层嵌入（PLE）缓存和 MatFormer 架构等创新技术，Gemma 3n 能动态管理内存使用与计算负载，通过选择性激活模型参数显著降低运行时资源需求。\n\n该模型支持广泛的语种（训练涵盖 140 多种语言），并具备灵活的 32K tokens 上下文窗口。Gemma 3n 可根据任务或设备能力选择性加载参数，优化内存与计算效率，非常适合注重隐私、支持离线运行的应用及端侧 AI 解决方案。[阅读博客文章了解更多](https://developers.googleblog.com/en/introducing-gemma-3n/)",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000002", Completion: "0.00000004"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gemma-3n-e4b-it"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1747776824,
+			HFIDVal:       "google/gemma-3n-E4B-it",
+			VariantList:   []string{"google/gemma-3n-e4b-it:free"},
+			FamilyVal:     "gemma-3n",
+			SizeVal:       "E4B",
 		},
 		"google/gemma-3n-e4b-it:free": {
 			IDVal:         "google/gemma-3n-e4b-it:free",
@@ -1058,8 +1831,17 @@ func init() {
 			DescCNVal:     "Gemma 3n E4B-it 针对手机、笔记本电脑和平板等移动及低资源设备的高效执行进行了优化。该模型支持多模态输入——包括文本、视觉数据和音频——可执行文本生成、语音识别、翻译及图像分析等多样化任务。借助逐层嵌入（PLE）缓存和 MatFormer 架构等创新技术，Gemma 3n 能动态管理内存使用与计算负载，通过选择性激活模型参数显著降低运行时资源需求。\n\n该模型支持广泛的语种（训练涵盖 140 多种语言），并具备灵活的 32K tokens 上下文窗口。Gemma 3n 可根据任务或设备能力选择性加载参数，优化内存与计算效率，非常适合注重隐私、支持离线运行的应用及端侧 AI 解决方案。[阅读博客文章了解更多](https://developers.googleblog.com/en/introducing-gemma-3n/)",
 			ContextLenVal: 8192,
 			MaxOutputVal:  2048,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gemma-3n-e4b-it:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "temperature", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1747776824,
+			CanonicalVal:  "google/gemma-3n-e4b-it",
+			HFIDVal:       "google/gemma-3n-E4B-it",
+			BaseVal:       "google/gemma-3n-e4b-it",
+			FamilyVal:     "gemma-3n",
+			SizeVal:       "E4B",
 		},
 		"gryphe/mythomax-l2-13b": {
 			IDVal:         "gryphe/mythomax-l2-13b",
@@ -1069,8 +1851,16 @@ func init() {
 			DescCNVal:     "Llama 2 13B 表现最佳且最受欢迎的微调模型之一，擅长生成丰富描述和角色扮演。#merge",
 			ContextLenVal: 4096,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000006", Completion: "0.00000006"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mythomax-l2-13b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_a", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Llama2",
+			InstructVal:   "alpaca",
+			ReleasedVal:   1688256000,
+			HFIDVal:       "Gryphe/MythoMax-L2-13b",
+			FamilyVal:     "mythomax-l2",
+			SizeVal:       "13B",
 		},
 		"ibm-granite/granite-4.0-h-micro": {
 			IDVal:         "ibm-granite/granite-4.0-h-micro",
@@ -1080,8 +1870,15 @@ func init() {
 			DescCNVal:     "Granite-4.0-H-Micro 是 IBM Granite 4 系列中的一个 30 亿参数模型。该系列是 IBM 最新发布的模型家族，专为长上下文工具调用场景进行了微调。",
 			ContextLenVal: 131000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000000017", Completion: "0.00000011"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"granite-4.0-h-micro"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "seed", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1760927695,
+			HFIDVal:       "ibm-granite/granite-4.0-h-micro",
+			FamilyVal:     "granite-h-micro",
+			VersionVal:    "4.0",
 		},
 		"inception/mercury": {
 			IDVal:         "inception/mercury",
@@ -1091,8 +1888,14 @@ func init() {
 			DescCNVal:     "Mercury 是全球首款扩散式大语言模型（dLLM）。该模型采用突破性的离散扩散方法，推理速度比 GPT-4.1 Nano 和 Claude 3.5 Haiku 等已优化速度的模型快 5–10 倍，同时性能相当。Mercury 的高速度使开发者能够构建响应迅速的用户体验，适用于语音助手、搜索界面和聊天机器人等场景。更多详情请参阅[博客文章](https://www.inceptionlabs.ai/blog/introducing-mercury)。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000025", Completion: "0.000001"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mercury"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0},
+			ReleasedVal:   1750973026,
+			FamilyVal:     "mercury",
 		},
 		"inception/mercury-coder": {
 			IDVal:         "inception/mercury-coder",
@@ -1102,8 +1905,15 @@ func init() {
 			DescCNVal:     "Mercury Coder 是全球首款扩散式大语言模型（dLLM）。该模型采用突破性的离散扩散方法，运行速度比 Claude 3.5 Haiku 和 GPT-4o Mini 等已优化速度的模型快 5–10 倍，同时性能相当。其卓越的速度使开发者在编码时能保持流畅状态，享受快速的聊天式迭代和响应迅速的代码补全建议。在 Copilot Arena 中，Mercury Coder 在速度方面排名第一，质量方面并列第二。更多详情请参阅[此博客文章](https://www.inceptionlabs.ai/blog/introducing-mercury)。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000025", Completion: "0.000001"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mercury-coder"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0},
+			ReleasedVal:   1746033880,
+			CanonicalVal:  "inception/mercury-coder-small-beta",
+			FamilyVal:     "mercury-coder",
 		},
 		"inflection/inflection-3-pi": {
 			IDVal:         "inflection/inflection-3-pi",
@@ -1113,8 +1923,14 @@ func init() {
 			DescCNVal:     "Inflection 3 Pi 为 Inflection 的 [Pi](https://pi.ai) 聊天机器人提供支持，涵盖背景故事、情感智能、生产力和安全性。该模型可访问最新新闻，在客户服务和角色扮演等场景中表现卓越。\n\nPi 经过训练可模仿您的语气和风格——若您使用更多表情符号，Pi 也会如此！不妨尝试各种提示词和对话风格。",
 			ContextLenVal: 8000,
 			MaxOutputVal:  1024,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.00001"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"inflection-3-pi"},
+			ParamList:     []string{"max_tokens", "stop", "temperature", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1728604800,
+			FamilyVal:     "inflection-pi",
+			VersionVal:    "3",
 		},
 		"inflection/inflection-3-productivity": {
 			IDVal:         "inflection/inflection-3-productivity",
@@ -1124,8 +1940,14 @@ func init() {
 			DescCNVal:     "Inflection 3 Productivity 针对指令遵循进行了优化，更适合需要 JSON 输出或严格遵循指定指南的任务。该模型可访问最新新闻。\n\n如需类似 Pi 的情感智能，请参阅 [Inflection 3 Pi](/inflection/inflection-3-pi)。\n\n更多详情请见 [Inflection 官方公告](https://inflection.ai/blog/enterprise)。",
 			ContextLenVal: 8000,
 			MaxOutputVal:  1024,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.00001"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"inflection-3-productivity"},
+			ParamList:     []string{"max_tokens", "stop", "temperature", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1728604800,
+			FamilyVal:     "inflection-productivity",
+			VersionVal:    "3",
 		},
 		"kwaipilot/kat-coder-pro": {
 			IDVal:         "kwaipilot/kat-coder-pro",
@@ -1135,8 +1957,14 @@ func init() {
 			DescCNVal:     "KAT-Coder-Pro V1 是快手 KwaiKAT 推出的 KAT-Coder 系列中最先进的智能体编程模型，专为智能体编程任务设计，在真实软件工程场景中表现卓越，在 SWE-Bench Verified 基准测试中达到 73.4% 的解决率。\n\n该模型通过多阶段训练流程（包括中期训练、监督微调（SFT）、强化微调（RFT）及可扩展智能体强化学习）优化了工具使用能力、多轮交互、指令遵循、泛化能力及综合性能。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  128000,
+			PricingVal:    Pricing{Prompt: "0.000000207", Completion: "0.000000828", InputCacheRead: "0.0000000414"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"kat-coder-pro"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1762745912,
+			CanonicalVal:  "kwaipilot/kat-coder-pro-v1",
+			FamilyVal:     "kat-coder-pro",
 		},
 		"liquid/lfm-2.2-6b": {
 			IDVal:         "liquid/lfm-2.2-6b",
@@ -1146,8 +1974,16 @@ func init() {
 			DescCNVal:     "LFM2 是 Liquid AI 开发的新一代混合模型，专为边缘 AI 和端侧部署而设计，在质量、速度和内存效率方面树立了新标准。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000001", Completion: "0.00000002"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"lfm-2.2-6b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1760970889,
+			HFIDVal:       "LiquidAI/LFM2-2.6B",
+			FamilyVal:     "lfm",
+			VersionVal:    "2.2",
+			SizeVal:       "6B",
 		},
 		"liquid/lfm-2.5-1.2b-instruct:free": {
 			IDVal:         "liquid/lfm-2.5-1.2b-instruct:free",
@@ -1157,8 +1993,17 @@ func init() {
 			DescCNVal:     "LFM2.5-1.2B-Instruct 是一款紧凑型高性能指令微调模型，专为快速端侧 AI 而设计。该模型在仅 12 亿参数的体积下提供出色的对话质量，并支持高效的边缘推理和广泛的运行时环境。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"lfm-2.5-1.2b-instruct:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1768927521,
+			CanonicalVal:  "liquid/lfm-2.5-1.2b-instruct-20260120",
+			HFIDVal:       "LiquidAI/LFM2.5-1.2B-Instruct",
+			FamilyVal:     "lfm",
+			VersionVal:    "2.5",
+			SizeVal:       "1.2B",
 		},
 		"liquid/lfm-2.5-1.2b-thinking:free": {
 			IDVal:         "liquid/lfm-2.5-1.2b-thinking:free",
@@ -1168,8 +2013,17 @@ func init() {
 			DescCNVal:     "LFM2.5-1.2B-Thinking 是一款轻量级推理专用模型，针对智能体任务、数据提取和检索增强生成（RAG）进行了优化，同时仍可在边缘设备上流畅运行。该模型支持长上下文（最高达 32K tokens），旨在以小巧的 12 亿参数规模提供更高质量的“思考型”响应。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"lfm-2.5-1.2b-thinking:free"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1768927527,
+			CanonicalVal:  "liquid/lfm-2.5-1.2b-thinking-20260120",
+			HFIDVal:       "LiquidAI/LFM2.5-1.2B-Thinking",
+			FamilyVal:     "lfm-thinking",
+			VersionVal:    "2.5",
+			SizeVal:       "1.2B",
 		},
 		"liquid/lfm2-8b-a1b": {
 			IDVal:         "liquid/lfm2-8b-a1b",
@@ -1179,8 +2033,16 @@ func init() {
 			DescCNVal:     "通过收件箱界面创建的模型",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000001", Completion: "0.00000002"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"lfm2-8b-a1b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1760970984,
+			HFIDVal:       "LiquidAI/LFM2-8B-A1B",
+			FamilyVal:     "lfm",
+			VersionVal:    "2",
+			SizeVal:       "8B",
 		},
 		"mancer/weaver": {
 			IDVal:         "mancer/weaver",
@@ -1190,8 +2052,14 @@ func init() {
 			DescCNVal:     "旨在复现 Claude 风格的详尽表达，但连贯性与记忆能力不及原版，适用于角色扮演或叙事场景。",
 			ContextLenVal: 8000,
 			MaxOutputVal:  2000,
+			PricingVal:    Pricing{Prompt: "0.00000075", Completion: "0.000001"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"weaver"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_a", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Llama2",
+			InstructVal:   "alpaca",
+			ReleasedVal:   1690934400,
+			FamilyVal:     "weaver",
 		},
 		"meituan/longcat-flash-chat": {
 			IDVal:         "meituan/longcat-flash-chat",
@@ -1201,8 +2069,14 @@ func init() {
 			DescCNVal:     "LongCat-Flash-Chat 是一个大规模混合专家（MoE）模型，总参数量达5600亿，每输入动态激活186亿至313亿参数（平均约270亿）。其创新性地采用捷径连接的MoE架构，有效降低通信开销，在保证训练稳定性的同时实现高吞吐量，这得益于超参迁移、确定性计算和多阶段优化等先进扩展策略。\n\n本次发布的 LongCat-Flash-Chat 是一款非推理型基础模型，专为对话与智能体任务优化。支持最长128K token上下文窗口，在推理、编程、指令遵循及领域基准测试中表现优异，尤其擅长工具调用与复杂多步交互场景。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000008", InputCacheRead: "0.0000002"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"longcat-flash-chat"},
+			ParamList:     []string{"max_tokens", "temperature", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1757427658,
+			HFIDVal:       "meituan-longcat/LongCat-Flash-Chat",
+			FamilyVal:     "longcat-flash-chat",
 		},
 		"meta-llama/llama-3-70b-instruct": {
 			IDVal:         "meta-llama/llama-3-70b-instruct",
@@ -1212,8 +2086,17 @@ func init() {
 			DescCNVal:     "Meta 最新推出的 Llama 3 系列模型包含多种规模与版本。此 70B 指令微调版本专为高质量对话场景优化。\n\n在人工评估中，其表现优于主流闭源模型。\n\n了解更多模型发布信息，请[点击此处](https://ai.meta.com/blog/meta-llama-3/)。使用本模型需遵守 [Meta 可接受使用政策](https://llama.meta.com/llama3/use-policy/)。",
 			ContextLenVal: 8192,
 			MaxOutputVal:  8000,
+			PricingVal:    Pricing{Prompt: "0.00000051", Completion: "0.00000074"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3-70b-instruct"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1713398400,
+			HFIDVal:       "meta-llama/Meta-Llama-3-70B-Instruct",
+			FamilyVal:     "llama",
+			VersionVal:    "3",
+			SizeVal:       "70B",
 		},
 		"meta-llama/llama-3-8b-instruct": {
 			IDVal:         "meta-llama/llama-3-8b-instruct",
@@ -1223,8 +2106,17 @@ func init() {
 			DescCNVal:     "Meta 最新推出的 Llama 3 系列模型包含多种规模与版本。此 8B 指令微调版本专为高质量对话场景优化。\n\n在人工评估中，其表现优于主流闭源模型。\n\n了解更多模型发布信息，请[点击此处](https://ai.meta.com/blog/meta-llama-3/)。使用本模型需遵守 [Meta 可接受使用政策](https://llama.meta.com/llama3/use-policy/)。",
 			ContextLenVal: 8192,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000003", Completion: "0.00000006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3-8b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1713398400,
+			HFIDVal:       "meta-llama/Meta-Llama-3-8B-Instruct",
+			FamilyVal:     "llama",
+			VersionVal:    "3",
+			SizeVal:       "8B",
 		},
 		"meta-llama/llama-3.1-405b": {
 			IDVal:         "meta-llama/llama-3.1-405b",
@@ -1234,8 +2126,17 @@ func init() {
 			DescCNVal:     "Meta 最新推出的 Llama 3.1 系列模型包含多种规模与版本，此为 405B 参数的基础预训练版本。\n\n在人工评估中，该模型展现出优于主流闭源模型的强劲性能。\n\n欲了解模型发布的更多信息，请[点击此处](https://ai.meta.com/blog/meta-llama-3/)。使用本模型需遵守 [Meta 可接受使用政策](https://llama.meta.com/llama3/use-policy/)。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.000004", Completion: "0.000004"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.1-405b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "none",
+			ReleasedVal:   1722556800,
+			HFIDVal:       "meta-llama/llama-3.1-405B",
+			FamilyVal:     "llama",
+			VersionVal:    "3.1",
+			SizeVal:       "405B",
 		},
 		"meta-llama/llama-3.1-405b-instruct": {
 			IDVal:         "meta-llama/llama-3.1-405b-instruct",
@@ -1245,8 +2146,20 @@ func init() {
 			DescCNVal:     "万众期待的 Llama 3 百亿级（400B）模型现已发布！支持 128k 上下文长度，并在多项评测中表现卓越，Meta AI 团队持续推动开源大语言模型的前沿边界。\n\nMeta 最新推出的 Llama 3.1 系列模型包含多种规模与版本，此 405B 指令微调版本专为高质量对话场景优化。\n\n在评测中，其性能显著优于包括 GPT-4o 和 Claude 3.5 Sonnet 在内的主流闭源模型。\n\n欲了解模型发布的更多信息，请[点击此处](https://ai.meta.com/blog/meta-llama-3-1/)。使用本模型需遵守 [Meta 可接受使用政策](https://llama.meta.com/llama3/use-policy/)。",
 			ContextLenVal: 10000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000035", Completion: "0.0000035"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.1-405b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1721692800,
+			ExpiresVal:    1770336000,
+			StatusVal:     StatusDeprecated,
+			HFIDVal:       "meta-llama/Meta-Llama-3.1-405B-Instruct",
+			VariantList:   []string{"meta-llama/llama-3.1-405b-instruct:free"},
+			FamilyVal:     "llama-instruct",
+			VersionVal:    "3.1",
+			SizeVal:       "405B",
 		},
 		"meta-llama/llama-3.1-405b-instruct:free": {
 			IDVal:         "meta-llama/llama-3.1-405b-instruct:free",
@@ -1256,8 +2169,19 @@ func init() {
 			DescCNVal:     "万众期待的 Llama 3 百亿级（400B）模型现已发布！支持 128k 上下文长度，并在多项评测中表现卓越，Meta AI 团队持续推动开源大语言模型的前沿边界。\n\nMeta 最新推出的 Llama 3.1 系列模型包含多种规模与版本，此 405B 指令微调版本专为高质量对话场景优化。\n\n在评测中，其性能显著优于包括 GPT-4o 和 Claude 3.5 Sonnet 在内的主流闭源模型。\n\n欲了解模型发布的更多信息，请[点击此处](https://ai.meta.com/blog/meta-llama-3-1/)。使用本模型需遵守 [Meta 可接受使用政策](https://llama.meta.com/llama3/use-policy/)。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.1-405b-instruct:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "temperature"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1721692800,
+			CanonicalVal:  "meta-llama/llama-3.1-405b-instruct",
+			HFIDVal:       "meta-llama/Meta-Llama-3.1-405B-Instruct",
+			BaseVal:       "meta-llama/llama-3.1-405b-instruct",
+			FamilyVal:     "llama-instruct",
+			VersionVal:    "3.1",
+			SizeVal:       "405B",
 		},
 		"meta-llama/llama-3.1-70b-instruct": {
 			IDVal:         "meta-llama/llama-3.1-70b-instruct",
@@ -1267,8 +2191,17 @@ func init() {
 			DescCNVal:     "Meta 最新推出的 Llama 3.1 系列模型包含多种规模与版本，此 70B 指令微调版本专为高质量对话场景优化。\n\n在人工评估中，该模型展现出优于主流闭源模型的强劲性能。\n\n欲了解模型发布的更多信息，请[点击此处](https://ai.meta.com/blog/meta-llama-3-1/)。使用本模型需遵守 [Meta 可接受使用政策](https://llama.meta.com/llama3/use-policy/)。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.0000004"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.1-70b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1721692800,
+			HFIDVal:       "meta-llama/Meta-Llama-3.1-70B-Instruct",
+			FamilyVal:     "llama",
+			VersionVal:    "3.1",
+			SizeVal:       "70B",
 		},
 		"meta-llama/llama-3.1-8b-instruct": {
 			IDVal:         "meta-llama/llama-3.1-8b-instruct",
@@ -1278,8 +2211,17 @@ func init() {
 			DescCNVal:     "Meta 最新推出的 Llama 3.1 系列模型包含多种规模与版本，此 8B 指令微调版本兼具速度与效率。\n\n在人工评估中，该模型展现出优于主流闭源模型的强劲性能。\n\n欲了解模型发布的更多信息，请[点击此处](https://ai.meta.com/blog/meta-llama-3-1/)。使用本模型需遵守 [Meta 可接受使用政策](https://llama.meta.com/llama3/use-policy/)。",
 			ContextLenVal: 16384,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000002", Completion: "0.00000005"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.1-8b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1721692800,
+			HFIDVal:       "meta-llama/Meta-Llama-3.1-8B-Instruct",
+			FamilyVal:     "llama",
+			VersionVal:    "3.1",
+			SizeVal:       "8B",
 		},
 		"meta-llama/llama-3.2-11b-vision-instruct": {
 			IDVal:         "meta-llama/llama-3.2-11b-vision-instruct",
@@ -1289,8 +2231,17 @@ func init() {
 			DescCNVal:     "Llama 3.2 11B Vision 是一款拥有 110 亿参数的多模态模型，专为处理视觉与文本融合任务而设计，在图像描述生成和视觉问答等任务中表现卓越，有效弥合了语言生成与视觉推理之间的鸿沟。该模型在海量图文对数据集上预训练，在复杂高精度图像分析任务中表现出色。\n\n其将视觉理解与语言处理相结合的能力，使其成为内容创作、AI 驱动的客户服务及科研等需要综合视觉-语言 AI 应用领域的理想解决方案。\n\n点击[此处](https://github.com/meta-llama/llama-models/blob/main/models/llama3_2/MODEL_CARD_VISION.md)查看原始模型卡。\n\n使用本模型需遵守 [Meta 可接受使用政策](https://www.llama.com/llama3/use-policy/)。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.000000049", Completion: "0.000000049"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"llama-3.2-11b-vision-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1727222400,
+			HFIDVal:       "meta-llama/Llama-3.2-11B-Vision-Instruct",
+			FamilyVal:     "llama-vision",
+			VersionVal:    "3.2",
+			SizeVal:       "11B",
 		},
 		"meta-llama/llama-3.2-1b-instruct": {
 			IDVal:         "meta-llama/llama-3.2-1b-instruct",
@@ -1300,8 +2251,17 @@ func init() {
 			DescCNVal:     "Llama 3.2 1B 是一款拥有 10 亿参数的语言模型，专注于高效执行自然语言任务，如摘要生成、对话交互和多语言文本分析。其较小的体量使其能在低资源环境中高效运行，同时保持出色的性能。\n\n该模型支持八种核心语言，并可微调以支持更多语言，是寻求轻量级但功能强大的 AI 解决方案的企业或开发者的理想选择，可在多样化的多语言场景中运行，且无需大型模型所需的高计算资源。\n\n点击此处查看[原始模型卡](https://github.com/meta-llama/llama-models/blob/main/models/llama3_2/MODEL_CARD.md)。\n\n使用本模型需遵守 [Meta 可接受使用政策](https://www.llama.com/llama3/use-policy/)。",
 			ContextLenVal: 60000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000000027", Completion: "0.0000002"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.2-1b-instruct"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "seed", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1727222400,
+			HFIDVal:       "meta-llama/Llama-3.2-1B-Instruct",
+			FamilyVal:     "llama",
+			VersionVal:    "3.2",
+			SizeVal:       "1B",
 		},
 		"meta-llama/llama-3.2-3b-instruct": {
 			IDVal:         "meta-llama/llama-3.2-3b-instruct",
@@ -1311,8 +2271,18 @@ func init() {
 			DescCNVal:     "Llama 3.2 3B 是一款拥有 30 亿参数的多语言大语言模型，专为高级自然语言处理任务（如对话生成、推理和摘要）而优化。基于最新的 Transformer 架构，支持包括英语、西班牙语和印地语在内的八种语言，并可适配更多语言。\n\n该模型在 9 万亿 token 上进行训练，在指令遵循、复杂推理和工具调用方面表现出色。其均衡的性能使其成为跨语言文本生成应用的理想选择，兼顾准确性与效率。\n\n点击此处查看[原始模型卡](https://github.com/meta-llama/llama-models/blob/main/models/llama3_2/MODEL_CARD.md)。\n\n使用本模型需遵守 [Meta 可接受使用政策](https://www.llama.com/llama3/use-policy/)。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000002", Completion: "0.00000002"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.2-3b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1727222400,
+			HFIDVal:       "meta-llama/Llama-3.2-3B-Instruct",
+			VariantList:   []string{"meta-llama/llama-3.2-3b-instruct:free"},
+			FamilyVal:     "llama",
+			VersionVal:    "3.2",
+			SizeVal:       "3B",
 		},
 		"meta-llama/llama-3.2-3b-instruct:free": {
 			IDVal:         "meta-llama/llama-3.2-3b-instruct:free",
@@ -1322,8 +2292,19 @@ func init() {
 			DescCNVal:     "Llama 3.2 3B 是一款拥有 30 亿参数的多语言大语言模型，专为高级自然语言处理任务（如对话生成、推理和摘要）而优化。基于最新的 Transformer 架构，支持包括英语、西班牙语和印地语在内的八种语言，并可适配更多语言。\n\n该模型在 9 万亿 token 上进行训练，在指令遵循、复杂推理和工具调用方面表现出色。其均衡的性能使其成为跨语言文本生成应用的理想选择，兼顾准确性与效率。\n\n点击此处查看[原始模型卡](https://github.com/meta-llama/llama-models/blob/main/models/llama3_2/MODEL_CARD.md)。\n\n使用本模型需遵守 [Meta 可接受使用政策](https://www.llama.com/llama3/use-policy/)。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.2-3b-instruct:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1727222400,
+			CanonicalVal:  "meta-llama/llama-3.2-3b-instruct",
+			HFIDVal:       "meta-llama/Llama-3.2-3B-Instruct",
+			BaseVal:       "meta-llama/llama-3.2-3b-instruct",
+			FamilyVal:     "llama",
+			VersionVal:    "3.2",
+			SizeVal:       "3B",
 		},
 		"meta-llama/llama-3.3-70b-instruct": {
 			IDVal:         "meta-llama/llama-3.3-70b-instruct",
@@ -1333,8 +2314,18 @@ func init() {
 			DescCNVal:     "Meta Llama 3.3 多语言大语言模型（LLM）是一款经过预训练和指令微调的 70B 参数生成式模型（纯文本输入/输出）。该模型专为多语言对话场景优化，在主流行业基准测试中性能优于众多现有开源及闭源聊天模型。\n\n支持语言：英语、德语、法语、意大利语、葡萄牙语、印地语、西班牙语和泰语。\n\n[模型卡片](https://github.com/meta-llama/llama-models/blob/main/models/llama3_3/MODEL_CARD.md)",
 			ContextLenVal: 131072,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.00000032"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.3-70b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1733506137,
+			HFIDVal:       "meta-llama/Llama-3.3-70B-Instruct",
+			VariantList:   []string{"meta-llama/llama-3.3-70b-instruct:free"},
+			FamilyVal:     "llama",
+			VersionVal:    "3.3",
+			SizeVal:       "70B",
 		},
 		"meta-llama/llama-3.3-70b-instruct:free": {
 			IDVal:         "meta-llama/llama-3.3-70b-instruct:free",
@@ -1344,8 +2335,19 @@ func init() {
 			DescCNVal:     "Meta Llama 3.3 多语言大语言模型（LLM）是一款经过预训练和指令微调的 70B 参数生成式模型（纯文本输入/输出）。该模型专为多语言对话场景优化，在主流行业基准测试中性能优于众多现有开源及闭源聊天模型。\n\n支持语言：英语、德语、法语、意大利语、葡萄牙语、印地语、西班牙语和泰语。\n\n[模型卡片](https://github.com/meta-llama/llama-models/blob/main/models/llama3_3/MODEL_CARD.md)",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.3-70b-instruct:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1733506137,
+			CanonicalVal:  "meta-llama/llama-3.3-70b-instruct",
+			HFIDVal:       "meta-llama/Llama-3.3-70B-Instruct",
+			BaseVal:       "meta-llama/llama-3.3-70b-instruct",
+			FamilyVal:     "llama",
+			VersionVal:    "3.3",
+			SizeVal:       "70B",
 		},
 		"meta-llama/llama-4-maverick": {
 			IDVal:         "meta-llama/llama-4-maverick",
@@ -1355,8 +2357,16 @@ func init() {
 			DescCNVal:     "Llama 4 Maverick 17B Instruct（128E）是 Meta 推出的高性能多模态语言模型，基于混合专家（MoE）架构，包含 128 个专家，每次前向传播激活 170 亿参数（总计 4000 亿参数）。该模型支持 12 种语言的多语言文本与图像输入，并生成多语言文本与代码输出。Maverick 针对视觉-语言任务进行了优化，通过指令微调实现类助手行为、图像推理及通用多模态交互。\n\nMaverick 采用早期融合（early fusion）实现原生多模态能力，并支持 100 万 token 的上下文窗口。其训练数据涵盖约 22 万亿 token，包括精选的公开数据、授权数据及 Meta 平台数据，知识截止于 2024 年 8 月。该模型于 2025 年 4 月 5 日依据 Llama 4 社区许可证发布，适用于需要高级多模态理解与高吞吐性能的研究及商业应用。",
 			ContextLenVal: 1048576,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"llama-4-maverick"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama4",
+			ReleasedVal:   1743881822,
+			CanonicalVal:  "meta-llama/llama-4-maverick-17b-128e-instruct",
+			HFIDVal:       "meta-llama/Llama-4-Maverick-17B-128E-Instruct",
+			FamilyVal:     "llama-maverick",
+			VersionVal:    "4",
 		},
 		"meta-llama/llama-4-scout": {
 			IDVal:         "meta-llama/llama-4-scout",
@@ -1366,8 +2376,16 @@ func init() {
 			DescCNVal:     "Llama 4 Scout 17B Instruct（16E）是 Meta 开发的混合专家（MoE）语言模型，总参数量为 1090 亿，每次前向传播激活 170 亿参数。该模型支持原生多模态输入（文本与图像）及 12 种语言的多语言输出（文本与代码），专为助手式交互与视觉推理设计，每次前向传播使用 16 个专家，上下文长度达 1000 万 token，训练语料规模约为 40 万亿 token。\n\nLlama 4 Scout 采用早期融合技术以实现无缝模态集成，兼顾高效率与本地或商业部署需求。该模型经过指令微调，适用于多语言对话、图像描述生成及图像理解等任务。依据 Llama 4 社区许可证发布，训练数据截止于 2024 年 8 月，并于 2025 年 4 月 5 日公开发布。",
 			ContextLenVal: 327680,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000008", Completion: "0.0000003"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"llama-4-scout"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama4",
+			ReleasedVal:   1743881519,
+			CanonicalVal:  "meta-llama/llama-4-scout-17b-16e-instruct",
+			HFIDVal:       "meta-llama/Llama-4-Scout-17B-16E-Instruct",
+			FamilyVal:     "llama-scout",
+			VersionVal:    "4",
 		},
 		"meta-llama/llama-guard-2-8b": {
 			IDVal:         "meta-llama/llama-guard-2-8b",
@@ -1377,8 +2395,17 @@ func init() {
 			DescCNVal:     "此安全防护模型拥有 80 亿参数，基于 Llama 3 系列构建。与前代 [LlamaGuard 1](https://huggingface.co/meta-llama/LlamaGuard-7b) 类似，它可对提示（prompt）和响应（response）进行分类。\n\nLlamaGuard 2 的工作方式类似于普通大语言模型，会生成文本以判断给定输入/输出是否安全。若判定为不安全，还会指出违反的内容类别。\n\n为获得最佳效果，请使用原始提示输入或 `/completions` 端点，而非聊天 API。\n\n在人工评估中，其表现优于主流闭源模型。\n\n有关模型发布的更多信息，请[点击此处](https://ai.meta.com/blog/meta-llama-3/)。本模型的使用须遵守 [Meta 可接受使用政策](https://llama.meta.com/llama3/use-policy/)。",
 			ContextLenVal: 8192,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000002"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-guard-2-8b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "none",
+			ReleasedVal:   1715558400,
+			HFIDVal:       "meta-llama/Meta-Llama-Guard-2-8B",
+			FamilyVal:     "llama-guard",
+			VersionVal:    "2",
+			SizeVal:       "8B",
 		},
 		"meta-llama/llama-guard-3-8b": {
 			IDVal:         "meta-llama/llama-guard-3-8b",
@@ -1388,8 +2415,17 @@ func init() {
 			DescCNVal:     "Llama Guard 3 是基于 Llama-3.1-8B 预训练模型微调而成的内容安全分类模型。与早期版本类似，它可用于对大语言模型的输入（提示分类）和输出（响应分类）进行内容安全评估。该模型以文本生成方式输出判断结果，明确指出给定提示或响应是否安全；若不安全，还会列出违反的具体内容类别。\n\nLlama Guard 3 依据 MLCommons 标准化风险分类体系进行对齐，并专为支持 Llama 3.1 的能力而设计。具体而言，它支持 8 种语言的内容审核，并针对搜索及代码解释器工具调用场景的安全性与可靠性进行了优化。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000002", Completion: "0.00000006"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-guard-3-8b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "seed", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "none",
+			ReleasedVal:   1739401318,
+			HFIDVal:       "meta-llama/Llama-Guard-3-8B",
+			FamilyVal:     "llama-guard",
+			VersionVal:    "3",
+			SizeVal:       "8B",
 		},
 		"meta-llama/llama-guard-4-12b": {
 			IDVal:         "meta-llama/llama-guard-4-12b",
@@ -1399,8 +2435,16 @@ func init() {
 			DescCNVal:     "Llama Guard 4 是基于 Llama 4 Scout 衍生的多模态预训练模型，经微调用于内容安全分类。与早期版本类似，它可用于对大语言模型输入（提示分类）和输出（响应分类）进行安全评估。该模型以文本形式输出判断结果，明确指出给定提示或响应是否安全；若不安全，还会列出违反的具体内容类别。\n\nLlama Guard 4 依据标准化的 MLCommons 危害分类体系进行对齐，并专为支持 Llama 4 的多模态能力而设计。具体而言，它融合了前代 Llama Guard 模型的特性，提供对英语及多种支持语言的内容审核能力，并增强了对混合文本与图像提示（包括多图输入）的处理能力。此外，Llama Guard 4 已集成至 Llama Moderations API，为文本和图像提供强大的安全分类支持。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000018", Completion: "0.00000018"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"llama-guard-4-12b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1745975193,
+			HFIDVal:       "meta-llama/Llama-Guard-4-12B",
+			FamilyVal:     "llama-guard",
+			VersionVal:    "4",
+			SizeVal:       "12B",
 		},
 		"microsoft/phi-4": {
 			IDVal:         "microsoft/phi-4",
@@ -1410,8 +2454,15 @@ func init() {
 			DescCNVal:     "[Microsoft Research](/microsoft) Phi-4 专为复杂推理任务设计，可在内存受限或需快速响应的场景中高效运行。\n\n该模型拥有 140 亿参数，训练数据涵盖高质量合成数据集、精选网站内容及学术资料，并经过精细优化以准确遵循指令并维持高标准的安全性。其对英文输入效果最佳。\n\n更多信息请参阅 [Phi-4 技术报告](https://arxiv.org/pdf/2412.08905)",
 			ContextLenVal: 16384,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000006", Completion: "0.00000014"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"phi-4"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1736489872,
+			HFIDVal:       "microsoft/phi-4",
+			FamilyVal:     "phi",
+			VersionVal:    "4",
 		},
 		"microsoft/wizardlm-2-8x22b": {
 			IDVal:         "microsoft/wizardlm-2-8x22b",
@@ -1421,8 +2472,17 @@ func init() {
 			DescCNVal:     "WizardLM-2 8x22B 是微软 AI 推出的最先进的 Wizard 系列模型，在性能上可与领先闭源模型高度竞争，并持续超越所有现有开源顶尖模型。\n\n该模型基于 [Mixtral 8x22B](/models/mistralai/mixtral-8x22b) 进行指令微调。\n\n了解更多模型发布信息，请[点击此处](https://wizardlm.github.io/WizardLM2/)。\n\n#moe",
 			ContextLenVal: 65536,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000048", Completion: "0.00000048"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"wizardlm-2-8x22b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			InstructVal:   "vicuna",
+			ReleasedVal:   1713225600,
+			HFIDVal:       "microsoft/WizardLM-2-8x22B",
+			FamilyVal:     "wizardlm",
+			VersionVal:    "2",
+			SizeVal:       "8x22B",
 		},
 		"minimax/minimax-01": {
 			IDVal:         "minimax/minimax-01",
@@ -1432,8 +2492,15 @@ func init() {
 			DescCNVal:     "MiniMax-01 融合了用于文本生成的 MiniMax-Text-01 与用于图像理解的 MiniMax-VL-01。模型总参数量达 4560 亿，每次推理激活 459 亿参数，支持高达 400 万 token 的上下文长度。\n\n文本模型采用混合架构，结合 Lightning Attention、Softmax Attention 与混合专家（MoE）机制；图像模型采用“ViT-MLP-LLM”框架，并在文本模型基础上进行训练。\n\n更多发布详情请见：https://www.minimaxi.com/en/news/minimax-01-series-2",
 			ContextLenVal: 1000192,
 			MaxOutputVal:  1000192,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000011"},
 			FeaturesVal:   CapChat | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"minimax-01"},
+			ParamList:     []string{"max_tokens", "temperature", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1736915462,
+			HFIDVal:       "MiniMaxAI/MiniMax-Text-01",
+			FamilyVal:     "minimax",
+			VersionVal:    "01",
 		},
 		"minimax/minimax-m1": {
 			IDVal:         "minimax/minimax-m1",
@@ -1443,8 +2510,13 @@ func init() {
 			DescCNVal:     "MiniMax-M1 是一款大规模开源权重推理模型，专为长上下文和高效率推理而设计。该模型采用混合专家（MoE）架构，并结合自研的“闪电注意力”机制，可处理长达100万 token 的序列，同时保持出色的 FLOP 效率。模型总参数量达4560亿，每 token 激活459亿参数，专为复杂多步推理任务优化。\n\n通过自研强化学习流程（CISPO）训练，M1 在长上下文理解、软件工程、智能体工具调用和数学推理方面表现突出。在 FullStackBench、SWE-bench、MATH、GPQA 和 TAU-Bench 等基准测试中成绩优异，常优于 DeepSeek R1 和 Qwen3-235B 等其他开源模型。",
 			ContextLenVal: 1000000,
 			MaxOutputVal:  40000,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.0000022"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"minimax-m1"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1750200414,
+			FamilyVal:     "minimax-m1",
 		},
 		"minimax/minimax-m2": {
 			IDVal:         "minimax/minimax-m2",
@@ -1454,8 +2526,15 @@ func init() {
 			DescCNVal:     "MiniMax-M2 是一款紧凑高效的大型语言模型，针对端到端编码和智能体工作流进行了优化。该模型激活参数达 100 亿（总计 2300 亿），在通用推理、工具调用和多步骤任务执行方面提供接近前沿的智能水平，同时保持低延迟和高部署效率。\n\n该模型在代码生成、多文件编辑、编译-运行-修复循环以及测试验证修复等任务上表现卓越，在 SWE-Bench Verified、Multi-SWE-Bench 和 Terminal-Bench 基准测试中取得优异成绩。在 BrowseComp 和 GAIA 等智能体评估中也具有竞争力，能有效处理长周期规划、信息检索及执行错误恢复。\n\n据 [Artificial Analysis](https://artificialanalysis.ai/models/minimax-m2) 测评，MiniMax-M2 在综合智能（涵盖数学、科学和指令遵循）方面位列顶尖开源模型之列。其较小的激活占用空间支持快速推理、高并发处理和更优的单位经济性，非常适合大规模智能体、开发者助手及对响应速度和成本效益有高要求的推理驱动型应用。\n\n为避免性能下降，MiniMax 强烈建议在对话轮次间保留推理过程。更多关于如何使用 reasoning_details 回传推理内容的信息，请参阅我们的 [文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#preserving-reasoning-blocks)。",
 			ContextLenVal: 196608,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.000001", InputCacheRead: "0.00000003"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"minimax-m2"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 1, "top_p": 0.95},
+			ReleasedVal:   1761252093,
+			HFIDVal:       "MiniMaxAI/MiniMax-M2",
+			FamilyVal:     "minimax-m2",
 		},
 		"minimax/minimax-m2-her": {
 			IDVal:         "minimax/minimax-m2-her",
@@ -1465,8 +2544,15 @@ func init() {
 			DescCNVal:     "MiniMax M2-her 是一款以对话为核心的大型语言模型，专为沉浸式角色扮演、角色驱动聊天和富有表现力的多轮对话而构建。该模型在语气与个性上保持高度一致，支持丰富的消息角色（user_system、group、sample_message_user、sample_message_ai），并能从示例对话中学习，以更精准地匹配用户场景的风格与节奏，是注重自然流畅性和生动交互性的故事叙述、虚拟陪伴及对话体验的理想选择。",
 			ContextLenVal: 65536,
 			MaxOutputVal:  2048,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000012", InputCacheRead: "0.00000003"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"minimax-m2-her"},
+			ParamList:     []string{"max_tokens", "temperature", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 1, "top_p": 0.95},
+			ReleasedVal:   1769177239,
+			CanonicalVal:  "minimax/minimax-m2-her-20260123",
+			FamilyVal:     "minimax-m2-her",
 		},
 		"minimax/minimax-m2.1": {
 			IDVal:         "minimax/minimax-m2.1",
@@ -1476,8 +2562,15 @@ func init() {
 			DescCNVal:     "MiniMax-M2.1 是一款轻量级、业界领先的大型语言模型，针对编程、智能体工作流及现代应用开发进行了优化。仅激活 100 亿参数，即可实现真实场景能力的显著跃升，同时保持卓越的延迟表现、可扩展性与成本效益。\n\n相比前代模型，M2.1 输出更简洁清晰，感知响应速度更快。其在主流系统与应用语言中展现出领先的多语言编程性能，在 Multi-SWE-Bench 上达到 49.4%，在 SWE-Bench Multilingual 上达到 72.5%，可作为 IDE、编程工具及通用助手中的多功能智能体“大脑”。\n\n为避免性能下降，MiniMax 强烈建议在对话轮次间保留推理过程。更多关于如何通过 reasoning_details 传递推理信息的内容，请参阅[文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#preserving-reasoning-blocks)。",
 			ContextLenVal: 196608,
 			MaxOutputVal:  196608,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000027", Completion: "0.0000011"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"minimax-m2.1"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 1, "top_p": 0.9},
+			ReleasedVal:   1766454997,
+			HFIDVal:       "MiniMaxAI/MiniMax-M2.1",
+			FamilyVal:     "minimax-m2.1",
 		},
 		"mistralai/codestral-2508": {
 			IDVal:         "mistralai/codestral-2508",
@@ -1487,8 +2580,15 @@ func init() {
 			DescCNVal:     "Mistral 于 2025 年 7 月底发布的前沿代码语言模型。Codestral 专为低延迟、高频率任务设计，例如中间填充（FIM）、代码修正和测试生成。\n\n[博客文章](https://mistral.ai/news/codestral-25-08)",
 			ContextLenVal: 256000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000009"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"codestral-2508"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1754079630,
+			FamilyVal:     "codestral",
+			SnapshotVal:   1754006400,
 		},
 		"mistralai/devstral-2512": {
 			IDVal:         "mistralai/devstral-2512",
@@ -1498,8 +2598,17 @@ func init() {
 			DescCNVal:     "Devstral 2 是 Mistral AI 推出的前沿开源模型，专精于智能体编程。该模型为 1230 亿参数的稠密 Transformer 架构，支持 256K 上下文窗口。\n\nDevstral 2 能够在保持架构级上下文的前提下探索代码库并协调多文件变更。它可追踪框架依赖关系、检测失败并自动重试修正，有效应对缺陷修复和遗留系统现代化等挑战。该模型支持微调以优先处理特定语言或针对大型企业代码库进行优化，并采用修改版 MIT 许可证发布。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  65536,
+			PricingVal:    Pricing{Prompt: "0.00000005", Completion: "0.00000022"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"devstral-2512"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1765285419,
+			HFIDVal:       "mistralai/Devstral-2-123B-Instruct-2512",
+			VariantList:   []string{"mistralai/devstral-2512:free"},
+			FamilyVal:     "devstral",
+			SnapshotVal:   1764547200,
 		},
 		"mistralai/devstral-2512:free": {
 			IDVal:         "mistralai/devstral-2512:free",
@@ -1509,8 +2618,15 @@ func init() {
 			DescCNVal:     "Devstral 2 是 Mistral AI 推出的前沿开源模型，专精于智能体编程。该模型为 1230 亿参数的稠密 Transformer 架构，支持 256K 上下文窗口。\n\nDevstral 2 能够在保持架构级上下文的前提下探索代码库并协调多文件变更。它可追踪框架依赖关系、检测失败并自动重试修正，有效应对缺陷修复和遗留系统现代化等挑战。该模型支持微调以优先处理特定语言或针对大型企业代码库进行优化，并采用修改版 MIT 许可证发布。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"devstral-2512:free"},
+			ParamList:     []string{},
+			StatusVal:     StatusRetired,
+			ReplacedByVal: "mistralai/devstral-2512",
+			BaseVal:       "mistralai/devstral-2512",
+			FamilyVal:     "devstral",
+			SnapshotVal:   1764547200,
 		},
 		"mistralai/devstral-medium": {
 			IDVal:         "mistralai/devstral-medium",
@@ -1520,8 +2636,15 @@ func init() {
 			DescCNVal:     "Devstral Medium 是由 Mistral AI 与 All Hands AI 联合开发的高性能代码生成与智能体推理模型。作为 Devstral Small 的升级版，其在 SWE-Bench Verified 基准上达到 61.6% 的准确率，超越 Gemini 2.5 Pro 与 GPT-4.1 等模型，同时成本显著更低。该模型专为在各类代码智能体与框架中泛化不同提示风格及工具使用而设计。\n\nDevstral Medium 仅通过 API 提供（非开源权重），支持在私有基础设施上进行企业级部署，并可选配微调功能。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.000002"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"devstral-medium"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1752161321,
+			CanonicalVal:  "mistralai/devstral-medium-2507",
+			FamilyVal:     "devstral-medium",
 		},
 		"mistralai/devstral-small": {
 			IDVal:         "mistralai/devstral-small",
@@ -1531,8 +2654,16 @@ func init() {
 			DescCNVal:     "Devstral Small 1.1 是由 Mistral AI 与 All Hands AI 联合开发的 240 亿参数开源语言模型，专为软件工程智能体打造。该模型基于 Mistral Small 3.1 微调而成，采用 Apache 2.0 许可证发布，支持 128K 令牌上下文窗口，并兼容 Mistral 风格的函数调用与 XML 输出格式。\n\n专为智能体编码工作流设计，Devstral Small 1.1 针对代码库探索、多文件编辑及集成至 OpenHands、Cline 等自主开发智能体等任务进行了优化。其在 SWE-Bench Verified 基准上取得 53.6% 的成绩，超越所有其他开源模型，同时足够轻量，可在单张 RTX 4090 GPU 或 Apple Silicon 设备上运行。该模型采用 Tekken 分词器，词表大小达 131K，可通过 vLLM、Transformers、Ollama、LM Studio 及其他兼容 OpenAI 的运行时部署。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000003"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"devstral-small"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1752160751,
+			CanonicalVal:  "mistralai/devstral-small-2507",
+			HFIDVal:       "mistralai/Devstral-Small-2507",
+			FamilyVal:     "devstral-small",
 		},
 		"mistralai/ministral-14b-2512": {
 			IDVal:         "mistralai/ministral-14b-2512",
@@ -1542,8 +2673,17 @@ func init() {
 			DescCNVal:     "Ministral 3 系列中最大的模型 Ministral 3 14B 具备前沿能力，性能可媲美更大的 Mistral Small 3.2 24B 模型，是一款兼具强大性能与高效性的多模态语言模型。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000002"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"ministral-14b-2512"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1764681735,
+			HFIDVal:       "mistralai/Ministral-3-14B-Instruct-2512",
+			FamilyVal:     "ministral",
+			SizeVal:       "14B",
+			SnapshotVal:   1764547200,
 		},
 		"mistralai/ministral-3b": {
 			IDVal:         "mistralai/ministral-3b",
@@ -1553,8 +2693,15 @@ func init() {
 			DescCNVal:     "Ministral 3B 是一款拥有 30 亿参数的模型，专为设备端和边缘计算优化。它在知识理解、常识推理和函数调用方面表现卓越，在多数基准测试中优于 Mistral 7B 等更大模型。支持高达 128K 的上下文长度，非常适合编排智能体工作流及高效执行专业任务。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000004", Completion: "0.00000004"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"ministral-3b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1729123200,
+			FamilyVal:     "ministral",
+			SizeVal:       "3B",
 		},
 		"mistralai/ministral-3b-2512": {
 			IDVal:         "mistralai/ministral-3b-2512",
@@ -1564,8 +2711,17 @@ func init() {
 			DescCNVal:     "Ministral 3 系列中最小的模型 Ministral 3 3B 是一款高效、强大的微型多模态语言模型。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000001"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"ministral-3b-2512"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1764681560,
+			HFIDVal:       "mistralai/Ministral-3-3B-Instruct-2512",
+			FamilyVal:     "ministral",
+			SizeVal:       "3B",
+			SnapshotVal:   1764547200,
 		},
 		"mistralai/ministral-8b": {
 			IDVal:         "mistralai/ministral-8b",
@@ -1575,8 +2731,15 @@ func init() {
 			DescCNVal:     "Ministral 8B 是一款拥有 80 亿参数的模型，采用独特的交错滑动窗口注意力机制，实现更快、更节省内存的推理。专为边缘应用场景设计，支持高达 128K 的上下文长度，在知识理解和推理任务中表现优异。在 100 亿参数以下模型中性能领先，是低延迟、注重隐私应用的理想之选。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000001"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"ministral-8b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1729123200,
+			FamilyVal:     "ministral",
+			SizeVal:       "8B",
 		},
 		"mistralai/ministral-8b-2512": {
 			IDVal:         "mistralai/ministral-8b-2512",
@@ -1586,8 +2749,17 @@ func init() {
 			DescCNVal:     "Ministral 3 系列中的均衡之选 Ministral 3 8B 是一款高效、强大的微型多模态语言模型。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.00000015"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"ministral-8b-2512"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1764681654,
+			HFIDVal:       "mistralai/Ministral-3-8B-Instruct-2512",
+			FamilyVal:     "ministral",
+			SizeVal:       "8B",
+			SnapshotVal:   1764547200,
 		},
 		"mistralai/mistral-7b-instruct": {
 			IDVal:         "mistralai/mistral-7b-instruct",
@@ -1597,8 +2769,17 @@ func init() {
 			DescCNVal:     "这是一款高性能、业界标准的 73 亿参数模型，针对速度和上下文长度进行了优化。\n\n*Mistral 7B Instruct 包含多个版本变体，此处指最新版本。*",
 			ContextLenVal: 32768,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000002"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-7b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			InstructVal:   "mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1716768000,
+			HFIDVal:       "mistralai/Mistral-7B-Instruct-v0.3",
+			FamilyVal:     "mistral",
+			SizeVal:       "7B",
 		},
 		"mistralai/mistral-7b-instruct-v0.1": {
 			IDVal:         "mistralai/mistral-7b-instruct-v0.1",
@@ -1608,8 +2789,18 @@ func init() {
 			DescCNVal:     "一款拥有 73 亿参数的模型，在所有基准测试中均优于 Llama 2 13B，并针对推理速度和上下文长度进行了优化。",
 			ContextLenVal: 2824,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000011", Completion: "0.00000019"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-7b-instruct-v0.1"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "seed", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			InstructVal:   "mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1695859200,
+			HFIDVal:       "mistralai/Mistral-7B-Instruct-v0.1",
+			FamilyVal:     "mistral",
+			VersionVal:    "0.1",
+			SizeVal:       "7B",
 		},
 		"mistralai/mistral-7b-instruct-v0.2": {
 			IDVal:         "mistralai/mistral-7b-instruct-v0.2",
@@ -1619,8 +2810,19 @@ func init() {
 			DescCNVal:     "一款高性能、行业标准的 73 亿参数模型，针对推理速度和上下文长度进行了优化。\n\n这是 [Mistral 7B Instruct](/modelsmistralai/mistral-7b-instruct-v0.1) 的改进版本，主要变更包括：\n\n- 上下文窗口扩展至 32k（v0.1 为 8k）\n- Rope-theta = 1e6\n- 移除了滑动窗口注意力机制",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000002"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-7b-instruct-v0.2"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			InstructVal:   "mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1703721600,
+			HFIDVal:       "mistralai/Mistral-7B-Instruct-v0.2",
+			FamilyVal:     "mistral",
+			VersionVal:    "0.2",
+			SizeVal:       "7B",
+			EquivList:     []string{"mistralai/mistral-tiny"},
 		},
 		"mistralai/mistral-7b-instruct-v0.3": {
 			IDVal:         "mistralai/mistral-7b-instruct-v0.3",
@@ -1630,8 +2832,18 @@ func init() {
 			DescCNVal:     "这是一款高性能、业界标准的 73 亿参数模型，针对速度和上下文长度进行了优化。\n\n这是 [Mistral 7B Instruct v0.2](/models/mistralai/mistral-7b-instruct-v0.2) 的改进版本，主要变更包括：\n\n- 词表扩展至 32768\n- 支持 v3 分词器\n- 支持函数调用\n\n注意：函数调用支持取决于具体服务提供商。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000002"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-7b-instruct-v0.3"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			InstructVal:   "mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1716768000,
+			HFIDVal:       "mistralai/Mistral-7B-Instruct-v0.3",
+			FamilyVal:     "mistral",
+			VersionVal:    "0.3",
+			SizeVal:       "7B",
 		},
 		"mistralai/mistral-large": {
 			IDVal:         "mistralai/mistral-large",
@@ -1641,8 +2853,15 @@ func init() {
 			DescCNVal:     "Mistral Large 2（版本 `mistral-large-2407`）是 Mistral AI 的旗舰模型。作为一款提供权重的专有模型，它在推理、代码、JSON、聊天等任务上表现出色。发布详情请见[此处](https://mistral.ai/news/mistral-large-2407/)。\n\n支持数十种语言，包括法语、德语、西班牙语、意大利语、葡萄牙语、阿拉伯语、印地语、俄语、中文、日语和韩语，以及 80 多种编程语言，如 Python、Java、C、C++、JavaScript 和 Bash。其超长上下文窗口可从大型文档中精准提取信息。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-large"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1708905600,
+			FamilyVal:     "mistral-large",
+			EquivList:     []string{"mistralai/mistral-large-2407"},
 		},
 		"mistralai/mistral-large-2407": {
 			IDVal:         "mistralai/mistral-large-2407",
@@ -1652,8 +2871,16 @@ func init() {
 			DescCNVal:     "这是 Mistral AI 的旗舰模型 Mistral Large 2（版本 mistral-large-2407）。该模型为闭源权重可用模型，在推理、代码、JSON、对话等方面表现卓越。发布详情请参阅[此处](https://mistral.ai/news/mistral-large-2407/)。\n\n支持数十种语言，包括法语、德语、西班牙语、意大利语、葡萄牙语、阿拉伯语、印地语、俄语、中文、日语和韩语，并支持 80 多种编程语言，如 Python、Java、C、C++、JavaScript 和 Bash。其长上下文窗口可精准从大型文档中检索信息。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-large-2407"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1731978415,
+			FamilyVal:     "mistral-large",
+			SnapshotVal:   1719792000,
+			EquivList:     []string{"mistralai/mistral-large"},
 		},
 		"mistralai/mistral-large-2411": {
 			IDVal:         "mistralai/mistral-large-2411",
@@ -1663,8 +2890,15 @@ func init() {
 			DescCNVal:     "Mistral Large 2 2411 是与 [Pixtral Large 2411](/mistralai/pixtral-large-2411) 同时发布的 [Mistral Large 2](/mistralai/mistral-large) 的更新版本。\n\n相比此前的 [Mistral Large 24.07](/mistralai/mistral-large-2407)，它在长上下文理解、新系统提示以及函数调用准确性方面均有显著提升。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-large-2411"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1731978685,
+			FamilyVal:     "mistral-large",
+			SnapshotVal:   1730419200,
 		},
 		"mistralai/mistral-large-2512": {
 			IDVal:         "mistralai/mistral-large-2512",
@@ -1674,8 +2908,15 @@ func init() {
 			DescCNVal:     "Mistral Large 3 2512 是 Mistral 迄今为止最强大的模型，采用稀疏混合专家架构，激活参数达 410 亿（总计 6750 亿），并以 Apache 2.0 许可证发布。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000005", Completion: "0.0000015"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"mistral-large-2512"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.0645},
+			ReleasedVal:   1764624472,
+			FamilyVal:     "mistral-large",
+			SnapshotVal:   1764547200,
 		},
 		"mistralai/mistral-medium-3": {
 			IDVal:         "mistralai/mistral-medium-3",
@@ -1685,8 +2926,15 @@ func init() {
 			DescCNVal:     "Mistral Medium 3 是一款高性能的企业级语言模型，旨在以显著降低的运营成本提供前沿级能力。该模型在尖端推理与多模态性能之间取得平衡，成本仅为传统大模型的八分之一，适用于专业及工业场景的大规模部署。\n\n该模型在编程、STEM 推理及企业适配等领域表现卓越，支持混合、本地及 VPC 内部署，并针对自定义工作流集成进行了优化。Mistral Medium 3 在准确性方面可与 Claude Sonnet 3.5/3.7、Llama 4 Maverick 和 Command R+ 等更大模型竞争，同时在各类云环境中保持广泛的兼容性。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.000002"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"mistral-medium-3"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1746627341,
+			FamilyVal:     "mistral-medium",
+			VersionVal:    "3",
 		},
 		"mistralai/mistral-medium-3.1": {
 			IDVal:         "mistralai/mistral-medium-3.1",
@@ -1696,8 +2944,15 @@ func init() {
 			DescCNVal:     "Mistral Medium 3.1 是 Mistral Medium 3 的升级版，是一款高性能企业级语言模型，旨在以显著降低的运营成本提供前沿能力。相比传统大模型，其成本降低 8 倍，同时兼顾顶尖的推理与多模态性能，适用于专业及工业场景的大规模部署。\n\n该模型在编程、STEM 推理和企业适配等领域表现卓越，支持混合部署、本地部署及 VPC 内部署，并针对自定义工作流集成进行了优化。在精度方面可与 Claude Sonnet 3.5/3.7、Llama 4 Maverick 和 Command R+ 等更大模型相媲美，同时保持广泛的云环境兼容性。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.000002"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"mistral-medium-3.1"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1755095639,
+			FamilyVal:     "mistral-medium",
+			VersionVal:    "3.1",
 		},
 		"mistralai/mistral-nemo": {
 			IDVal:         "mistralai/mistral-nemo",
@@ -1707,8 +2962,16 @@ func init() {
 			DescCNVal:     "Mistral 与 NVIDIA 联合开发的 120 亿参数模型，支持 128k token 上下文长度。\n\n该模型支持多语言，包括英语、法语、德语、西班牙语、意大利语、葡萄牙语、中文、日语、韩语、阿拉伯语和印地语。\n\n支持函数调用，并以 Apache 2.0 许可证发布。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000002", Completion: "0.00000004"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-nemo"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			InstructVal:   "mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1721347200,
+			HFIDVal:       "mistralai/Mistral-Nemo-Instruct-2407",
+			FamilyVal:     "mistral-nemo",
 		},
 		"mistralai/mistral-saba": {
 			IDVal:         "mistralai/mistral-saba",
@@ -1718,8 +2981,15 @@ func init() {
 			DescCNVal:     "Mistral Saba 是一款专为中东和南亚地区设计的 240 亿参数语言模型，在保持高效性能的同时提供准确且符合本地语境的响应。该模型基于精选的区域性数据集训练，支持多种印度本土语言（包括泰米尔语和马拉雅拉姆语）以及阿拉伯语，适用于广泛的区域性和多语言应用场景。更多详情请参阅[此博客文章](https://mistral.ai/en/news/mistral-saba)",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-saba"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1739803239,
+			CanonicalVal:  "mistralai/mistral-saba-2502",
+			FamilyVal:     "mistral-saba",
 		},
 		"mistralai/mistral-small-24b-instruct-2501": {
 			IDVal:         "mistralai/mistral-small-24b-instruct-2501",
@@ -1729,8 +2999,17 @@ func init() {
 			DescCNVal:     "Mistral Small 3 是一款 240 亿参数的语言模型，针对常见 AI 任务优化了低延迟性能。该模型以 Apache 2.0 许可证发布，提供预训练版和指令微调版，适用于高效的本地部署。\n\n模型在 MMLU 基准测试中达到 81% 的准确率，性能可与 Llama 3.3 70B 和 Qwen 32B 等更大模型竞争，且在同等硬件上运行速度提升三倍。[点击此处阅读模型博客文章。](https://mistral.ai/news/mistral-small-3/)",
 			ContextLenVal: 32768,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.00000003", Completion: "0.00000011"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-small-24b-instruct-2501"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1738255409,
+			HFIDVal:       "mistralai/Mistral-Small-24B-Instruct-2501",
+			FamilyVal:     "mistral-small",
+			SizeVal:       "24B",
+			SnapshotVal:   1735689600,
 		},
 		"mistralai/mistral-small-3.1-24b-instruct": {
 			IDVal:         "mistralai/mistral-small-3.1-24b-instruct",
@@ -1740,8 +3019,19 @@ func init() {
 			DescCNVal:     "Mistral Small 3.1 24B Instruct 是 Mistral Small 3（2501）的升级版本，拥有 240 亿参数并具备先进的多模态能力。该模型在文本推理与视觉任务（包括图像分析、编程、数学推理及数十种语言的多语言支持）方面达到业界领先水平。配备高达 128K token 的上下文窗口，并针对高效本地推理进行优化，适用于对话代理、函数调用、长文档理解及隐私敏感型部署等场景。其更新版本为 [Mistral Small 3.2](mistralai/mistral-small-3.2-24b-instruct)。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
+			PricingVal:    Pricing{Prompt: "0.00000003", Completion: "0.00000011"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"mistral-small-3.1-24b-instruct"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1742238937,
+			CanonicalVal:  "mistralai/mistral-small-3.1-24b-instruct-2503",
+			HFIDVal:       "mistralai/Mistral-Small-3.1-24B-Instruct-2503",
+			VariantList:   []string{"mistralai/mistral-small-3.1-24b-instruct:free"},
+			FamilyVal:     "mistral-small",
+			VersionVal:    "3.1",
+			SizeVal:       "24B",
 		},
 		"mistralai/mistral-small-3.1-24b-instruct:free": {
 			IDVal:         "mistralai/mistral-small-3.1-24b-instruct:free",
@@ -1751,8 +3041,19 @@ func init() {
 			DescCNVal:     "Mistral Small 3.1 24B Instruct 是 Mistral Small 3（2501）的升级版本，拥有 240 亿参数并具备先进的多模态能力。该模型在文本推理与视觉任务（包括图像分析、编程、数学推理及数十种语言的多语言支持）方面达到业界领先水平。配备高达 128K token 的上下文窗口，并针对高效本地推理进行优化，适用于对话代理、函数调用、长文档理解及隐私敏感型部署等场景。其更新版本为 [Mistral Small 3.2](mistralai/mistral-small-3.2-24b-instruct)。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"mistral-small-3.1-24b-instruct:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1742238937,
+			CanonicalVal:  "mistralai/mistral-small-3.1-24b-instruct-2503",
+			HFIDVal:       "mistralai/Mistral-Small-3.1-24B-Instruct-2503",
+			BaseVal:       "mistralai/mistral-small-3.1-24b-instruct",
+			FamilyVal:     "mistral-small",
+			VersionVal:    "3.1",
+			SizeVal:       "24B",
 		},
 		"mistralai/mistral-small-3.2-24b-instruct": {
 			IDVal:         "mistralai/mistral-small-3.2-24b-instruct",
@@ -1762,8 +3063,18 @@ func init() {
 			DescCNVal:     "Mistral-Small-3.2-24B-Instruct-2506 是 Mistral 推出的更新版 240 亿参数模型，专为指令遵循、减少重复输出及改进函数调用而优化。相比 3.1 版本，3.2 版在 WildBench 和 Arena Hard 基准上显著提升准确性，有效抑制无限生成问题，并在工具使用和结构化输出任务中取得明显进步。\n\n该模型支持图文输入与结构化输出、函数/工具调用，在编程（HumanEval+、MBPP）、STEM（MMLU、MATH、GPQA）及视觉（ChartQA、DocVQA）等基准测试中表现优异。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
+			PricingVal:    Pricing{Prompt: "0.00000006", Completion: "0.00000018"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"mistral-small-3.2-24b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1750443016,
+			CanonicalVal:  "mistralai/mistral-small-3.2-24b-instruct-2506",
+			HFIDVal:       "mistralai/Mistral-Small-3.2-24B-Instruct-2506",
+			FamilyVal:     "mistral-small",
+			VersionVal:    "3.2",
+			SizeVal:       "24B",
 		},
 		"mistralai/mistral-small-creative": {
 			IDVal:         "mistralai/mistral-small-creative",
@@ -1773,8 +3084,15 @@ func init() {
 			DescCNVal:     "Mistral Small Creative 是一款实验性小型模型，专为创意写作、叙事生成、角色扮演与人物驱动对话、通用指令遵循及对话代理而设计。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000003"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-small-creative"},
+			ParamList:     []string{"tool_choice", "tools"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3, "top_p": 0.95},
+			ReleasedVal:   1765908653,
+			CanonicalVal:  "mistralai/mistral-small-creative-20251216",
+			FamilyVal:     "mistral-small-creative",
 		},
 		"mistralai/mistral-tiny": {
 			IDVal:         "mistralai/mistral-tiny",
@@ -1784,8 +3102,15 @@ func init() {
 			DescCNVal:     "注意：此模型即将弃用。推荐使用更新的 [Ministral 8B](/mistral/ministral-8b)。\n\n当前该模型基于 Mistral-7B-v0.2 构建，并采用了受社区工作启发的“更优”微调策略，相较于 [Mistral 7B](/models/mistralai/mistral-7b-instruct-v0.1) 有所提升。适用于对成本敏感但对推理能力要求不高的大批量处理任务。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000025", Completion: "0.00000025"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mistral-tiny"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1704844800,
+			FamilyVal:     "mistral-tiny",
+			EquivList:     []string{"mistralai/mistral-7b-instruct-v0.2"},
 		},
 		"mistralai/mixtral-8x22b-instruct": {
 			IDVal:         "mistralai/mixtral-8x22b-instruct",
@@ -1795,8 +3120,17 @@ func init() {
 			DescCNVal:     "Mistral 官方发布的 [Mixtral 8x22B](/models/mistralai/mixtral-8x22b) 指令微调版本。该模型在 141B 总参数中激活 39B 参数，以同等规模实现无与伦比的成本效益。其优势包括：\n- 出色的数学、编程与推理能力\n- 超长上下文长度（64k）\n- 流利支持英语、法语、意大利语、德语和西班牙语\n\n基准测试结果详见发布公告：[此处](https://mistral.ai/news/mixtral-8x22b/)。\n#moe",
 			ContextLenVal: 65536,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mixtral-8x22b-instruct"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			InstructVal:   "mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1713312000,
+			HFIDVal:       "mistralai/Mixtral-8x22B-Instruct-v0.1",
+			FamilyVal:     "mixtral",
+			SizeVal:       "8x22B",
 		},
 		"mistralai/mixtral-8x7b-instruct": {
 			IDVal:         "mistralai/mixtral-8x7b-instruct",
@@ -1806,8 +3140,17 @@ func init() {
 			DescCNVal:     "Mixtral 8x7B Instruct 是 Mistral AI 开发的预训练生成式稀疏混合专家（Sparse Mixture of Experts）模型，专为聊天和指令遵循场景设计，包含 8 个专家（前馈网络），总参数量达 470 亿。\n\n该指令微调版本由 Mistral 官方提供。#moe",
 			ContextLenVal: 32768,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000054", Completion: "0.00000054"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mixtral-8x7b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			InstructVal:   "mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1702166400,
+			HFIDVal:       "mistralai/Mixtral-8x7B-Instruct-v0.1",
+			FamilyVal:     "mixtral",
+			SizeVal:       "8x7B",
 		},
 		"mistralai/pixtral-12b": {
 			IDVal:         "mistralai/pixtral-12b",
@@ -1817,8 +3160,16 @@ func init() {
 			DescCNVal:     "Mistral AI 推出的首款多模态文本+图像到文本模型。其权重已通过种子文件发布：https://x.com/mistralai/status/1833758285167722836。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000001"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"pixtral-12b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1725926400,
+			HFIDVal:       "mistralai/Pixtral-12B-2409",
+			FamilyVal:     "pixtral",
+			SizeVal:       "12B",
 		},
 		"mistralai/pixtral-large-2411": {
 			IDVal:         "mistralai/pixtral-large-2411",
@@ -1828,8 +3179,15 @@ func init() {
 			DescCNVal:     "Pixtral Large 是一个拥有 1240 亿参数的开源权重多模态模型，基于 [Mistral Large 2](/mistralai/mistral-large-2411) 构建，能够理解文档、图表和自然图像。\n\n该模型依据 Mistral 研究许可（MRL）可用于研究与教育用途，并可通过 Mistral 商业许可用于商业目的的实验、测试和生产部署。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"pixtral-large-2411"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.3},
+			ReleasedVal:   1731977388,
+			FamilyVal:     "pixtral-large",
+			SnapshotVal:   1730419200,
 		},
 		"mistralai/voxtral-small-24b-2507": {
 			IDVal:         "mistralai/voxtral-small-24b-2507",
@@ -1839,8 +3197,17 @@ func init() {
 			DescCNVal:     "Voxtral Small 是 Mistral Small 3 的增强版，在保留业界领先的文本性能的同时，集成了先进的音频输入能力，擅长语音转录、翻译和音频理解。音频输入定价为每百万秒 100 美元。",
 			ContextLenVal: 32000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000003", Audio: "0.0001"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"voxtral-small-24b-2507"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			DefaultParams: map[string]float64{"temperature": 0.2, "top_p": 0.95},
+			ReleasedVal:   1761835144,
+			HFIDVal:       "mistralai/Voxtral-Small-24B-2507",
+			FamilyVal:     "voxtral-small",
+			SizeVal:       "24B",
+			SnapshotVal:   1751328000,
 		},
 		"moonshotai/kimi-dev-72b": {
 			IDVal:         "moonshotai/kimi-dev-72b",
@@ -1850,8 +3217,15 @@ func init() {
 			DescCNVal:     "Kimi-Dev-72B 是一款面向软件工程和问题修复任务微调的开源大语言模型。基于 Qwen2.5-72B，通过大规模强化学习进行优化：在真实代码仓库中应用代码补丁，并通过完整测试套件验证，仅对正确且鲁棒的补全结果给予奖励。该模型在 SWE-bench Verified 上达到60.4%，在开源模型中树立了软件缺陷修复与代码推理的新标杆。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000029", Completion: "0.00000115"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"kimi-dev-72b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "reasoning", "response_format", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1750115909,
+			HFIDVal:       "moonshotai/Kimi-Dev-72B",
+			FamilyVal:     "kimi-dev",
+			SizeVal:       "72B",
 		},
 		"moonshotai/kimi-k2": {
 			IDVal:         "moonshotai/kimi-k2",
@@ -1861,8 +3235,15 @@ func init() {
 			DescCNVal:     "Kimi K2 Instruct 是月之暗面（Moonshot AI）开发的大规模混合专家（MoE）语言模型，总参数量达 1 万亿，每次前向传播激活 320 亿参数。该模型针对智能体能力进行优化，支持高级工具调用、复杂推理与代码合成。Kimi K2 在多项基准测试中表现卓越，尤其在编程（LiveCodeBench、SWE-bench）、推理（ZebraLogic、GPQA）及工具使用（Tau2、AceBench）任务中优势显著。模型支持最长 128K 令牌的长上下文推理，并采用包含 MuonClip 优化器的新型训练栈，确保大规模 MoE 模型训练的稳定性。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000005", Completion: "0.0000024"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"kimi-k2"},
+			ParamList:     []string{"frequency_penalty", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1752263252,
+			HFIDVal:       "moonshotai/Kimi-K2-Instruct",
+			VariantList:   []string{"moonshotai/kimi-k2:free"},
+			FamilyVal:     "kimi-k2",
 		},
 		"moonshotai/kimi-k2-0905": {
 			IDVal:         "moonshotai/kimi-k2-0905",
@@ -1872,8 +3253,16 @@ func init() {
 			DescCNVal:     "Kimi K2 0905 是 [Kimi K2 0711](moonshotai/kimi-k2) 的九月更新版本，由月之暗面（Moonshot AI）开发的大规模专家混合（MoE）语言模型，总参数量达 1 万亿，每次前向传递激活 320 亿参数。支持最长 256k tokens 的长上下文推理（此前为 128k）。\n\n本次更新提升了智能体编程的准确率与跨框架泛化能力，并增强了前端编程在 Web、3D 等相关任务中的输出美观性与功能性。Kimi K2 针对智能体能力进行了优化，包括高级工具使用、推理与代码合成，在编程（LiveCodeBench、SWE-bench）、推理（ZebraLogic、GPQA）和工具使用（Tau2、AceBench）等基准测试中表现优异。该模型采用包含 MuonClip 优化器的新训练栈，以实现稳定的大规模 MoE 训练。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  262144,
+			PricingVal:    Pricing{Prompt: "0.00000039", Completion: "0.0000019"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"kimi-k2-0905"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1757021147,
+			HFIDVal:       "moonshotai/Kimi-K2-Instruct-0905",
+			VariantList:   []string{"moonshotai/kimi-k2-0905:exacto"},
+			FamilyVal:     "kimi-k2",
+			SnapshotVal:   1757030400,
 		},
 		"moonshotai/kimi-k2-0905:exacto": {
 			IDVal:         "moonshotai/kimi-k2-0905:exacto",
@@ -1883,8 +3272,17 @@ func init() {
 			DescCNVal:     "Kimi K2 0905 是 [Kimi K2 0711](moonshotai/kimi-k2) 的九月更新版本，由月之暗面（Moonshot AI）开发的大规模专家混合（MoE）语言模型，总参数量达 1 万亿，每次前向传递激活 320 亿参数。支持最长 256k tokens 的长上下文推理（此前为 128k）。\n\n本次更新提升了智能体编程的准确率与跨框架泛化能力，并增强了前端编程在 Web、3D 等相关任务中的输出美观性与功能性。Kimi K2 针对智能体能力进行了优化，包括高级工具使用、推理与代码合成，在编程（LiveCodeBench、SWE-bench）、推理（ZebraLogic、GPQA）和工具使用（Tau2、AceBench）等基准测试中表现优异。该模型采用包含 MuonClip 优化器的新训练栈，以实现稳定的大规模 MoE 训练。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000006", Completion: "0.0000025"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"kimi-k2-0905:exacto"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1757021147,
+			CanonicalVal:  "moonshotai/kimi-k2-0905",
+			HFIDVal:       "moonshotai/Kimi-K2-Instruct-0905",
+			BaseVal:       "moonshotai/kimi-k2-0905",
+			FamilyVal:     "kimi-k2",
+			SnapshotVal:   1757030400,
 		},
 		"moonshotai/kimi-k2-thinking": {
 			IDVal:         "moonshotai/kimi-k2-thinking",
@@ -1894,8 +3292,15 @@ func init() {
 			DescCNVal:     "Kimi K2 Thinking 是月之暗面（Moonshot AI）迄今最先进的开源推理模型，将 K2 系列拓展至智能体驱动的长周期推理领域。该模型基于 Kimi K2 引入的万亿参数混合专家（MoE）架构，每次前向传递激活 320 亿参数，支持 256k token 的上下文窗口。模型针对持续逐步推理、动态工具调用及跨越数百轮次的复杂推理工作流进行了优化，能将逐步推理与工具使用交错执行，实现无需漂移的数百步连续自主研究、编程与写作。\n\n该模型在 HLE、BrowseComp、SWE-Multilingual 和 LiveCodeBench 等开源基准上创下新纪录，并能在 200–300 次工具调用中保持稳定的多智能体行为。依托大规模 MoE 架构与 MuonClip 优化，它在高推理深度与高推理效率之间取得平衡，适用于高要求的智能体与分析任务。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  65535,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.00000175"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"kimi-k2-thinking"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1762440622,
+			CanonicalVal:  "moonshotai/kimi-k2-thinking-20251106",
+			HFIDVal:       "moonshotai/Kimi-K2-Thinking",
+			FamilyVal:     "kimi-k2-thinking",
 		},
 		"moonshotai/kimi-k2.5": {
 			IDVal:         "moonshotai/kimi-k2.5",
@@ -1905,8 +3310,15 @@ func init() {
 			DescCNVal:     "Kimi K2.5 是月之暗面（Moonshot AI）推出的原生多模态模型，在视觉编程能力方面达到业界领先水平，并采用自主导向的智能体集群（agent swarm）范式。该模型基于 Kimi K2 架构，通过约 15 万亿混合视觉与文本 token 的持续预训练，在通用推理、视觉编程及智能体工具调用方面均展现出强大性能。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000005", Completion: "0.0000028"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"kimi-k2.5"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1769487076,
+			CanonicalVal:  "moonshotai/kimi-k2.5-0127",
+			HFIDVal:       "moonshotai/Kimi-K2.5",
+			FamilyVal:     "kimi-k2.5",
 		},
 		"moonshotai/kimi-k2:free": {
 			IDVal:         "moonshotai/kimi-k2:free",
@@ -1916,8 +3328,16 @@ func init() {
 			DescCNVal:     "Kimi K2 Instruct 是月之暗面（Moonshot AI）开发的大规模混合专家（MoE）语言模型，总参数量达 1 万亿，每次前向传播激活 320 亿参数。该模型针对智能体能力进行优化，支持高级工具调用、复杂推理与代码合成。Kimi K2 在多项基准测试中表现卓越，尤其在编程（LiveCodeBench、SWE-bench）、推理（ZebraLogic、GPQA）及工具使用（Tau2、AceBench）任务中优势显著。模型支持最长 128K 令牌的长上下文推理，并采用包含 MuonClip 优化器的新型训练栈，确保大规模 MoE 模型训练的稳定性。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"kimi-k2:free"},
+			ParamList:     []string{"max_tokens", "seed", "stop", "temperature"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1752263252,
+			CanonicalVal:  "moonshotai/kimi-k2",
+			HFIDVal:       "moonshotai/Kimi-K2-Instruct",
+			BaseVal:       "moonshotai/kimi-k2",
+			FamilyVal:     "kimi-k2",
 		},
 		"morph/morph-v3-fast": {
 			IDVal:         "morph/morph-v3-fast",
@@ -1927,8 +3347,14 @@ func init() {
 			DescCNVal:     "Morph 最快的代码编辑应用模型，推理速度约 10,500 tokens/秒，准确率达 96%，适用于快速代码转换。\n\n该模型要求提示格式如下：\n<instruction>{instruction}</instruction>\n<code>{initial_code}</code>\n<update>{edit_snippet}</update>\n\nMorph 已启用零数据留存策略。更多模型信息请参阅其[文档](https://docs.morphllm.com/quickstart)。",
 			ContextLenVal: 81920,
 			MaxOutputVal:  38000,
+			PricingVal:    Pricing{Prompt: "0.0000008", Completion: "0.0000012"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"morph-v3-fast"},
+			ParamList:     []string{"max_tokens", "stop", "temperature"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1751910002,
+			FamilyVal:     "morph-fast",
+			VersionVal:    "3",
 		},
 		"morph/morph-v3-large": {
 			IDVal:         "morph/morph-v3-large",
@@ -1938,8 +3364,14 @@ func init() {
 			DescCNVal:     "Morph 高精度代码编辑应用模型，适用于复杂代码修改，推理速度约 4,500 tokens/秒，准确率达 98%，可实现精准代码转换。\n\n该模型要求提示格式如下：\n<instruction>{instruction}</instruction>\n<code>{initial_code}</code>\n<update>{edit_snippet}</update>\n\nMorph 已启用零数据留存策略。更多模型信息请参阅其[文档](https://docs.morphllm.com/quickstart)。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  131072,
+			PricingVal:    Pricing{Prompt: "0.0000009", Completion: "0.0000019"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"morph-v3-large"},
+			ParamList:     []string{"max_tokens", "stop", "temperature"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1751910858,
+			FamilyVal:     "morph-large",
+			VersionVal:    "3",
 		},
 		"neversleep/llama-3.1-lumimaid-8b": {
 			IDVal:         "neversleep/llama-3.1-lumimaid-8b",
@@ -1949,8 +3381,17 @@ func init() {
 			DescCNVal:     "Lumimaid v0.2 8B 是基于 [Llama 3.1 8B](/models/meta-llama/llama-3.1-8b-instruct) 微调的模型，相比 Lumimaid v0.1 在数据集方面实现了“巨大飞跃”，并清理了低质量的聊天输出。\n\n使用本模型需遵守 [Meta 可接受使用政策](https://llama.meta.com/llama3/use-policy/)。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000009", Completion: "0.0000006"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.1-lumimaid-8b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "stop", "structured_outputs", "temperature", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1726358400,
+			HFIDVal:       "NeverSleep/Lumimaid-v0.2-8B",
+			FamilyVal:     "llama-lumimaid",
+			VersionVal:    "3.1",
+			SizeVal:       "8B",
 		},
 		"neversleep/noromaid-20b": {
 			IDVal:         "neversleep/noromaid-20b",
@@ -1960,8 +3401,16 @@ func init() {
 			DescCNVal:     "由 IkariDev 与 Undi 联合开发的合并模型，适用于角色扮演（RP）、成人角色扮演（ERP）及通用知识任务。\n\n#merge #uncensored",
 			ContextLenVal: 4096,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000001", Completion: "0.00000175"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"noromaid-20b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "stop", "structured_outputs", "temperature", "top_p"},
+			TokenizerVal:  "Llama2",
+			InstructVal:   "alpaca",
+			ReleasedVal:   1700956800,
+			HFIDVal:       "NeverSleep/Noromaid-20b-v0.1.1",
+			FamilyVal:     "noromaid",
+			SizeVal:       "20B",
 		},
 		"nex-agi/deepseek-v3.1-nex-n1": {
 			IDVal:         "nex-agi/deepseek-v3.1-nex-n1",
@@ -1971,8 +3420,15 @@ func init() {
 			DescCNVal:     "DeepSeek V3.1 Nex-N1 是 Nex-N1 系列的旗舰版本——一款经过后训练的模型，旨在突出智能体自主性、工具使用能力及现实生产力。\n\nNex-N1 在所有评估场景中均展现出竞争力，在实际编码和 HTML 生成任务中表现尤为突出。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  163840,
+			PricingVal:    Pricing{Prompt: "0.00000027", Completion: "0.000001"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-v3.1-nex-n1"},
+			ParamList:     []string{"frequency_penalty", "response_format", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			ReleasedVal:   1765204393,
+			HFIDVal:       "nex-agi/DeepSeek-V3.1-Nex-N1",
+			FamilyVal:     "deepseek-nex-n1",
+			VersionVal:    "3.1",
 		},
 		"nousresearch/deephermes-3-mistral-24b-preview": {
 			IDVal:         "nousresearch/deephermes-3-mistral-24b-preview",
@@ -1982,8 +3438,17 @@ func init() {
 			DescCNVal:     "DeepHermes 3（Mistral 24B 预览版）是由 Nous Research 基于 Mistral-Small-24B 开发的指令微调语言模型，专为聊天、函数调用及高级多轮推理设计。该模型引入双模式系统，可通过特殊系统提示在直观聊天响应与结构化“深度推理”模式间切换。经 R1 蒸馏微调，支持结构化输出（JSON 模式）及面向智能体应用的函数调用语法。\n\nDeepHermes 3 支持**通过系统提示启用推理切换**，允许用户在快速直观响应与深思熟虑的多步推理之间自由转换。当使用以下特定系统指令激活时，模型将进入*“深度思考”*模式——在给出最终答案前，生成包含在 `<think></think>` 标签内的长链思维过程。\n\n系统提示：你是一个深度思考型 AI，可使用极长的思维链深入分析问题，并通过系统化推理过程与自身反复推演，以得出正确解答。请将你的思考与内心独白置于 <think> </think> 标签内，随后提供问题的解决方案或回答。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  32768,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000002", Completion: "0.0000001"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deephermes-3-mistral-24b-preview"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1746830904,
+			StatusVal:     StatusPreview,
+			HFIDVal:       "NousResearch/DeepHermes-3-Mistral-24B-Preview",
+			FamilyVal:     "deephermes-mistral",
+			VersionVal:    "3",
+			SizeVal:       "24B",
 		},
 		"nousresearch/hermes-2-pro-llama-3-8b": {
 			IDVal:         "nousresearch/hermes-2-pro-llama-3-8b",
@@ -1993,8 +3458,17 @@ func init() {
 			DescCNVal:     "Hermes 2 Pro 是 Nous Hermes 2 的升级再训练版本，采用更新并清洗后的 OpenHermes 2.5 数据集，并新增了内部开发的函数调用与 JSON 模式数据集。",
 			ContextLenVal: 8192,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.00000014", Completion: "0.00000014"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"hermes-2-pro-llama-3-8b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "chatml",
+			ReleasedVal:   1716768000,
+			HFIDVal:       "NousResearch/Hermes-2-Pro-Llama-3-8B",
+			FamilyVal:     "hermes-pro-llama-3",
+			VersionVal:    "2",
+			SizeVal:       "8B",
 		},
 		"nousresearch/hermes-3-llama-3.1-405b": {
 			IDVal:         "nousresearch/hermes-3-llama-3.1-405b",
@@ -2004,8 +3478,18 @@ func init() {
 			DescCNVal:     "Hermes 3 是一款通用语言模型，在 Hermes 2 基础上实现了多项改进，包括更先进的智能体能力、更出色的角色扮演、推理能力、多轮对话连贯性、长上下文一致性，以及全方位性能提升。\n\nHermes 3 405B 是基于 Llama-3.1 405B 基础模型进行全参数微调的前沿级别模型，专注于将大语言模型与用户意图对齐，赋予终端用户强大的引导与控制能力。\n\nHermes 3 系列在 Hermes 2 能力集基础上进一步拓展，包括更强大可靠的函数调用与结构化输出能力、通用助手功能，以及增强的代码生成技能。\n\n在通用能力方面，Hermes 3 与 Llama-3.1 Instruct 模型相比具备竞争力，甚至更优，二者各有优势与不足。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.000001", Completion: "0.000001"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"hermes-3-llama-3.1-405b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "chatml",
+			ReleasedVal:   1723766400,
+			HFIDVal:       "NousResearch/Hermes-3-Llama-3.1-405B",
+			VariantList:   []string{"nousresearch/hermes-3-llama-3.1-405b:free"},
+			FamilyVal:     "hermes-llama-3.1",
+			VersionVal:    "3",
+			SizeVal:       "405B",
 		},
 		"nousresearch/hermes-3-llama-3.1-405b:free": {
 			IDVal:         "nousresearch/hermes-3-llama-3.1-405b:free",
@@ -2015,8 +3499,19 @@ func init() {
 			DescCNVal:     "Hermes 3 是一款通用语言模型，在 Hermes 2 基础上进行了多项改进，包括更先进的智能体能力、更出色的角色扮演、推理、多轮对话、长上下文连贯性，以及全方位性能提升。\n\nHermes 3 405B 是基于 Llama-3.1 405B 基础模型进行的全参数微调前沿级模型，专注于将大语言模型与用户需求对齐，赋予终端用户强大的引导能力和控制权。\n\nHermes 3 系列在 Hermes 2 能力基础上进一步拓展，包括更强大可靠的函数调用与结构化输出能力、通用助手能力，以及改进的代码生成技能。\n\n在通用能力方面，Hermes 3 与 Llama-3.1 Instruct 模型相比具有竞争力，甚至更优，两者各有优势与不足。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"hermes-3-llama-3.1-405b:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "chatml",
+			ReleasedVal:   1723766400,
+			CanonicalVal:  "nousresearch/hermes-3-llama-3.1-405b",
+			HFIDVal:       "NousResearch/Hermes-3-Llama-3.1-405B",
+			BaseVal:       "nousresearch/hermes-3-llama-3.1-405b",
+			FamilyVal:     "hermes-llama-3.1",
+			VersionVal:    "3",
+			SizeVal:       "405B",
 		},
 		"nousresearch/hermes-3-llama-3.1-70b": {
 			IDVal:         "nousresearch/hermes-3-llama-3.1-70b",
@@ -2026,8 +3521,17 @@ func init() {
 			DescCNVal:     "Hermes 3 是一款通用语言模型，在 [Hermes 2](/models/nousresearch/nous-hermes-2-mistral-7b-dpo) 基础上进行了多项改进，包括更先进的智能体能力、更出色的角色扮演、推理、多轮对话、长上下文连贯性，以及全方位性能提升。\n\nHermes 3 70B 是对 [Llama-3.1 70B 基础模型](/models/meta-llama/llama-3.1-70b-instruct) 进行的具有竞争力（甚至更优）的微调版本，专注于将大语言模型与用户需求对齐，赋予终端用户强大的引导能力和控制权。\n\nHermes 3 系列在 Hermes 2 能力基础上进一步拓展，包括更强大可靠的函数调用与结构化输出能力、通用助手能力，以及改进的代码生成技能。",
 			ContextLenVal: 65536,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000003"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"hermes-3-llama-3.1-70b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "chatml",
+			ReleasedVal:   1723939200,
+			HFIDVal:       "NousResearch/Hermes-3-Llama-3.1-70B",
+			FamilyVal:     "hermes-llama-3.1",
+			VersionVal:    "3",
+			SizeVal:       "70B",
 		},
 		"nousresearch/hermes-4-405b": {
 			IDVal:         "nousresearch/hermes-4-405b",
@@ -2037,8 +3541,16 @@ func init() {
 			DescCNVal:     "Hermes 4 是由 Nous Research 基于 Meta-Llama-3.1-405B 构建的大规模推理模型，引入了混合推理模式：模型可选择通过 <think>...</think> 标记进行内部推理，或直接响应，从而在速度与深度之间灵活权衡。用户可通过 `reasoning` `enabled` 布尔值控制推理行为。[了解更多](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)\n\n该模型经过指令微调，使用了约 600 亿 token 的扩展后训练语料，重点强化推理轨迹，在数学、代码、STEM 及逻辑推理方面性能显著提升，同时保留广泛的助手功能。此外，支持结构化输出（包括 JSON 模式、模式遵循、函数调用和工具使用）。Hermes 4 经过专门训练，具备更强的可控性、更低的拒答率，并对齐至中立、用户导向的行为模式。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.000001", Completion: "0.000003"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"hermes-4-405b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1756235463,
+			HFIDVal:       "NousResearch/Hermes-4-405B",
+			FamilyVal:     "hermes",
+			VersionVal:    "4",
+			SizeVal:       "405B",
 		},
 		"nousresearch/hermes-4-70b": {
 			IDVal:         "nousresearch/hermes-4-70b",
@@ -2048,8 +3560,16 @@ func init() {
 			DescCNVal:     "Hermes 4 70B 是 Nous Research 基于 Meta-Llama-3.1-70B 开发的混合推理模型，引入了与更大规模 405B 版本相同的混合模式，允许模型直接响应或在回答前生成显式的 <think>...</think> 推理轨迹。用户可通过 `reasoning` 的 `enabled` 布尔值控制推理行为。[详见文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)\n\n该 70B 版本使用扩展后的后训练语料库（约 600 亿 tokens）进行训练，重点强化经验证的推理数据，从而在数学、编程、STEM、逻辑和结构化输出方面取得显著提升，同时保持通用助手性能。支持 JSON 模式、模式遵循、函数调用和工具使用，具备更强的可控性并降低拒答率。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000011", Completion: "0.00000038"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"hermes-4-70b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			ReleasedVal:   1756236182,
+			HFIDVal:       "NousResearch/Hermes-4-70B",
+			FamilyVal:     "hermes",
+			VersionVal:    "4",
+			SizeVal:       "70B",
 		},
 		"nvidia/llama-3.1-nemotron-70b-instruct": {
 			IDVal:         "nvidia/llama-3.1-nemotron-70b-instruct",
@@ -2059,8 +3579,17 @@ func init() {
 			DescCNVal:     "NVIDIA 的 Llama 3.1 Nemotron 70B 是一款旨在生成精准且实用回复的语言模型。基于 [Llama 3.1 70B](/models/meta-llama/llama-3.1-70b-instruct) 架构并结合人类反馈强化学习（RLHF），在自动对齐基准测试中表现卓越。该模型专为需要高准确性的帮助性和回复生成任务而设计，适用于跨领域的多样化用户查询。\n\n使用本模型需遵守 [Meta 可接受使用政策](https://www.llama.com/llama3/use-policy/)。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.0000012", Completion: "0.0000012"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.1-nemotron-70b-instruct"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1728950400,
+			HFIDVal:       "nvidia/Llama-3.1-Nemotron-70B-Instruct-HF",
+			FamilyVal:     "llama-nemotron",
+			VersionVal:    "3.1",
+			SizeVal:       "70B",
 		},
 		"nvidia/llama-3.1-nemotron-ultra-253b-v1": {
 			IDVal:         "nvidia/llama-3.1-nemotron-ultra-253b-v1",
@@ -2070,8 +3599,16 @@ func init() {
 			DescCNVal:     "Llama-3.1-Nemotron-Ultra-253B-v1 是一款专为高级推理、人机交互对话、检索增强生成（RAG）及工具调用任务优化的大语言模型（LLM）。该模型基于 Meta 的 Llama-3.1-405B-Instruct，通过神经架构搜索（NAS）进行了深度定制，显著提升了效率、降低了内存占用并改善了推理延迟。模型支持最高 128K token 的上下文长度，并可在 8 块 NVIDIA H100 GPU 节点上高效运行。\n\n注意：必须在系统提示中包含 `detailed thinking on` 才能启用推理功能。更多详情请参阅 [使用建议](https://huggingface.co/nvidia/Llama-3_1-Nemotron-Ultra-253B-v1#quick-start-and-usage-recommendations)。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000006", Completion: "0.0000018"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.1-nemotron-ultra-253b-v1"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			ReleasedVal:   1744115059,
+			HFIDVal:       "nvidia/Llama-3_1-Nemotron-Ultra-253B-v1",
+			FamilyVal:     "llama-nemotron-ultra",
+			VersionVal:    "3.1",
+			SizeVal:       "253B",
 		},
 		"nvidia/llama-3.3-nemotron-super-49b-v1.5": {
 			IDVal:         "nvidia/llama-3.3-nemotron-super-49b-v1.5",
@@ -2081,8 +3618,16 @@ func init() {
 			DescCNVal:     "Llama-3.3-Nemotron-Super-49B-v1.5 是一个以英语为中心的 490 亿参数推理/对话模型，基于 Meta 的 Llama-3.3-70B-Instruct 并支持 128K 上下文。该模型通过监督微调（SFT）针对智能体工作流（RAG、工具调用）在数学、代码、科学及多轮对话任务上进行后训练，随后经历多阶段强化学习：基于奖励感知的偏好优化（RPO）用于对齐，基于可验证奖励的强化学习（RLVR）用于逐步推理，以及迭代式 DPO 以优化工具使用行为。通过蒸馏驱动的神经架构搜索（“Puzzle”）替换部分注意力模块并调整前馈网络宽度，显著降低内存占用并提升吞吐量，从而支持单 GPU（H100/H200）部署，同时保留指令遵循能力和思维链（CoT）质量。\n\n在内部评估中（NeMo-Skills，最多 16 次运行，温度=0.6，top_p=0.95），该模型展现出强大的推理与编码能力，例如 MATH500 pass@1 = 97.4、AIME-2024 = 87.5、AIME-2025 = 82.71、GPQA = 71.97、LiveCodeBench（24.10–25.02）= 73.58 以及 MMLU-Pro（CoT）= 79.53。该模型面向实际推理效率（高 tokens/s、低显存占用），支持 Transformers/vLLM，并提供显式的“推理开/关”模式（默认聊天优先，关闭时推荐使用贪心解码）。适用于构建对准确率与成本平衡性及可靠工具使用有要求的智能体、助手和长上下文检索系统。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000004"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"llama-3.3-nemotron-super-49b-v1.5"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			ReleasedVal:   1760101395,
+			HFIDVal:       "nvidia/Llama-3_3-Nemotron-Super-49B-v1_5",
+			FamilyVal:     "llama-nemotron-super",
+			VersionVal:    "3.3",
+			SizeVal:       "49B",
 		},
 		"nvidia/nemotron-3-nano-30b-a3b": {
 			IDVal:         "nvidia/nemotron-3-nano-30b-a3b",
@@ -2092,8 +3637,17 @@ func init() {
 			DescCNVal:     "NVIDIA Nemotron 3 Nano 30B A3B 是一款面向开发者的小型语言专家混合（MoE）模型，在计算效率与准确性方面表现卓越，适用于构建专用智能体 AI 系统。\n\n该模型完全开源，提供开放权重、数据集及训练方案，便于开发者轻松定制、优化并在自有基础设施上部署，以实现最高级别的隐私与安全。\n\n注意：免费端点的所有提示与输出均会被记录，用于改进提供商的模型及其产品与服务。请勿上传任何个人、机密或其他敏感信息。此为试用版本，不得用于生产环境或关键业务系统。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000005", Completion: "0.0000002"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"nemotron-3-nano-30b-a3b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1765731275,
+			HFIDVal:       "nvidia/NVIDIA-Nemotron-3-Nano-30B-A3B-BF16",
+			VariantList:   []string{"nvidia/nemotron-3-nano-30b-a3b:free"},
+			FamilyVal:     "nemotron-nano",
+			VersionVal:    "3",
+			SizeVal:       "30B",
 		},
 		"nvidia/nemotron-3-nano-30b-a3b:free": {
 			IDVal:         "nvidia/nemotron-3-nano-30b-a3b:free",
@@ -2103,8 +3657,18 @@ func init() {
 			DescCNVal:     "NVIDIA Nemotron 3 Nano 30B A3B 是一款面向开发者的小型语言专家混合（MoE）模型，在计算效率与准确性方面表现卓越，适用于构建专用智能体 AI 系统。\n\n该模型完全开源，提供开放权重、数据集及训练方案，便于开发者轻松定制、优化并在自有基础设施上部署，以实现最高级别的隐私与安全。\n\n注意：免费端点的所有提示与输出均会被记录，用于改进提供商的模型及其产品与服务。请勿上传任何个人、机密或其他敏感信息。此为试用版本，不得用于生产环境或关键业务系统。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"nemotron-3-nano-30b-a3b:free"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "seed", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1765731275,
+			CanonicalVal:  "nvidia/nemotron-3-nano-30b-a3b",
+			HFIDVal:       "nvidia/NVIDIA-Nemotron-3-Nano-30B-A3B-BF16",
+			BaseVal:       "nvidia/nemotron-3-nano-30b-a3b",
+			FamilyVal:     "nemotron-nano",
+			VersionVal:    "3",
+			SizeVal:       "30B",
 		},
 		"nvidia/nemotron-nano-12b-v2-vl": {
 			IDVal:         "nvidia/nemotron-nano-12b-v2-vl",
@@ -2114,8 +3678,17 @@ func init() {
 			DescCNVal:     "NVIDIA Nemotron Nano 2 VL 是一款拥有 120 亿参数的开源多模态推理模型，专为视频理解和文档智能设计。该模型采用混合 Transformer-Mamba 架构，结合了 Transformer 级别的准确性与 Mamba 的内存高效序列建模能力，显著提升吞吐量并降低延迟。\n\n该模型支持文本和多图像文档输入，并生成自然语言输出。其训练数据为 NVIDIA 精心构建的高质量合成数据集，针对光学字符识别（OCR）、图表推理和多模态理解进行了优化。\n\nNemotron Nano 2 VL 在 OCRBench v2 上取得领先成果，并在 MMMU、MathVista、AI2D、OCRBench、OCR-Reasoning、ChartQA、DocVQA 和 Video-MME 等基准测试中平均得分约 74，超越此前所有开源视觉语言基线模型。借助高效视频采样（EVS）技术，该模型可处理长视频内容，同时降低推理成本。\n\n模型权重、训练数据和微调方案均以宽松的 NVIDIA 开源许可证发布，并支持在 NeMo、NIM 及主流推理运行时环境中部署。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000006"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"nemotron-nano-12b-v2-vl"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1761675565,
+			HFIDVal:       "nvidia/NVIDIA-Nemotron-Nano-12B-v2-VL-BF16",
+			VariantList:   []string{"nvidia/nemotron-nano-12b-v2-vl:free"},
+			FamilyVal:     "nemotron-nano-vl",
+			VersionVal:    "2",
+			SizeVal:       "12B",
 		},
 		"nvidia/nemotron-nano-12b-v2-vl:free": {
 			IDVal:         "nvidia/nemotron-nano-12b-v2-vl:free",
@@ -2125,8 +3698,18 @@ func init() {
 			DescCNVal:     "NVIDIA Nemotron Nano 2 VL 是一款拥有 120 亿参数的开源多模态推理模型，专为视频理解和文档智能设计。该模型采用混合 Transformer-Mamba 架构，结合了 Transformer 级别的准确性与 Mamba 的内存高效序列建模能力，显著提升吞吐量并降低延迟。\n\n该模型支持文本和多图像文档输入，并生成自然语言输出。其训练数据为 NVIDIA 精心构建的高质量合成数据集，针对光学字符识别（OCR）、图表推理和多模态理解进行了优化。\n\nNemotron Nano 2 VL 在 OCRBench v2 上取得领先成果，并在 MMMU、MathVista、AI2D、OCRBench、OCR-Reasoning、ChartQA、DocVQA 和 Video-MME 等基准测试中平均得分约 74，超越此前所有开源视觉语言基线模型。借助高效视频采样（EVS）技术，该模型可处理长视频内容，同时降低推理成本。\n\n模型权重、训练数据和微调方案均以宽松的 NVIDIA 开源许可证发布，并支持在 NeMo、NIM 及主流推理运行时环境中部署。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"nemotron-nano-12b-v2-vl:free"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "seed", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1761675565,
+			CanonicalVal:  "nvidia/nemotron-nano-12b-v2-vl",
+			HFIDVal:       "nvidia/NVIDIA-Nemotron-Nano-12B-v2-VL-BF16",
+			BaseVal:       "nvidia/nemotron-nano-12b-v2-vl",
+			FamilyVal:     "nemotron-nano-vl",
+			VersionVal:    "2",
+			SizeVal:       "12B",
 		},
 		"nvidia/nemotron-nano-9b-v2": {
 			IDVal:         "nvidia/nemotron-nano-9b-v2",
@@ -2136,8 +3719,17 @@ func init() {
 			DescCNVal:     "NVIDIA-Nemotron-Nano-9B-v2 是 NVIDIA 从零开始训练的大语言模型（LLM），设计为统一处理推理与非推理任务。模型在响应用户查询和任务时，首先生成推理轨迹，再给出最终答案。\n\n其推理能力可通过系统提示词进行控制。若用户希望模型直接提供最终答案而不显示中间推理轨迹，亦可进行相应配置。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000004", Completion: "0.00000016"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"nemotron-nano-9b-v2"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1757106807,
+			HFIDVal:       "nvidia/NVIDIA-Nemotron-Nano-9B-v2",
+			VariantList:   []string{"nvidia/nemotron-nano-9b-v2:free"},
+			FamilyVal:     "nemotron-nano",
+			VersionVal:    "2",
+			SizeVal:       "9B",
 		},
 		"nvidia/nemotron-nano-9b-v2:free": {
 			IDVal:         "nvidia/nemotron-nano-9b-v2:free",
@@ -2147,8 +3739,18 @@ func init() {
 			DescCNVal:     "NVIDIA-Nemotron-Nano-9B-v2 是 NVIDIA 从零开始训练的大语言模型（LLM），设计为统一处理推理与非推理任务。模型在响应用户查询和任务时，首先生成推理轨迹，再给出最终答案。\n\n其推理能力可通过系统提示词进行控制。若用户希望模型直接提供最终答案而不显示中间推理轨迹，亦可进行相应配置。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"nemotron-nano-9b-v2:free"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1757106807,
+			CanonicalVal:  "nvidia/nemotron-nano-9b-v2",
+			HFIDVal:       "nvidia/NVIDIA-Nemotron-Nano-9B-v2",
+			BaseVal:       "nvidia/nemotron-nano-9b-v2",
+			FamilyVal:     "nemotron-nano",
+			VersionVal:    "2",
+			SizeVal:       "9B",
 		},
 		"openai/chatgpt-4o-latest": {
 			IDVal:         "openai/chatgpt-4o-latest",
@@ -2158,8 +3760,13 @@ func init() {
 			DescCNVal:     "OpenAI ChatGPT-4o 由 OpenAI 持续更新，始终指向 ChatGPT 当前使用的 GPT-4o 版本。因此，与 API 版本的 [GPT-4o](/models/openai/gpt-4o) 略有不同，它额外应用了 RLHF 微调，主要用于研究与评估。\n\nOpenAI 指出，该模型不适用于生产环境，未来可能被移除或重定向至其他模型。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.000005", Completion: "0.000015"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"chatgpt-4o-latest"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1723593600,
+			FamilyVal:     "chatgpt-4o",
 		},
 		"openai/gpt-3.5-turbo": {
 			IDVal:         "openai/gpt-3.5-turbo",
@@ -2169,8 +3776,14 @@ func init() {
 			DescCNVal:     "GPT-3.5 Turbo 是 OpenAI 速度最快的模型，可理解和生成自然语言或代码，专为聊天及传统补全任务优化。\n\n训练数据截止至 2021 年 9 月。",
 			ContextLenVal: 16385,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.0000005", Completion: "0.0000015"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-3.5-turbo"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1685232000,
+			FamilyVal:     "gpt-turbo",
+			VersionVal:    "3.5",
 		},
 		"openai/gpt-3.5-turbo-0613": {
 			IDVal:         "openai/gpt-3.5-turbo-0613",
@@ -2180,8 +3793,15 @@ func init() {
 			DescCNVal:     "GPT-3.5 Turbo 是 OpenAI 最快的模型，可理解并生成自然语言或代码，专为聊天及传统补全任务优化。\n\n训练数据截止于 2021 年 9 月。",
 			ContextLenVal: 4095,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.000001", Completion: "0.000002"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-3.5-turbo-0613"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1706140800,
+			FamilyVal:     "gpt-turbo",
+			VersionVal:    "3.5",
+			SnapshotVal:   1686614400,
 		},
 		"openai/gpt-3.5-turbo-16k": {
 			IDVal:         "openai/gpt-3.5-turbo-16k",
@@ -2191,8 +3811,14 @@ func init() {
 			DescCNVal:     "该模型的上下文长度是 gpt-3.5-turbo 的四倍，单次请求可处理约 20 页文本，但成本更高。训练数据截止至 2021 年 9 月。",
 			ContextLenVal: 16385,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000004"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-3.5-turbo-16k"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1693180800,
+			FamilyVal:     "gpt-turbo-16k",
+			VersionVal:    "3.5",
 		},
 		"openai/gpt-3.5-turbo-instruct": {
 			IDVal:         "openai/gpt-3.5-turbo-instruct",
@@ -2202,8 +3828,15 @@ func init() {
 			DescCNVal:     "该模型是 GPT-3.5 Turbo 的变体，专为指令类提示进行调优，未包含面向聊天场景的优化。训练数据截止至 2021 年 9 月。",
 			ContextLenVal: 4095,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.0000015", Completion: "0.000002"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-3.5-turbo-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			InstructVal:   "chatml",
+			ReleasedVal:   1695859200,
+			FamilyVal:     "gpt-turbo-instruct",
+			VersionVal:    "3.5",
 		},
 		"openai/gpt-4": {
 			IDVal:         "openai/gpt-4",
@@ -2213,8 +3846,14 @@ func init() {
 			DescCNVal:     "OpenAI 旗舰模型 GPT-4 是一款大规模多模态语言模型，凭借更广泛的知识储备和更强的推理能力，在解决复杂问题方面比以往模型更为精准。训练数据截止至 2021 年 9 月。",
 			ContextLenVal: 8191,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.00003", Completion: "0.00006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-4"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1685232000,
+			FamilyVal:     "gpt",
+			VersionVal:    "4",
 		},
 		"openai/gpt-4-0314": {
 			IDVal:         "openai/gpt-4-0314",
@@ -2224,8 +3863,15 @@ func init() {
 			DescCNVal:     "GPT-4-0314 是 GPT-4 的首个发布版本，上下文长度为 8,192 个 token，支持持续至 6 月 14 日。训练数据截止至 2021 年 9 月。",
 			ContextLenVal: 8191,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.00003", Completion: "0.00006"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-4-0314"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1685232000,
+			FamilyVal:     "gpt",
+			VersionVal:    "4",
+			SnapshotVal:   1678752000,
 		},
 		"openai/gpt-4-1106-preview": {
 			IDVal:         "openai/gpt-4-1106-preview",
@@ -2235,19 +3881,34 @@ func init() {
 			DescCNVal:     "最新的 GPT-4 Turbo 模型，具备视觉能力。视觉请求现已支持 JSON 模式与函数调用。\n\n训练数据截止至 2023 年 4 月。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.00001", Completion: "0.00003"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-4-1106-preview"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1699228800,
+			StatusVal:     StatusPreview,
+			FamilyVal:     "gpt",
+			VersionVal:    "4",
+			SnapshotVal:   1699228800,
 		},
 		"openai/gpt-4-turbo": {
 			IDVal:         "openai/gpt-4-turbo",
 			NameVal:       "OpenAI: GPT-4 Turbo",
+			NameCNVal:     "GPT-4 Turbo",
 			ProviderVal:   "OpenAI",
 			DescVal:       "The latest GPT-4 Turbo model with vision capabilities. Vision requests can now use JSON mode and function calling.\n\nTraining data: up to December 2023.",
 			DescCNVal:     "OpenAI 的高性能模型，支持 128k 上下文。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.00001", Completion: "0.00003"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4-turbo", "gpt4t"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1712620800,
+			FamilyVal:     "gpt-turbo",
+			VersionVal:    "4",
 		},
 		"openai/gpt-4-turbo-preview": {
 			IDVal:         "openai/gpt-4-turbo-preview",
@@ -2257,8 +3918,15 @@ func init() {
 			DescCNVal:     "预览版 GPT-4 模型，改进了指令遵循能力，支持 JSON 模式、可复现输出、并行函数调用等功能。训练数据截止于 2023 年 12 月。\n\n**注意**：预览期间受 OpenAI 严格速率限制。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.00001", Completion: "0.00003"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-4-turbo-preview"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1706140800,
+			StatusVal:     StatusPreview,
+			FamilyVal:     "gpt-turbo",
+			VersionVal:    "4",
 		},
 		"openai/gpt-4.1": {
 			IDVal:         "openai/gpt-4.1",
@@ -2268,8 +3936,15 @@ func init() {
 			DescCNVal:     "GPT-4.1 是一款旗舰级大语言模型，针对高级指令遵循、现实世界软件工程和长上下文推理进行了优化。支持 100 万 token 的上下文窗口，在编码（SWE-bench Verified 达 54.6%）、指令遵循（IFEval 达 87.4%）和多模态理解等基准测试中均优于 GPT-4o 和 GPT-4.5。该模型专为精确代码差异生成、智能体可靠性以及在大型文档上下文中实现高召回率而调优，非常适合用于智能体、IDE 工具和企业知识检索场景。",
 			ContextLenVal: 1047576,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000008", InputCacheRead: "0.0000005", WebSearch: "0.01"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4.1"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1744651385,
+			CanonicalVal:  "openai/gpt-4.1-2025-04-14",
+			FamilyVal:     "gpt",
+			VersionVal:    "4.1",
 		},
 		"openai/gpt-4.1-mini": {
 			IDVal:         "openai/gpt-4.1-mini",
@@ -2279,8 +3954,15 @@ func init() {
 			DescCNVal:     "GPT-4.1 Mini 是一款中等规模模型，在显著降低延迟和成本的同时，性能可与 GPT-4o 相媲美。它保留了 100 万 token 的上下文窗口，在困难指令评估中得分为 45.1%，MultiChallenge 得分为 35.8%，IFEval 得分为 84.1%。Mini 在编码能力（如 Aider 多语言差异基准达 31.6%）和视觉理解方面也表现出色，适用于对性能要求严苛的交互式应用。",
 			ContextLenVal: 1047576,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.0000016", InputCacheRead: "0.0000001", WebSearch: "0.01"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4.1-mini"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1744651381,
+			CanonicalVal:  "openai/gpt-4.1-mini-2025-04-14",
+			FamilyVal:     "gpt-mini",
+			VersionVal:    "4.1",
 		},
 		"openai/gpt-4.1-nano": {
 			IDVal:         "openai/gpt-4.1-nano",
@@ -2290,8 +3972,15 @@ func init() {
 			DescCNVal:     "对于低延迟任务，GPT-4.1 Nano 是 GPT-4.1 系列中速度最快、成本最低的模型。其体积小巧却性能卓越，配备 100 万 token 上下文窗口，在 MMLU 上得分 80.1%，GPQA 上得分 50.3%，Aider 多语言编码基准上得分 9.8%——甚至高于 GPT-4o Mini。该模型非常适合分类或自动补全等任务。",
 			ContextLenVal: 1047576,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000004", InputCacheRead: "0.000000025", WebSearch: "0.01"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4.1-nano"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1744651369,
+			CanonicalVal:  "openai/gpt-4.1-nano-2025-04-14",
+			FamilyVal:     "gpt-nano",
+			VersionVal:    "4.1",
 		},
 		"openai/gpt-4o": {
 			IDVal:         "openai/gpt-4o",
@@ -2301,8 +3990,14 @@ func init() {
 			DescCNVal:     "GPT-4o（“o”代表“omni”）是 OpenAI 最新推出的 AI 模型，支持文本与图像输入，并生成文本输出。其智能水平与 [GPT-4 Turbo](/models/openai/gpt-4-turbo) 相当，但速度提升两倍，成本降低 50%。此外，GPT-4o 在非英语语言处理和视觉能力方面均有显著增强。\n\n在与其他模型的基准对比中，该模型曾短暂命名为 [\"im-also-a-good-gpt2-chatbot\"](https://twitter.com/LiamFedus/status/1790064963966370209)。\n\n#multimodal",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.00001", InputCacheRead: "0.00000125"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4o"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p", "web_search_options"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1715558400,
+			VariantList:   []string{"openai/gpt-4o:extended"},
+			FamilyVal:     "gpt-4o",
 		},
 		"openai/gpt-4o-2024-05-13": {
 			IDVal:         "openai/gpt-4o-2024-05-13",
@@ -2312,8 +4007,14 @@ func init() {
 			DescCNVal:     "GPT-4o（“o”代表“omni”）是 OpenAI 最新推出的 AI 模型，支持文本和图像输入，并生成文本输出。其智能水平与 [GPT-4 Turbo](/models/openai/gpt-4-turbo) 相当，但推理速度提升两倍，成本降低 50%。此外，GPT-4o 在非英语语言处理和视觉能力方面均有显著增强。\n\n在与其他模型的基准测试中，该模型曾短暂使用代号 [\"im-also-a-good-gpt2-chatbot\"](https://twitter.com/LiamFedus/status/1790064963966370209)。\n\n#多模态",
 			ContextLenVal: 128000,
 			MaxOutputVal:  4096,
+			PricingVal:    Pricing{Prompt: "0.000005", Completion: "0.000015"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4o-2024-05-13"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p", "web_search_options"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1715558400,
+			FamilyVal:     "gpt-4o",
+			SnapshotVal:   1715558400,
 		},
 		"openai/gpt-4o-2024-08-06": {
 			IDVal:         "openai/gpt-4o-2024-08-06",
@@ -2323,8 +4024,14 @@ func init() {
 			DescCNVal:     "GPT-4o 2024-08-06 版本在结构化输出方面表现更佳，支持在 response_format 中指定 JSON Schema。更多详情请参阅[此处](https://openai.com/index/introducing-structured-outputs-in-the-api/)。\n\nGPT-4o（“o”代表“omni”）是 OpenAI 最新的人工智能模型，支持文本与图像输入并生成文本输出。其智能水平与 [GPT-4 Turbo](/models/openai/gpt-4-turbo) 相当，但推理速度提升一倍，成本降低 50%。此外，GPT-4o 在非英语语言处理和视觉能力方面亦有显著增强。\n\n在与其他模型的基准测试中，该模型曾短暂使用代号 [\"im-also-a-good-gpt2-chatbot\"](https://twitter.com/LiamFedus/status/1790064963966370209)。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.00001", InputCacheRead: "0.00000125"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4o-2024-08-06"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p", "web_search_options"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1722902400,
+			FamilyVal:     "gpt-4o",
+			SnapshotVal:   1722902400,
 		},
 		"openai/gpt-4o-2024-11-20": {
 			IDVal:         "openai/gpt-4o-2024-11-20",
@@ -2334,8 +4041,14 @@ func init() {
 			DescCNVal:     "GPT-4o 2024-11-20 版本提升了创意写作能力，生成更自然、生动且高度定制化的文本，显著增强相关性与可读性。同时，在处理上传文件方面表现更优，可提供更深入的洞察和更全面的回答。\n\nGPT-4o（“o”代表“omni”）是 OpenAI 最新一代 AI 模型，支持文本和图像输入并输出文本。其智能水平与 [GPT-4 Turbo](/models/openai/gpt-4-turbo) 相当，但速度提升一倍，成本降低 50%。此外，该版本在非英语语言处理和视觉能力方面也有进一步增强。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.00001", InputCacheRead: "0.00000125"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4o-2024-11-20"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p", "web_search_options"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1732127594,
+			FamilyVal:     "gpt-4o",
+			SnapshotVal:   1732060800,
 		},
 		"openai/gpt-4o-audio-preview": {
 			IDVal:         "openai/gpt-4o-audio-preview",
@@ -2345,8 +4058,14 @@ func init() {
 			DescCNVal:     "gpt-4o-audio-preview 模型新增对音频输入作为提示的支持。此增强功能使模型能够识别音频录音中的细微差别，从而丰富生成的用户体验。目前暂不支持音频输出。音频 token 的定价为每百万输入音频 token 40 美元。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.00001", Audio: "0.00004"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityAudioIn | ModalityAudioOut | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-4o-audio-preview"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1755233061,
+			StatusVal:     StatusPreview,
+			FamilyVal:     "gpt-4o-audio",
 		},
 		"openai/gpt-4o-mini": {
 			IDVal:         "openai/gpt-4o-mini",
@@ -2356,8 +4075,13 @@ func init() {
 			DescCNVal:     "GPT-4o mini 是继 [GPT-4 Omni](/models/openai/gpt-4o) 之后 OpenAI 推出的最新模型，支持文本和图像输入，并生成文本输出。\n\n作为其最先进的小型模型，其成本远低于其他近期前沿模型，比 [GPT-3.5 Turbo](/models/openai/gpt-3.5-turbo) 便宜 60% 以上，同时保持业界领先的智能水平，性价比显著提升。\n\nGPT-4o mini 在 MMLU 基准测试中取得 82% 的得分，目前在主流聊天偏好排行榜上超越 GPT-4（参见 [常见排行榜](https://arena.lmsys.org/)）。\n\n了解更多详情，请查看 [发布公告](https://openai.com/index/gpt-4o-mini-advancing-cost-efficient-intelligence/)。\n\n#多模态",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000006", InputCacheRead: "0.000000075"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4o-mini"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p", "web_search_options"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1721260800,
+			FamilyVal:     "gpt-4o-mini",
 		},
 		"openai/gpt-4o-mini-2024-07-18": {
 			IDVal:         "openai/gpt-4o-mini-2024-07-18",
@@ -2367,8 +4091,14 @@ func init() {
 			DescCNVal:     "GPT-4o mini 是继 [GPT-4 Omni](/models/openai/gpt-4o) 之后 OpenAI 推出的最新模型，支持文本和图像输入，并生成文本输出。\n\n作为其最先进的小型模型，其成本远低于其他近期前沿模型，比 [GPT-3.5 Turbo](/models/openai/gpt-3.5-turbo) 便宜 60% 以上，同时保持业界领先的智能水平，性价比显著提升。\n\nGPT-4o mini 在 MMLU 基准测试中取得 82% 的得分，目前在主流聊天偏好排行榜上超越 GPT-4（参见 [常见排行榜](https://arena.lmsys.org/)）。\n\n了解更多详情，请查看 [发布公告](https://openai.com/index/gpt-4o-mini-advancing-cost-efficient-intelligence/)。\n\n#多模态",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000006", InputCacheRead: "0.000000075"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4o-mini-2024-07-18"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p", "web_search_options"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1721260800,
+			FamilyVal:     "gpt-4o-mini",
+			SnapshotVal:   1721260800,
 		},
 		"openai/gpt-4o-mini-search-preview": {
 			IDVal:         "openai/gpt-4o-mini-search-preview",
@@ -2378,8 +4108,15 @@ func init() {
 			DescCNVal:     "GPT-4o mini Search Preview 是专用于聊天补全（Chat Completions）中网络搜索的专用模型，经过训练以理解并执行网络搜索查询。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000006", WebSearch: "0.0275"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-4o-mini-search-preview"},
+			ParamList:     []string{"max_tokens", "response_format", "structured_outputs", "web_search_options"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1741818122,
+			StatusVal:     StatusPreview,
+			CanonicalVal:  "openai/gpt-4o-mini-search-preview-2025-03-11",
+			FamilyVal:     "gpt-4o-mini-search",
 		},
 		"openai/gpt-4o-search-preview": {
 			IDVal:         "openai/gpt-4o-search-preview",
@@ -2389,8 +4126,15 @@ func init() {
 			DescCNVal:     "GPT-4o Search Preview 是专用于聊天补全（Chat Completions）中网络搜索的专用模型，经过训练以理解并执行网络搜索查询。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.00001", WebSearch: "0.035"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-4o-search-preview"},
+			ParamList:     []string{"max_tokens", "response_format", "structured_outputs", "web_search_options"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1741817949,
+			StatusVal:     StatusPreview,
+			CanonicalVal:  "openai/gpt-4o-search-preview-2025-03-11",
+			FamilyVal:     "gpt-4o-search",
 		},
 		"openai/gpt-4o:extended": {
 			IDVal:         "openai/gpt-4o:extended",
@@ -2400,8 +4144,15 @@ func init() {
 			DescCNVal:     "GPT-4o（“o”代表“omni”）是 OpenAI 最新推出的 AI 模型，支持文本与图像输入，并生成文本输出。其智能水平与 [GPT-4 Turbo](/models/openai/gpt-4-turbo) 相当，但速度提升两倍，成本降低 50%。此外，GPT-4o 在非英语语言处理和视觉能力方面均有显著增强。\n\n在与其他模型的基准对比中，该模型曾短暂命名为 [\"im-also-a-good-gpt2-chatbot\"](https://twitter.com/LiamFedus/status/1790064963966370209)。\n\n#multimodal",
 			ContextLenVal: 128000,
 			MaxOutputVal:  64000,
+			PricingVal:    Pricing{Prompt: "0.000006", Completion: "0.000018"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-4o:extended"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p", "web_search_options"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1715558400,
+			CanonicalVal:  "openai/gpt-4o",
+			BaseVal:       "openai/gpt-4o",
+			FamilyVal:     "gpt-4o",
 		},
 		"openai/gpt-5": {
 			IDVal:         "openai/gpt-5",
@@ -2411,8 +4162,15 @@ func init() {
 			DescCNVal:     "GPT-5 是 OpenAI 最先进的模型，在推理能力、代码质量和用户体验方面实现重大提升。该模型针对需要逐步推理、指令遵循以及在高风险场景中保持高准确性的复杂任务进行了优化。支持测试时路由功能和高级提示理解能力，包括用户指定的意图（如“认真思考此问题”）。改进包括减少幻觉和迎合倾向，并在编码、写作及健康相关任务中表现更佳。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00001", InputCacheRead: "0.000000125", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1754587413,
+			CanonicalVal:  "openai/gpt-5-2025-08-07",
+			FamilyVal:     "gpt",
+			VersionVal:    "5",
 		},
 		"openai/gpt-5-chat": {
 			IDVal:         "openai/gpt-5-chat",
@@ -2422,8 +4180,15 @@ func init() {
 			DescCNVal:     "GPT-5 Chat 专为企业级应用设计，支持高级、自然、多模态且具备上下文感知能力的对话。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00001", InputCacheRead: "0.000000125", WebSearch: "0.01"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5-chat"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "structured_outputs"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1754587837,
+			CanonicalVal:  "openai/gpt-5-chat-2025-08-07",
+			FamilyVal:     "gpt-chat",
+			VersionVal:    "5",
 		},
 		"openai/gpt-5-codex": {
 			IDVal:         "openai/gpt-5-codex",
@@ -2433,8 +4198,14 @@ func init() {
 			DescCNVal:     "GPT-5-Codex 是 GPT-5 的专用版本，针对软件工程和编码工作流进行了优化。它既适用于交互式开发会话，也适用于长时间独立执行复杂工程任务。该模型支持从零构建项目、功能开发、调试、大规模重构和代码审查。相比 GPT-5，Codex 更具可控性，能更严格遵循开发者指令，并生成更简洁、高质量的代码。推理强度可通过 `reasoning.effort` 参数调节。[详见文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#reasoning-effort-level)\n\nCodex 可集成至 CLI、IDE 插件、GitHub 和云任务等开发者环境。它能动态调整推理强度——对小型任务快速响应，对大型项目则可持续运行数小时。该模型经过训练，可执行结构化代码审查，通过推理依赖关系并验证测试行为来发现关键缺陷。它还支持图像或截图等多模态输入用于 UI 开发，并集成工具用于搜索、依赖安装和环境配置。Codex 专为智能体驱动的编码应用而设计。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00001", InputCacheRead: "0.000000125"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5-codex"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1758643403,
+			FamilyVal:     "gpt-codex",
+			VersionVal:    "5",
 		},
 		"openai/gpt-5-image": {
 			IDVal:         "openai/gpt-5-image",
@@ -2444,8 +4215,14 @@ func init() {
 			DescCNVal:     "[GPT-5](https://openrouter.ai/openai/gpt-5) Image 将 OpenAI 的 GPT-5 模型与尖端图像生成能力相结合，在推理能力、代码质量和用户体验方面实现显著提升，同时继承了 GPT Image 1 在指令遵循、文本渲染和精细图像编辑方面的卓越表现。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityImageOut | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00001", Completion: "0.00001", InputCacheRead: "0.00000125", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityImageOut | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5-image"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1760447986,
+			FamilyVal:     "gpt-image",
+			VersionVal:    "5",
 		},
 		"openai/gpt-5-image-mini": {
 			IDVal:         "openai/gpt-5-image-mini",
@@ -2455,8 +4232,14 @@ func init() {
 			DescCNVal:     "GPT-5 Image Mini 结合了由 [GPT-5 Mini](https://openrouter.ai/openai/gpt-5-mini) 驱动的先进语言能力与 GPT Image 1 Mini 的高效图像生成能力。这一原生多模态模型具备卓越的指令遵循、文本渲染和精细图像编辑能力，同时显著降低延迟与成本。它在高质量视觉内容创作方面表现出色，并保持强大的文本理解能力，非常适合需要大规模高效图像生成与文本处理的应用场景。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityImageOut | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.000002", InputCacheRead: "0.00000025", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityImageOut | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5-image-mini"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1760624583,
+			FamilyVal:     "gpt-image-mini",
+			VersionVal:    "5",
 		},
 		"openai/gpt-5-mini": {
 			IDVal:         "openai/gpt-5-mini",
@@ -2466,8 +4249,15 @@ func init() {
 			DescCNVal:     "GPT-5 Mini 是 GPT-5 的紧凑版本，专为轻量级推理任务设计。它继承了 GPT-5 的指令遵循与安全对齐优势，同时显著降低延迟和成本。GPT-5 Mini 是 OpenAI o4-mini 模型的继任者。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000025", Completion: "0.000002", InputCacheRead: "0.000000025", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5-mini"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1754587407,
+			CanonicalVal:  "openai/gpt-5-mini-2025-08-07",
+			FamilyVal:     "gpt-mini",
+			VersionVal:    "5",
 		},
 		"openai/gpt-5-nano": {
 			IDVal:         "openai/gpt-5-nano",
@@ -2477,8 +4267,15 @@ func init() {
 			DescCNVal:     "GPT-5-Nano 是 GPT-5 系列中最小、最快的变体，专为开发者工具、快速交互和超低延迟环境优化。尽管其推理深度较大型版本有所限制，但仍保留了关键的指令遵循与安全特性。该模型是 GPT-4.1-nano 的继任者，为成本敏感或实时应用场景提供轻量级选择。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000005", Completion: "0.0000004", InputCacheRead: "0.000000005", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5-nano"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1754587402,
+			CanonicalVal:  "openai/gpt-5-nano-2025-08-07",
+			FamilyVal:     "gpt-nano",
+			VersionVal:    "5",
 		},
 		"openai/gpt-5-pro": {
 			IDVal:         "openai/gpt-5-pro",
@@ -2488,8 +4285,15 @@ func init() {
 			DescCNVal:     "GPT-5 Pro 是 OpenAI 最先进的模型，在推理能力、代码质量和用户体验方面均有重大提升。该模型针对需逐步推理、精准遵循指令及高风险场景下高准确性的复杂任务进行了优化。支持运行时路由功能和高级提示理解能力，包括识别用户指定意图（如“认真思考此问题”）。改进包括降低幻觉与迎合倾向，并在编程、写作及健康相关任务中表现更优。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000015", Completion: "0.00012", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5-pro"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1759776663,
+			CanonicalVal:  "openai/gpt-5-pro-2025-10-06",
+			FamilyVal:     "gpt-pro",
+			VersionVal:    "5",
 		},
 		"openai/gpt-5.1": {
 			IDVal:         "openai/gpt-5.1",
@@ -2499,8 +4303,15 @@ func init() {
 			DescCNVal:     "GPT-5.1 是 GPT-5 系列最新的前沿级模型，在通用推理能力、指令遵循性和自然对话风格方面均优于 GPT-5。它采用自适应推理机制，动态分配计算资源：对简单查询快速响应，对复杂任务投入更深推理。模型输出更清晰、更贴近事实的解释，减少术语使用，即使面对技术性或多步骤问题也易于理解。\n\n为广泛任务覆盖而构建，GPT-5.1 在数学、编程和结构化分析负载上持续提升，提供更连贯的长文本回答及更可靠的工具调用能力。同时，其对话对齐经过精细调优，在不牺牲准确性的前提下实现更亲切、直观的回应。GPT-5.1 是 GPT-5 的主要全功能继任者。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00001", InputCacheRead: "0.000000125", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5.1"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1763060305,
+			CanonicalVal:  "openai/gpt-5.1-20251113",
+			FamilyVal:     "gpt",
+			VersionVal:    "5.1",
 		},
 		"openai/gpt-5.1-chat": {
 			IDVal:         "openai/gpt-5.1-chat",
@@ -2510,8 +4321,15 @@ func init() {
 			DescCNVal:     "GPT-5.1 Chat（又名 Instant）是 5.1 系列中轻量、快速的成员，专为低延迟聊天优化，同时保留强大的通用智能。它采用自适应推理机制，仅在处理较难查询时选择性“深入思考”，从而在不影响常规对话速度的前提下提升数学、编程及多步骤任务的准确性。该模型默认更具亲和力和对话感，指令遵循能力更强，短文本推理更稳定。GPT-5.1 Chat 专为高吞吐、交互式工作负载设计，在响应速度与一致性比深度推理更重要的场景中表现出色。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00001", InputCacheRead: "0.000000125", WebSearch: "0.01"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5.1-chat"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1763060302,
+			CanonicalVal:  "openai/gpt-5.1-chat-20251113",
+			FamilyVal:     "gpt-chat",
+			VersionVal:    "5.1",
 		},
 		"openai/gpt-5.1-codex": {
 			IDVal:         "openai/gpt-5.1-codex",
@@ -2521,8 +4339,15 @@ func init() {
 			DescCNVal:     "GPT-5.1-Codex 是 GPT-5.1 的专用版本，针对软件工程与编程工作流优化。它既适用于交互式开发会话，也能独立执行复杂的长期工程任务。模型支持从零构建项目、功能开发、调试、大规模重构及代码审查。相比 GPT-5.1，Codex 更具可控性，严格遵循开发者指令，并生成更简洁、高质量的代码。可通过 `reasoning.effort` 参数调节推理强度，详见[文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#reasoning-effort-level)。\n\nCodex 可集成至 CLI、IDE 插件、GitHub 及云任务等开发者环境，能动态调整推理强度——小任务快速响应，大型项目则可持续运行数小时。模型经过专门训练，可执行结构化代码审查，通过依赖分析与测试验证识别关键缺陷。此外，它还支持 UI 开发所需的多模态输入（如图像或截图），并集成工具用于搜索、依赖安装及环境配置。Codex 专为智能体编程应用而设计。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00001", InputCacheRead: "0.000000125"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5.1-codex"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1763060298,
+			CanonicalVal:  "openai/gpt-5.1-codex-20251113",
+			FamilyVal:     "gpt-codex",
+			VersionVal:    "5.1",
 		},
 		"openai/gpt-5.1-codex-max": {
 			IDVal:         "openai/gpt-5.1-codex-max",
@@ -2532,8 +4357,15 @@ func init() {
 			DescCNVal:     "GPT-5.1-Codex-Max 是 OpenAI 最新的智能体编程模型，专为长时间运行、高上下文的软件开发任务而设计。该模型基于更新版的 5.1 推理架构，并在涵盖软件工程、数学和科研领域的智能体工作流上进行训练。\nGPT-5.1-Codex-Max 在整个开发生命周期中提供更快的性能、更强的推理能力以及更高的 Token 利用效率。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000125", Completion: "0.00001", InputCacheRead: "0.000000125", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5.1-codex-max"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1764878934,
+			CanonicalVal:  "openai/gpt-5.1-codex-max-20251204",
+			FamilyVal:     "gpt-codex-max",
+			VersionVal:    "5.1",
 		},
 		"openai/gpt-5.1-codex-mini": {
 			IDVal:         "openai/gpt-5.1-codex-mini",
@@ -2543,8 +4375,15 @@ func init() {
 			DescCNVal:     "GPT-5.1-Codex-Mini 是 GPT-5.1-Codex 的更小、更快版本。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  100000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000025", Completion: "0.000002", InputCacheRead: "0.000000025"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5.1-codex-mini"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1763057820,
+			CanonicalVal:  "openai/gpt-5.1-codex-mini-20251113",
+			FamilyVal:     "gpt-codex-mini",
+			VersionVal:    "5.1",
 		},
 		"openai/gpt-5.2": {
 			IDVal:         "openai/gpt-5.2",
@@ -2554,8 +4393,15 @@ func init() {
 			DescCNVal:     "GPT-5.2 是 GPT-5 系列中最新的前沿级模型，在智能体能力和长上下文性能方面较 GPT-5.1 有显著提升。该模型采用自适应推理机制，动态分配计算资源，对简单查询快速响应，同时对复杂任务投入更深层次的处理。\n\nGPT-5.2 面向广泛任务场景设计，在数学、编程、科学及工具调用等负载上均实现一致性能提升，并能生成更具连贯性的长篇回答，同时提高工具使用的可靠性。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000175", Completion: "0.000014", InputCacheRead: "0.000000175", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5.2"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1765389775,
+			CanonicalVal:  "openai/gpt-5.2-20251211",
+			FamilyVal:     "gpt",
+			VersionVal:    "5.2",
 		},
 		"openai/gpt-5.2-chat": {
 			IDVal:         "openai/gpt-5.2-chat",
@@ -2565,8 +4411,15 @@ func init() {
 			DescCNVal:     "GPT-5.2 Chat（又称 Instant）是 GPT-5.2 系列中轻量、高速的成员，专为低延迟对话优化，同时保留强大的通用智能。该模型采用自适应推理机制，仅在面对较难查询时才启动深度思考，从而在不拖慢常规对话的前提下提升数学、编程及多步骤任务的准确性。默认设置下，模型语气更亲切自然，指令遵循能力更强，短程推理也更加稳定。GPT-5.2 Chat 专为高吞吐、交互式工作负载设计，在响应速度与一致性比深度推理更为关键的场景中表现优异。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000175", Completion: "0.000014", InputCacheRead: "0.000000175", WebSearch: "0.01"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5.2-chat"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1765389783,
+			CanonicalVal:  "openai/gpt-5.2-chat-20251211",
+			FamilyVal:     "gpt-chat",
+			VersionVal:    "5.2",
 		},
 		"openai/gpt-5.2-codex": {
 			IDVal:         "openai/gpt-5.2-codex",
@@ -2576,8 +4429,15 @@ func init() {
 			DescCNVal:     "GPT-5.2-Codex 是 GPT-5.1-Codex 的升级版，针对软件工程与编程工作流进行了优化，适用于交互式开发会话及长时间独立执行复杂工程任务。该模型支持从零构建项目、功能开发、调试、大规模重构及代码审查。相比 GPT-5.1-Codex，5.2-Codex 更具可控性，严格遵循开发者指令，并生成更简洁、高质量的代码。可通过 `reasoning.effort` 参数调节推理强度。详见[文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#reasoning-effort-level)。\n\nCodex 可集成至 CLI、IDE 插件、GitHub 及云任务等开发者环境，能动态调整推理强度——小任务快速响应，大型项目则可持续运行数小时。该模型经过训练，可执行结构化代码审查，通过分析依赖关系并对照测试验证行为，识别关键缺陷。此外，它还支持 UI 开发所需的图像或多模态输入，并集成工具用于搜索、依赖安装及环境配置。Codex 专为智能体编程应用场景而设计。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000175", Completion: "0.000014", InputCacheRead: "0.000000175", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5.2-codex"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "reasoning", "response_format", "seed", "stop", "structured_outputs", "tool_choice", "tools", "top_logprobs"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1768409315,
+			CanonicalVal:  "openai/gpt-5.2-codex-20260114",
+			FamilyVal:     "gpt-codex",
+			VersionVal:    "5.2",
 		},
 		"openai/gpt-5.2-pro": {
 			IDVal:         "openai/gpt-5.2-pro",
@@ -2587,8 +4447,15 @@ func init() {
 			DescCNVal:     "GPT-5.2 Pro 是 OpenAI 最先进的模型，在智能体编程和长上下文性能方面相较 GPT-5 Pro 有重大提升。该模型专为需要逐步推理、精准指令遵循及高可靠性输出的高风险应用场景而优化。它支持运行时路由功能和高级提示理解能力，包括识别用户指定意图（如“对此深入思考”）。改进之处包括显著降低幻觉与迎合倾向，并在编程、写作及健康相关任务中表现更佳。",
 			ContextLenVal: 400000,
 			MaxOutputVal:  128000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000021", Completion: "0.000168", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"gpt-5.2-pro"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1765389780,
+			CanonicalVal:  "openai/gpt-5.2-pro-20251211",
+			FamilyVal:     "gpt-pro",
+			VersionVal:    "5.2",
 		},
 		"openai/gpt-audio": {
 			IDVal:         "openai/gpt-audio",
@@ -2598,8 +4465,13 @@ func init() {
 			DescCNVal:     "gpt-audio 模型是 OpenAI 首款通用音频模型。新快照版本采用升级版解码器，可生成更自然的人声并保持更好的音色一致性。音频输入定价为每百万 token 32 美元，输出定价为每百万 token 64 美元。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.0000025", Completion: "0.00001", Audio: "0.000032"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityAudioIn | ModalityAudioOut | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-audio"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1768862569,
+			FamilyVal:     "gpt-audio",
 		},
 		"openai/gpt-audio-mini": {
 			IDVal:         "openai/gpt-audio-mini",
@@ -2609,8 +4481,13 @@ func init() {
 			DescCNVal:     "GPT Audio 的高性价比版本。新快照版本采用升级版解码器，可生成更自然的人声并保持更好的音色一致性。输入定价为每百万 token 0.60 美元，输出定价为每百万 token 2.40 美元。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.0000006", Completion: "0.0000024", Audio: "0.0000006"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityAudioIn | ModalityAudioOut | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-audio-mini"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1768859419,
+			FamilyVal:     "gpt-audio-mini",
 		},
 		"openai/gpt-oss-120b": {
 			IDVal:         "openai/gpt-oss-120b",
@@ -2620,8 +4497,17 @@ func init() {
 			DescCNVal:     "gpt-oss-120b 是 OpenAI 发布的开源权重、1170 亿参数的混合专家（MoE）语言模型，面向高阶推理、智能体及通用生产场景。每次前向传递激活 51 亿参数，并针对单张 H100 GPU 与原生 MXFP4 量化进行优化。模型支持可配置的推理深度、完整的思维链访问以及原生工具调用能力，包括函数调用、网页浏览和结构化输出生成。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.000000039", Completion: "0.00000019"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-oss-120b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "reasoning_effort", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReasoningVal:  ReasoningConfig{Efforts: []string{"low", "medium", "high"}},
+			ReleasedVal:   1754414231,
+			HFIDVal:       "openai/gpt-oss-120b",
+			VariantList:   []string{"openai/gpt-oss-120b:exacto", "openai/gpt-oss-120b:free"},
+			FamilyVal:     "gpt-oss",
+			SizeVal:       "120B",
 		},
 		"openai/gpt-oss-120b:exacto": {
 			IDVal:         "openai/gpt-oss-120b:exacto",
@@ -2631,8 +4517,17 @@ func init() {
 			DescCNVal:     "gpt-oss-120b 是 OpenAI 发布的开源权重、1170 亿参数的混合专家（MoE）语言模型，面向高阶推理、智能体及通用生产场景。每次前向传递激活 51 亿参数，并针对单张 H100 GPU 与原生 MXFP4 量化进行优化。模型支持可配置的推理深度、完整的思维链访问以及原生工具调用能力，包括函数调用、网页浏览和结构化输出生成。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.000000039", Completion: "0.00000019"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-oss-120b:exacto"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1754414231,
+			CanonicalVal:  "openai/gpt-oss-120b",
+			HFIDVal:       "openai/gpt-oss-120b",
+			BaseVal:       "openai/gpt-oss-120b",
+			FamilyVal:     "gpt-oss",
+			SizeVal:       "120B",
 		},
 		"openai/gpt-oss-120b:free": {
 			IDVal:         "openai/gpt-oss-120b:free",
@@ -2642,8 +4537,17 @@ func init() {
 			DescCNVal:     "gpt-oss-120b 是 OpenAI 发布的开源权重、1170 亿参数的混合专家（MoE）语言模型，面向高阶推理、智能体及通用生产场景。每次前向传递激活 51 亿参数，并针对单张 H100 GPU 与原生 MXFP4 量化进行优化。模型支持可配置的推理深度、完整的思维链访问以及原生工具调用能力，包括函数调用、网页浏览和结构化输出生成。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-oss-120b:free"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "seed", "stop", "temperature", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1754414231,
+			CanonicalVal:  "openai/gpt-oss-120b",
+			HFIDVal:       "openai/gpt-oss-120b",
+			BaseVal:       "openai/gpt-oss-120b",
+			FamilyVal:     "gpt-oss",
+			SizeVal:       "120B",
 		},
 		"openai/gpt-oss-20b": {
 			IDVal:         "openai/gpt-oss-20b",
@@ -2653,8 +4557,17 @@ func init() {
 			DescCNVal:     "gpt-oss-20b 是 OpenAI 在 Apache 2.0 许可下发布的开源权重、210 亿参数模型。采用混合专家（MoE）架构，每次前向传递激活 36 亿参数，针对低延迟推理和消费级或单 GPU 硬件部署进行了优化。该模型基于 OpenAI 的 Harmony 响应格式训练，支持推理层级配置、微调以及智能体能力，包括函数调用、工具使用和结构化输出。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000002", Completion: "0.0000001"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-oss-20b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "reasoning_effort", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "GPT",
+			ReasoningVal:  ReasoningConfig{Efforts: []string{"low", "medium", "high"}},
+			ReleasedVal:   1754414229,
+			HFIDVal:       "openai/gpt-oss-20b",
+			VariantList:   []string{"openai/gpt-oss-20b:free"},
+			FamilyVal:     "gpt-oss",
+			SizeVal:       "20B",
 		},
 		"openai/gpt-oss-20b:free": {
 			IDVal:         "openai/gpt-oss-20b:free",
@@ -2664,8 +4577,17 @@ func init() {
 			DescCNVal:     "gpt-oss-20b 是 OpenAI 在 Apache 2.0 许可下发布的开源权重、210 亿参数模型。采用混合专家（MoE）架构，每次前向传递激活 36 亿参数，针对低延迟推理和消费级或单 GPU 硬件部署进行了优化。该模型基于 OpenAI 的 Harmony 响应格式训练，支持推理层级配置、微调以及智能体能力，包括函数调用、工具使用和结构化输出。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-oss-20b:free"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "seed", "stop", "temperature", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1754414229,
+			CanonicalVal:  "openai/gpt-oss-20b",
+			HFIDVal:       "openai/gpt-oss-20b",
+			BaseVal:       "openai/gpt-oss-20b",
+			FamilyVal:     "gpt-oss",
+			SizeVal:       "20B",
 		},
 		"openai/gpt-oss-safeguard-20b": {
 			IDVal:         "openai/gpt-oss-safeguard-20b",
@@ -2675,8 +4597,15 @@ func init() {
 			DescCNVal:     "gpt-oss-safeguard-20b 是 OpenAI 基于 gpt-oss-20b 构建的安全推理模型。这款开源权重、210 亿参数的混合专家（MoE）模型在内容分类、大模型过滤及信任与安全标注等安全任务上具备更低延迟。\n\n更多关于此模型的信息，请参阅 OpenAI 的 gpt-oss-safeguard [用户指南](https://cookbook.openai.com/articles/gpt-oss-safeguard-guide)。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.000000075", Completion: "0.0000003", InputCacheRead: "0.000000037"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"gpt-oss-safeguard-20b"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "stop", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1761752836,
+			HFIDVal:       "openai/gpt-oss-safeguard-20b",
+			FamilyVal:     "gpt-oss-safeguard",
+			SizeVal:       "20B",
 		},
 		"openai/o1": {
 			IDVal:         "openai/o1",
@@ -2686,8 +4615,14 @@ func init() {
 			DescCNVal:     "o1 是 OpenAI 最新且最强的模型系列，设计上会花更多时间进行思考后再响应。该系列模型通过大规模强化学习训练，采用思维链（Chain of Thought）方式进行推理。\n\no1 模型针对数学、科学、编程及其他 STEM 领域任务进行了优化，在物理、化学和生物学等领域的基准测试中持续展现出博士级准确率。更多信息请参阅[发布公告](https://openai.com/o1)。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  100000,
+			PricingVal:    Pricing{Prompt: "0.000015", Completion: "0.00006", InputCacheRead: "0.0000075"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"o1"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1734459999,
+			CanonicalVal:  "openai/o1-2024-12-17",
+			FamilyVal:     "o1",
 		},
 		"openai/o1-pro": {
 			IDVal:         "openai/o1-pro",
@@ -2697,8 +4632,13 @@ func init() {
 			DescCNVal:     "o1 系列模型通过强化学习训练，能够在回答前进行思考并执行复杂推理。o1-pro 模型投入更多计算资源进行深度思考，从而持续提供更优质的答案。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  100000,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00015", Completion: "0.0006"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"o1-pro"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1742423211,
+			FamilyVal:     "o1-pro",
 		},
 		"openai/o3": {
 			IDVal:         "openai/o3",
@@ -2708,8 +4648,14 @@ func init() {
 			DescCNVal:     "o3 是一款在多个领域表现均衡且强大的模型，在数学、科学、编程和视觉推理任务中树立了新标杆。同时，它在技术写作和指令遵循方面也极为出色。适用于需结合文本、代码和图像进行多步骤分析的问题求解。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  100000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000008", InputCacheRead: "0.0000005", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"o3"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1744823457,
+			CanonicalVal:  "openai/o3-2025-04-16",
+			FamilyVal:     "o3",
 		},
 		"openai/o3-deep-research": {
 			IDVal:         "openai/o3-deep-research",
@@ -2719,8 +4665,14 @@ func init() {
 			DescCNVal:     "o3-deep-research 是 OpenAI 专为深度研究设计的高级模型，旨在处理复杂的多步骤研究任务。\n\n注意：此模型始终使用“web_search”工具，会产生额外费用。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  100000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00001", Completion: "0.00004", InputCacheRead: "0.0000025", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"o3-deep-research"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1760129661,
+			CanonicalVal:  "openai/o3-deep-research-2025-06-26",
+			FamilyVal:     "o3-deep-research",
 		},
 		"openai/o3-mini": {
 			IDVal:         "openai/o3-mini",
@@ -2730,8 +4682,15 @@ func init() {
 			DescCNVal:     "OpenAI o3-mini 是一款高性价比语言模型，专为 STEM 推理任务优化，在科学、数学和编程领域表现尤为突出。\n\n该模型支持 `reasoning_effort` 参数，可设为“high”、“medium”或“low”，以控制模型的思考时间，默认值为“medium”。OpenRouter 还提供模型别名 `openai/o3-mini-high`，默认将该参数设为“high”。\n\n模型具备三级可调推理强度，并支持函数调用、结构化输出和流式响应等关键开发者功能，但不包含视觉处理能力。\n\n相比前代模型，o3-mini 实现显著改进：专家评测者在 56% 的情况下更偏好其回答，且在复杂问题上重大错误减少 39%。在中等推理强度设置下，o3-mini 在 AIME 和 GPQA 等高难度推理评测中性能媲美更大的 o1 模型，同时保持更低延迟与成本。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  100000,
+			PricingVal:    Pricing{Prompt: "0.0000011", Completion: "0.0000044", InputCacheRead: "0.00000055"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"o3-mini"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1738351721,
+			CanonicalVal:  "openai/o3-mini-2025-01-31",
+			FamilyVal:     "o3-mini",
+			EquivList:     []string{"openai/o3-mini-high"},
 		},
 		"openai/o3-mini-high": {
 			IDVal:         "openai/o3-mini-high",
@@ -2741,8 +4700,15 @@ func init() {
 			DescCNVal:     "OpenAI o3-mini-high 与 [o3-mini](/openai/o3-mini) 为同一模型，仅将 reasoning_effort 参数设为 high。\n\no3-mini 是一款高性价比的语言模型，专为 STEM 推理任务优化，在科学、数学和编程领域表现尤为突出。该模型提供三种可调节的推理强度级别，并支持函数调用、结构化输出和流式响应等关键开发者功能，但不包含视觉处理能力。\n\n相比前代模型，o3-mini 表现显著提升：专家评测者在 56% 的情况下更偏好其回答，并在复杂问题上观察到重大错误减少 39%。在中等推理强度设置下，o3-mini 在 AIME 和 GPQA 等高难度推理评测中达到与更大规模的 o1 模型相当的性能，同时保持更低的延迟和成本。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  100000,
+			PricingVal:    Pricing{Prompt: "0.0000011", Completion: "0.0000044", InputCacheRead: "0.00000055"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"o3-mini-high"},
+			ParamList:     []string{"max_tokens", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1739372611,
+			CanonicalVal:  "openai/o3-mini-high-2025-01-31",
+			FamilyVal:     "o3-mini-high",
+			EquivList:     []string{"openai/o3-mini"},
 		},
 		"openai/o3-pro": {
 			IDVal:         "openai/o3-pro",
@@ -2752,8 +4718,14 @@ func init() {
 			DescCNVal:     "o 系列模型通过强化学习训练，在回答前进行思考，以执行复杂推理。o3-pro 模型投入更多算力进行深度思考，从而持续提供更优答案。\n\n注意：使用此模型需自带密钥（BYOK）。设置地址：https://openrouter.ai/settings/integrations",
 			ContextLenVal: 200000,
 			MaxOutputVal:  100000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00002", Completion: "0.00008", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"o3-pro"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1749598352,
+			CanonicalVal:  "openai/o3-pro-2025-06-10",
+			FamilyVal:     "o3-pro",
 		},
 		"openai/o4-mini": {
 			IDVal:         "openai/o4-mini",
@@ -2763,8 +4735,15 @@ func init() {
 			DescCNVal:     "OpenAI o4-mini 是 o 系列中一款紧凑型推理模型，在保持强大多模态和智能体能力的同时，针对快速、高性价比的性能进行了优化。该模型支持工具调用，在 AIME（配合 Python 达 99.5%）和 SWE-bench 等基准测试中展现出具有竞争力的推理与编码能力，超越前代 o3-mini，甚至在某些领域接近 o3。\n\n尽管模型规模较小，o4-mini 在 STEM 任务、视觉问题求解（如 MathVista、MMMU）和代码编辑方面仍具备高准确率。特别适用于对延迟或成本敏感的高吞吐场景。得益于其高效架构和精细化的强化学习训练，o4-mini 能够串联工具、生成结构化输出，并在极短时间内（通常不到一分钟）完成多步骤任务。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  100000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000011", Completion: "0.0000044", InputCacheRead: "0.000000275", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"o4-mini"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1744820942,
+			CanonicalVal:  "openai/o4-mini-2025-04-16",
+			FamilyVal:     "o4-mini",
+			EquivList:     []string{"openai/o4-mini-high"},
 		},
 		"openai/o4-mini-deep-research": {
 			IDVal:         "openai/o4-mini-deep-research",
@@ -2774,8 +4753,14 @@ func init() {
 			DescCNVal:     "o4-mini-deep-research 是 OpenAI 推出的速度更快、成本更低的深度研究模型，非常适合处理复杂的多步骤研究任务。\n\n注意：此模型始终使用“web_search”工具，会产生额外费用。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  100000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000008", InputCacheRead: "0.0000005", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"o4-mini-deep-research"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1760129642,
+			CanonicalVal:  "openai/o4-mini-deep-research-2025-06-26",
+			FamilyVal:     "o4-mini-deep-research",
 		},
 		"openai/o4-mini-high": {
 			IDVal:         "openai/o4-mini-high",
@@ -2785,8 +4770,15 @@ func init() {
 			DescCNVal:     "OpenAI o4-mini-high 与 [o4-mini](/openai/o4-mini) 为同一模型，仅将 reasoning_effort 参数设为 high。\n\nOpenAI o4-mini 是 o 系列中一款紧凑型推理模型，在保持强大多模态和智能体能力的同时，针对快速、高性价比的性能进行了优化。该模型支持工具调用，在 AIME（配合 Python 达 99.5%）和 SWE-bench 等基准测试中展现出具有竞争力的推理与编码能力，超越前代 o3-mini，甚至在某些领域接近 o3。\n\n尽管模型规模较小，o4-mini 在 STEM 任务、视觉问题求解（如 MathVista、MMMU）和代码编辑方面仍具备高准确率。特别适用于对延迟或成本敏感的高吞吐场景。得益于其高效架构和精细化的强化学习训练，o4-mini 能够串联工具、生成结构化输出，并在极短时间内（通常不到一分钟）完成多步骤任务。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  100000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000011", Completion: "0.0000044", InputCacheRead: "0.000000275", WebSearch: "0.01"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityFileIn | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"o4-mini-high"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "tool_choice", "tools"},
+			TokenizerVal:  "GPT",
+			ReleasedVal:   1744824212,
+			CanonicalVal:  "openai/o4-mini-high-2025-04-16",
+			FamilyVal:     "o4-mini-high",
+			EquivList:     []string{"openai/o4-mini"},
 		},
 		"openai/text-embedding-3-large": {
 			IDVal:         "openai/text-embedding-3-large",
@@ -2796,8 +4788,13 @@ func init() {
 			DescCNVal:     "OpenAI 功能最强大的嵌入模型。",
 			ContextLenVal: 8192,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{},
 			FeaturesVal:   CapEmbedding | ModalityTextIn,
 			AliasList:     []string{"text-embedding-3-large"},
+			ParamList:     []string{},
+			TokenizerVal:  "GPT",
+			FamilyVal:     "text-embedding-large",
+			VersionVal:    "3",
 		},
 		"opengvlab/internvl3-78b": {
 			IDVal:         "opengvlab/internvl3-78b",
@@ -2807,8 +4804,16 @@ func init() {
 			DescCNVal:     "InternVL3 系列是先进的多模态大语言模型（MLLM）。相较于 InternVL 2.5，InternVL3 展现出更强的多模态感知与推理能力。\n\n此外，InternVL3 在基准测试中对标 Qwen2.5 Chat 模型，其语言模块以 Qwen2.5 预训练基础模型为初始化起点。得益于原生多模态预训练（Native Multimodal Pre-Training），InternVL3 系列在整体文本性能上超越 Qwen2.5 系列。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.00000039"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"internvl3-78b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1757962555,
+			HFIDVal:       "OpenGVLab/InternVL3-78B",
+			FamilyVal:     "internvl",
+			VersionVal:    "3",
+			SizeVal:       "78B",
 		},
 		"openrouter/auto": {
 			IDVal:         "openrouter/auto",
@@ -2818,8 +4823,13 @@ func init() {
 			DescCNVal:     "您的提示将由元模型处理，并自动路由至以下数十种模型之一（见下文），以优化输出质量。\n\n要查看实际使用的模型，请访问 [Activity](/activity)，或读取响应中的 `model` 字段。计费将按所路由模型的标准费率执行。\n\n更多详情（包括如何自定义路由模型）请参阅我们的 [文档](/docs/guides/routing/routers/auto-router)。\n\n请求可能被路由至以下模型：\n- [openai/gpt-5.1](/openai/gpt-5.1)\n- [openai/gpt-5](/openai/gpt-5)\n- [openai/gpt-5-mini](/openai/gpt-5-mini)\n- [openai/gpt-5-nano](/openai/gpt-5-nano)\n- [openai/gpt-4.1](/openai/gpt-4.1)\n- [openai/gpt-4.1-mini](/openai/gpt-4.1-mini)\n- [openai/gpt-4.1-nano](/openai/gpt-4.1-nano)\n- [openai/gpt-4o](/openai/gpt-4o)\n- [openai/gpt-4o-2024-05-13](/openai/gpt-4o-2024-05-13)\n- [openai/gpt-4o-2024-08-06](/openai/gpt-4o-2024-08-06)\n- [openai/gpt-4o-2024-11-20](/openai/gpt-4o-2024-11-20)\n- [openai/gpt-4o-mini](/openai/gpt-4o-mini)\n- [openai/gpt-4o-mini-2024-07-18](/openai/gpt-4o-mini-2024-07-18)\n- [openai/gpt-4-turbo](/openai/gpt-4-turbo)\n- [openai/gpt-4-turbo-preview](/openai/gpt-4-turbo-preview)\n- [openai/gpt-4-1106-preview](/openai/gpt-4-1106-preview)\n- [openai/gpt-4](/openai/gpt-4)\n- [openai/gpt-3.5-turbo](/openai/gpt-3.5-turbo)\n- [openai/gpt-oss-120b](/openai/gpt-oss-120b)\n- [anthropic/claude-opus-4.5](/anthropic/claude-opus-4.5)\n- [anthropic/claude-opus-4.1](/anthropic/claude-opus-4.1)\n- [anthropic/claude-opus-4](/anthropic/claude-opus-4)\n- [anthropic/claude-sonnet-4.5](/anthropic/claude-sonnet-4.5)\n- [anthropic/claude-sonnet-4](/anthropic/claude-sonnet-4)\n- [anthropic/claude-3.7-sonnet](/anthropic/claude-3.7-sonnet)\n- [anthropic/claude-haiku-4.5](/anthropic/claude-haiku-4.5)\n- [anthropic/claude-3.5-haiku](/anthropic/claude-3.5-haiku)\n- [anthropic/claude-3-haiku](/anthropic/claude-3-haiku)\n- [google/gemini-3-pro-preview](/google/gemini-3-pro-preview)\n- [google/gemini-2.5-pro](/google/gemini-2.5-pro)\n- [google/gemini-2.0-flash-001](/google/gemini-2.0-flash-001)\n- [google/gemini-2.5-flash](/google/gemini-2.5-flash)\n- [mistralai/mistral-large](/mistralai/mistral-large)\n- [mistralai/mistral-large-2407](/mistralai/mistral-large-2407)\n- [mistralai/mistral-large-2411](/mistralai/mistral-large-2411)\n- [mistralai/mistral-medium-3.1](/mistralai/mistral-medium-3.1)\n- [mistralai/mistral-nemo](/mistralai/mistral-nemo)\n- [mistralai/mistral-7b-instruct](/mistralai/mistral-7b-instruct)\n- [mistralai/mixtral-8x7b-instruct](/mistralai/mixtral-8x7b-instruct)\n- [mistralai/mixtral-8x22b-instruct](/mistralai/mixtral-8x22b-instruct)\n- [mistralai/codestral-2508](/mistralai/codestral-2508)\n- [x-ai/grok-4](/x-ai/grok-4)\n- [x-ai/grok-3](/x-ai/grok-3)\n- [x-ai/grok-3-mini](/x-ai/grok-3-mini)\n- [deepseek/deepseek-r1](/deepseek/deepseek-r1)\n- [meta-llama/llama-3.3-70b-instruct](/meta-llama/llama-3.3-70b-instruct)\n- [meta-llama/llama-3.1-405b-instruct](/meta-llama/llama-3.1-405b-instruct)\n- [meta-llama/llama-3.1-70b-instruct](/meta-llama/llama-3.1-70b-instruct)\n- [meta-llama/llama-3.1-8b-instruct](/meta-llama/llama-3.1-8b-instruct)\n- [meta-llama/llama-3-70b-instruct](/meta-llama/llama-3-70b-instruct)\n- [meta-llama/llama-3-8b-instruct](/meta-llama/llama-3-8b-instruct)\n- [qwen/qwen3-235b-a22b](/qwen/qwen3-235b-a22b)\n- [qwen/qwen3-32b](/qwen/qwen3-32b)\n- [qwen/qwen3-14b](/qwen/qwen3-14b)\n- [cohere/command-r-plus-08-2024](/cohere/command-r-plus-08-2024)\n- [cohere/command-r-08-2024](/cohere/command-r-08-2024)\n- [moonshotai/kimi-k2-thinking](/moonshotai/kimi-k2-thinking)\n- [perplexity/sonar](/perplexity/sonar)",
 			ContextLenVal: 2000000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "-1", Completion: "-1"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"auto"},
+			ParamList:     []string{},
+			TokenizerVal:  "Router",
+			ReleasedVal:   1699401600,
+			FamilyVal:     "auto",
 		},
 		"openrouter/bodybuilder": {
 			IDVal:         "openrouter/bodybuilder",
@@ -2829,8 +4839,13 @@ func init() {
 			DescCNVal:     "将您的自然语言请求转换为结构化的 OpenRouter API 请求对象。只需描述您希望 AI 模型完成的任务，Body Builder 即可自动生成相应的 API 调用。例如：“使用 gemini 和 opus 从 1 数到 10。”\n\n此功能适用于创建多模型请求、自定义模型路由，或根据人类描述程序化生成 API 调用。\n\n**Beta 公告**：Body Builder 目前处于 Beta 阶段，免费使用。未来定价与功能可能发生变化。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "-1", Completion: "-1"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"bodybuilder"},
+			ParamList:     []string{},
+			TokenizerVal:  "Router",
+			ReleasedVal:   1764903653,
+			FamilyVal:     "bodybuilder",
 		},
 		"perplexity/sonar": {
 			IDVal:         "perplexity/sonar",
@@ -2840,8 +4855,13 @@ func init() {
 			DescCNVal:     "Sonar 轻量、经济、快速且易于使用——现已支持引用来源并可自定义信息源。该模型专为希望集成轻量级、高速问答功能的企业设计。",
 			ContextLenVal: 127072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000001", Completion: "0.000001", InternalReasoning: "0", Image: "0", Request: "0.005", WebSearch: "0"},
 			FeaturesVal:   CapChat | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"sonar"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "temperature", "top_k", "top_p", "web_search_options"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1738013808,
+			FamilyVal:     "sonar",
 		},
 		"perplexity/sonar-deep-research": {
 			IDVal:         "perplexity/sonar-deep-research",
@@ -2851,8 +4871,14 @@ func init() {
 			DescCNVal:     "Sonar Deep Research 是一款专注于研究的模型，专为跨复杂主题的多步骤检索、信息整合与推理而设计。它能自主搜索、阅读并评估信息源，并在收集信息过程中不断优化策略，从而在金融、科技、健康和时事等领域生成全面的研究报告。\n\n定价说明（[来源](https://docs.perplexity.ai/guides/pricing#detailed-pricing-breakdown-for-sonar-deep-research)）：\n- 输入 token 包含提示 token（用户输入）和引用 token（执行搜索后处理的 token）\n- Deep Research 会执行多次搜索以完成深度研究，搜索费用为每 1000 次搜索 5 美元。例如，一次包含 30 次搜索的请求将产生 0.15 美元的搜索费用\n- 推理是 Deep Research 中的一个独立步骤，模型会对研究阶段收集的所有材料进行大量自动化推理。此处的推理 token 与最终答案中的思维链（CoT）不同，指的是在生成输出前用于分析研究材料的内部推理 token，定价为每百万 token 3 美元",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000008", InternalReasoning: "0.000003", Image: "0", Request: "0", WebSearch: "0.005"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"sonar-deep-research"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "temperature", "top_k", "top_p", "web_search_options"},
+			TokenizerVal:  "Other",
+			InstructVal:   "deepseek-r1",
+			ReleasedVal:   1741311246,
+			FamilyVal:     "sonar-deep-research",
 		},
 		"perplexity/sonar-pro": {
 			IDVal:         "perplexity/sonar-pro",
@@ -2862,8 +4888,13 @@ func init() {
 			DescCNVal:     "注意：Sonar Pro 的定价已包含 Perplexity 搜索费用。详见[此处说明](https://docs.perplexity.ai/guides/pricing#detailed-pricing-breakdown-for-sonar-reasoning-pro-and-sonar-pro)\n\n面向需要更高级功能的企业用户，Sonar Pro API 可处理深入的多步骤查询，并具备更强的扩展性，例如平均每轮搜索可提供的引用数量是普通 Sonar 的两倍。此外，凭借更大的上下文窗口，它能够处理更长、更复杂的搜索请求及后续追问。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  8000,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000015", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0.005"},
 			FeaturesVal:   CapChat | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"sonar-pro"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "temperature", "top_k", "top_p", "web_search_options"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1741312423,
+			FamilyVal:     "sonar-pro",
 		},
 		"perplexity/sonar-pro-search": {
 			IDVal:         "perplexity/sonar-pro-search",
@@ -2873,8 +4904,13 @@ func init() {
 			DescCNVal:     "Sonar Pro 的全新 Pro Search 模式仅在 OpenRouter API 上提供，是 Perplexity 最先进的智能体搜索系统，专为深度推理与分析而设计。定价基于 token 数量外加每千次请求 18 美元。该模型驱动 Perplexity 平台上的 Pro Search 模式。\n\nSonar Pro Search 在 Sonar Pro 基础上增加了自主多步推理能力，不再局限于单次查询与结果整合，而是能够规划并执行完整的研究工作流，调用多种工具完成任务。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  8000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000015", InternalReasoning: "0", Image: "0", Request: "0.018", WebSearch: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"sonar-pro-search"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "structured_outputs", "temperature", "top_k", "top_p", "web_search_options"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1761854366,
+			FamilyVal:     "sonar-pro-search",
 		},
 		"perplexity/sonar-reasoning-pro": {
 			IDVal:         "perplexity/sonar-reasoning-pro",
@@ -2884,8 +4920,14 @@ func init() {
 			DescCNVal:     "注意：Sonar Pro 的定价已包含 Perplexity 搜索费用。详见[此处说明](https://docs.perplexity.ai/guides/pricing#detailed-pricing-breakdown-for-sonar-reasoning-pro-and-sonar-pro)\n\nSonar Reasoning Pro 是一款由 DeepSeek R1 驱动、采用思维链（Chain of Thought, CoT）技术的高端推理模型。专为高级应用场景设计，支持深入的多步骤查询，拥有更大的上下文窗口，并可在每次搜索中返回更多引用，从而生成更全面、更具扩展性的回答。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000002", Completion: "0.000008", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0.005"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"sonar-reasoning-pro"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "temperature", "top_k", "top_p", "web_search_options"},
+			TokenizerVal:  "Other",
+			InstructVal:   "deepseek-r1",
+			ReleasedVal:   1741313308,
+			FamilyVal:     "sonar-reasoning-pro",
 		},
 		"prime-intellect/intellect-3": {
 			IDVal:         "prime-intellect/intellect-3",
@@ -2895,8 +4937,17 @@ func init() {
 			DescCNVal:     "INTELLECT-3 是一款1060亿参数的混合专家（MoE）模型（激活参数约120亿），基于 GLM-4.5-Air-Base 经过监督微调（SFT）及大规模强化学习（RL）后训练而成。在数学、代码、科学及通用推理等任务上，其单位参数性能达到业界领先水平，持续超越众多更大规模的前沿模型。该模型专为强大多步问题求解设计，在结构化任务中保持高准确性，同时凭借 MoE 架构实现高效的推理性能。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000011"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"intellect-3"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.6},
+			ReleasedVal:   1764212534,
+			CanonicalVal:  "prime-intellect/intellect-3-20251126",
+			HFIDVal:       "PrimeIntellect/INTELLECT-3-FP8",
+			FamilyVal:     "intellect",
+			VersionVal:    "3",
 		},
 		"qwen/qwen-2.5-72b-instruct": {
 			IDVal:         "qwen/qwen-2.5-72b-instruct",
@@ -2906,8 +4957,17 @@ func init() {
 			DescCNVal:     "通义千问 2.5 72B 指令微调版。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000012", Completion: "0.00000039"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen-2.5-72b", "qwen2.5", "qwen-2.5-72b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen",
+			InstructVal:   "chatml",
+			ReleasedVal:   1726704000,
+			HFIDVal:       "Qwen/Qwen2.5-72B-Instruct",
+			FamilyVal:     "qwen",
+			VersionVal:    "2.5",
+			SizeVal:       "72B",
 		},
 		"qwen/qwen-2.5-7b-instruct": {
 			IDVal:         "qwen/qwen-2.5-7b-instruct",
@@ -2917,8 +4977,17 @@ func init() {
 			DescCNVal:     "Qwen2.5 7B 是通义千问大语言模型系列的最新版本，在 Qwen2 基础上带来以下改进：\n\n- 凭借领域专家模型，在知识储备、代码及数学能力方面显著增强。\n\n- 指令遵循能力大幅提升，支持生成长文本（超过 8K tokens），更好地理解结构化数据（如表格），并能高效生成结构化输出（尤其是 JSON）。对系统提示的多样性更具鲁棒性，显著提升聊天机器人的角色扮演和条件设定能力。\n\n- 支持最长 128K tokens 的上下文，最大生成长度达 8K tokens。\n\n- 支持 29 种以上语言，包括中文、英文、法语、西班牙语、葡萄牙语、德语、意大利语、俄语、日语、韩语、越南语、泰语、阿拉伯语等。\n\n使用本模型需遵守 [通义千问许可协议](https://huggingface.co/Qwen/Qwen1.5-110B-Chat/blob/main/LICENSE)。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000004", Completion: "0.0000001"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen-2.5-7b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen",
+			InstructVal:   "chatml",
+			ReleasedVal:   1729036800,
+			HFIDVal:       "Qwen/Qwen2.5-7B-Instruct",
+			FamilyVal:     "qwen",
+			VersionVal:    "2.5",
+			SizeVal:       "7B",
 		},
 		"qwen/qwen-2.5-coder-32b-instruct": {
 			IDVal:         "qwen/qwen-2.5-coder-32b-instruct",
@@ -2928,8 +4997,17 @@ func init() {
 			DescCNVal:     "Qwen2.5-Coder 是最新一代专注于代码的 Qwen 大语言模型系列（前身为 CodeQwen）。相较于 CodeQwen1.5，Qwen2.5-Coder 带来了以下改进：\n\n- 在**代码生成**、**代码推理**和**代码修复**方面显著提升；\n- 为实际应用场景（如**代码智能体**）提供了更全面的基础，不仅强化了编码能力，还保持了在数学和通用能力方面的优势。\n\n更多评测结果详见 [Qwen 2.5 Coder 博客](https://qwenlm.github.io/blog/qwen2.5-coder-family/)。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.00000003", Completion: "0.00000011"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen-2.5-coder-32b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Qwen",
+			InstructVal:   "chatml",
+			ReleasedVal:   1731368400,
+			HFIDVal:       "Qwen/Qwen2.5-Coder-32B-Instruct",
+			FamilyVal:     "qwen-coder",
+			VersionVal:    "2.5",
+			SizeVal:       "32B",
 		},
 		"qwen/qwen-2.5-vl-7b-instruct": {
 			IDVal:         "qwen/qwen-2.5-vl-7b-instruct",
@@ -2939,8 +5017,18 @@ func init() {
 			DescCNVal:     "Qwen2.5 VL 7B 是通义千问团队推出的多模态大语言模型，具备以下关键增强特性：\n\n- 多分辨率与比例图像的 SOTA 理解能力：Qwen2.5-VL 在 MathVista、DocVQA、RealWorldQA、MTVQA 等视觉理解基准测试中达到业界领先水平。\n\n- 支持 20 分钟以上视频理解：可高质量完成基于长视频的问答、对话、内容创作等任务。\n\n- 可操作手机、机器人等设备的智能体：凭借强大的复杂推理与决策能力，Qwen2.5-VL 可集成至手机、机器人等设备，根据视觉环境与文本指令实现自动化操作。\n\n- 多语言支持：除英语和中文外，现支持识别图像内多种语言文字，包括主流欧洲语言、日语、韩语、阿拉伯语、越南语等，服务全球用户。\n\n更多详情请参见[博客文章](https://qwenlm.github.io/blog/qwen2-vl/)和[GitHub 仓库](https://github.com/QwenLM/Qwen2-VL)。\n\n使用本模型需遵守[通义千问许可协议](https://huggingface.co/Qwen/Qwen1.5-110B-Chat/blob/main/LICENSE)。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000002"},
 			FeaturesVal:   CapChat | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen-2.5-vl-7b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1724803200,
+			CanonicalVal:  "qwen/qwen-2-vl-7b-instruct",
+			HFIDVal:       "Qwen/Qwen2.5-VL-7B-Instruct",
+			VariantList:   []string{"qwen/qwen-2.5-vl-7b-instruct:free"},
+			FamilyVal:     "qwen-vl",
+			VersionVal:    "2.5",
+			SizeVal:       "7B",
 		},
 		"qwen/qwen-2.5-vl-7b-instruct:free": {
 			IDVal:         "qwen/qwen-2.5-vl-7b-instruct:free",
@@ -2950,8 +5038,18 @@ func init() {
 			DescCNVal:     "Qwen2.5 VL 7B 是通义千问团队推出的多模态大语言模型，具备以下关键增强特性：\n\n- 多分辨率与比例图像的 SOTA 理解能力：Qwen2.5-VL 在 MathVista、DocVQA、RealWorldQA、MTVQA 等视觉理解基准测试中达到业界领先水平。\n\n- 支持 20 分钟以上视频理解：可高质量完成基于长视频的问答、对话、内容创作等任务。\n\n- 可操作手机、机器人等设备的智能体：凭借强大的复杂推理与决策能力，Qwen2.5-VL 可集成至手机、机器人等设备，根据视觉环境与文本指令实现自动化操作。\n\n- 多语言支持：除英语和中文外，现支持识别图像内多种语言文字，包括主流欧洲语言、日语、韩语、阿拉伯语、越南语等，服务全球用户。\n\n更多详情请参见[博客文章](https://qwenlm.github.io/blog/qwen2-vl/)和[GitHub 仓库](https://github.com/QwenLM/Qwen2-VL)。\n\n使用本模型需遵守[通义千问许可协议](https://huggingface.co/Qwen/Qwen1.5-110B-Chat/blob/main/LICENSE)。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen-2.5-vl-7b-instruct:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "temperature"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1724803200,
+			CanonicalVal:  "qwen/qwen-2-vl-7b-instruct",
+			HFIDVal:       "Qwen/Qwen2.5-VL-7B-Instruct",
+			BaseVal:       "qwen/qwen-2.5-vl-7b-instruct",
+			FamilyVal:     "qwen-vl",
+			VersionVal:    "2.5",
+			SizeVal:       "7B",
 		},
 		"qwen/qwen-max": {
 			IDVal:         "qwen/qwen-max",
@@ -2961,8 +5059,14 @@ func init() {
 			DescCNVal:     "Qwen-Max 基于 Qwen2.5 构建，是 [Qwen 系列模型](/qwen) 中推理性能最强的版本，尤其擅长复杂的多步骤任务。该大规模 MoE 模型在超过 20 万亿 token 上完成预训练，并进一步通过精选的监督微调（SFT）与人类反馈强化学习（RLHF）方法进行后训练。具体参数量未公开。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.0000016", Completion: "0.0000064", InputCacheRead: "0.00000064", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen-max"},
+			ParamList:     []string{"max_tokens", "presence_penalty", "response_format", "seed", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1738402289,
+			CanonicalVal:  "qwen/qwen-max-2025-01-25",
+			FamilyVal:     "qwen-max",
 		},
 		"qwen/qwen-plus": {
 			IDVal:         "qwen/qwen-plus",
@@ -2972,8 +5076,14 @@ func init() {
 			DescCNVal:     "Qwen-Plus 基于 Qwen2.5 基础模型，上下文长度达 131K，兼顾性能、速度与成本。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.0000012", InputCacheRead: "0.00000016", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen-plus"},
+			ParamList:     []string{"max_tokens", "presence_penalty", "response_format", "seed", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1738409840,
+			CanonicalVal:  "qwen/qwen-plus-2025-01-25",
+			FamilyVal:     "qwen-plus",
 		},
 		"qwen/qwen-plus-2025-07-28": {
 			IDVal:         "qwen/qwen-plus-2025-07-28",
@@ -2983,8 +5093,15 @@ func init() {
 			DescCNVal:     "Qwen Plus 0728 基于 Qwen3 基础模型，是一款支持百万上下文的混合推理模型，在性能、速度与成本之间取得均衡。",
 			ContextLenVal: 1000000,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.0000012", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen-plus-2025-07-28"},
+			ParamList:     []string{"max_tokens", "presence_penalty", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1757347599,
+			VariantList:   []string{"qwen/qwen-plus-2025-07-28:thinking"},
+			FamilyVal:     "qwen-plus",
+			SnapshotVal:   1753660800,
 		},
 		"qwen/qwen-plus-2025-07-28:thinking": {
 			IDVal:         "qwen/qwen-plus-2025-07-28:thinking",
@@ -2994,8 +5111,16 @@ func init() {
 			DescCNVal:     "Qwen Plus 0728 基于 Qwen3 基础模型，是一款支持百万上下文的混合推理模型，在性能、速度与成本之间取得均衡。",
 			ContextLenVal: 1000000,
 			MaxOutputVal:  32768,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.000004", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen-plus-2025-07-28:thinking"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "presence_penalty", "reasoning", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1757347599,
+			CanonicalVal:  "qwen/qwen-plus-2025-07-28",
+			BaseVal:       "qwen/qwen-plus-2025-07-28",
+			FamilyVal:     "qwen-plus",
+			SnapshotVal:   1753660800,
 		},
 		"qwen/qwen-turbo": {
 			IDVal:         "qwen/qwen-turbo",
@@ -3005,8 +5130,14 @@ func init() {
 			DescCNVal:     "Qwen-Turbo 基于 Qwen2.5，上下文长度达 1M，具备高速度与低成本特性，适用于简单任务。",
 			ContextLenVal: 1000000,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.00000005", Completion: "0.0000002", InputCacheRead: "0.00000002", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen-turbo"},
+			ParamList:     []string{"max_tokens", "presence_penalty", "response_format", "seed", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1738410974,
+			CanonicalVal:  "qwen/qwen-turbo-2024-11-01",
+			FamilyVal:     "qwen-turbo",
 		},
 		"qwen/qwen-vl-max": {
 			IDVal:         "qwen/qwen-vl-max",
@@ -3016,8 +5147,14 @@ func init() {
 			DescCNVal:     "Qwen VL Max 是一款视觉理解模型，上下文长度达 7500 个 token，在更广泛的复杂任务中提供卓越性能。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.0000008", Completion: "0.0000032", InternalReasoning: "0", Image: "0.001024", Request: "0", WebSearch: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen-vl-max"},
+			ParamList:     []string{"max_tokens", "presence_penalty", "response_format", "seed", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1738434304,
+			CanonicalVal:  "qwen/qwen-vl-max-2025-01-25",
+			FamilyVal:     "qwen-vl-max",
 		},
 		"qwen/qwen-vl-plus": {
 			IDVal:         "qwen/qwen-vl-plus",
@@ -3027,8 +5164,13 @@ func init() {
 			DescCNVal:     "Qwen 增强版大视觉语言模型，显著提升了细节识别与文本识别能力，支持高达百万像素级的超高清分辨率及极端宽高比的图像输入，在各类视觉任务中均展现出显著性能优势。",
 			ContextLenVal: 7500,
 			MaxOutputVal:  1500,
+			PricingVal:    Pricing{Prompt: "0.00000021", Completion: "0.00000063", InternalReasoning: "0", Image: "0.0002688", Request: "0", WebSearch: "0"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen-vl-plus"},
+			ParamList:     []string{"max_tokens", "presence_penalty", "response_format", "seed", "temperature", "top_p"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1738731255,
+			FamilyVal:     "qwen-vl-plus",
 		},
 		"qwen/qwen2.5-coder-7b-instruct": {
 			IDVal:         "qwen/qwen2.5-coder-7b-instruct",
@@ -3038,8 +5180,16 @@ func init() {
 			DescCNVal:     "Qwen2.5-Coder-7B-Instruct 是一款拥有 70 亿参数的指令微调语言模型，专为代码生成、推理和调试等任务优化。基于 Qwen2.5 架构，集成了 RoPE、SwiGLU、RMSNorm 和 GQA 注意力机制，并通过 YaRN 插值技术支持高达 128K token 的上下文长度。该模型在大规模源代码、合成数据及文本-代码对齐语料上训练，可在多种编程语言和智能体编码工作流中提供稳健性能。\n\n作为 Qwen2.5-Coder 系列的一员，该模型与 vLLM 等高效部署工具高度兼容，并以 Apache 2.0 许可证发布。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000003", Completion: "0.00000009"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen2.5-coder-7b-instruct"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1744734887,
+			HFIDVal:       "Qwen/Qwen2.5-Coder-7B-Instruct",
+			FamilyVal:     "qwen-coder",
+			VersionVal:    "2.5",
+			SizeVal:       "7B",
 		},
 		"qwen/qwen2.5-vl-32b-instruct": {
 			IDVal:         "qwen/qwen2.5-vl-32b-instruct",
@@ -3049,8 +5199,16 @@ func init() {
 			DescCNVal:     "Qwen2.5-VL-32B 是一款通过强化学习微调的多模态视觉-语言模型，显著增强了数学推理、结构化输出及视觉问题求解能力。该模型在视觉分析任务中表现卓越，包括物体识别、图像内文本解析以及长视频中精确事件定位。在 MMMU、MathVista 和 VideoMME 等多模态基准测试中达到业界领先水平，同时在 MMLU、数学问题求解和代码生成等纯文本任务中也展现出强大的推理能力与清晰度。",
 			ContextLenVal: 16384,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000005", Completion: "0.00000022"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen2.5-vl-32b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1742839838,
+			HFIDVal:       "Qwen/Qwen2.5-VL-32B-Instruct",
+			FamilyVal:     "qwen-vl",
+			VersionVal:    "2.5",
+			SizeVal:       "32B",
 		},
 		"qwen/qwen2.5-vl-72b-instruct": {
 			IDVal:         "qwen/qwen2.5-vl-72b-instruct",
@@ -3060,8 +5218,18 @@ func init() {
 			DescCNVal:     "Qwen2.5-VL 擅长识别花卉、鸟类、鱼类和昆虫等常见物体，同时能高效分析图像中的文字、图表、图标、图形及版式布局。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000006"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen2.5-vl-72b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1738410311,
+			ExpiresVal:    1771200000,
+			StatusVal:     StatusDeprecated,
+			HFIDVal:       "Qwen/Qwen2.5-VL-72B-Instruct",
+			FamilyVal:     "qwen-vl",
+			VersionVal:    "2.5",
+			SizeVal:       "72B",
 		},
 		"qwen/qwen3-14b": {
 			IDVal:         "qwen/qwen3-14b",
@@ -3071,8 +5239,18 @@ func init() {
 			DescCNVal:     "Qwen3-14B 是 Qwen3 系列中的稠密因果语言模型，参数量达 148 亿，兼顾复杂推理与高效对话。该模型支持在“思考”模式（适用于数学、编程和逻辑推理等任务）与“非思考”模式（适用于通用对话）之间无缝切换。模型经过指令遵循、智能体工具调用、创意写作及 100 多种语言和方言的多语言任务微调，原生支持 32K token 上下文，并可通过基于 YaRN 的扩展技术将上下文长度提升至 131K tokens。",
 			ContextLenVal: 40960,
 			MaxOutputVal:  40960,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000005", Completion: "0.00000022"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-14b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			InstructVal:   "qwen3",
+			ReleasedVal:   1745876478,
+			CanonicalVal:  "qwen/qwen3-14b-04-28",
+			HFIDVal:       "Qwen/Qwen3-14B",
+			FamilyVal:     "qwen",
+			VersionVal:    "3",
+			SizeVal:       "14B",
 		},
 		"qwen/qwen3-235b-a22b": {
 			IDVal:         "qwen/qwen3-235b-a22b",
@@ -3082,8 +5260,18 @@ func init() {
 			DescCNVal:     "Qwen3-235B-A22B 是千问推出的 2350 亿参数稀疏专家混合（MoE）模型，每次前向传播激活 220 亿参数。该模型支持在“思考”模式（用于复杂推理、数学和代码任务）与“非思考”模式（用于高效通用对话）之间无缝切换，展现出强大的推理能力、多语言支持（覆盖 100 多种语言和方言）、高级指令遵循能力以及智能体工具调用功能。模型原生支持 32K token 上下文窗口，并可通过基于 YaRN 的扩展技术将上下文长度延伸至 131K tokens。",
 			ContextLenVal: 40960,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000006"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-235b-a22b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Qwen3",
+			InstructVal:   "qwen3",
+			ReleasedVal:   1745875757,
+			CanonicalVal:  "qwen/qwen3-235b-a22b-04-28",
+			HFIDVal:       "Qwen/Qwen3-235B-A22B",
+			FamilyVal:     "qwen",
+			VersionVal:    "3",
+			SizeVal:       "235B",
 		},
 		"qwen/qwen3-235b-a22b-2507": {
 			IDVal:         "qwen/qwen3-235b-a22b-2507",
@@ -3093,8 +5281,18 @@ func init() {
 			DescCNVal:     "Qwen3-235B-A22B-Instruct-2507 是基于 Qwen3-235B 架构的多语言指令微调混合专家语言模型，每次前向传播激活 220 亿参数。该模型针对通用文本生成任务优化，涵盖指令遵循、逻辑推理、数学、编程及工具调用等能力。模型原生支持 262K 令牌上下文长度，且未启用“思考模式”（即无 <think> 区块）。\n\n相较于基础版本，此版本在知识覆盖广度、长上下文推理、编程基准及开放式任务对齐方面均有显著提升。其在多语言理解、数学推理（如 AIME、HMMT）以及 Arena-Hard、WritingBench 等对齐评估中表现尤为突出。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.000000071", Completion: "0.000000463"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-235b-a22b-2507"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "reasoning_effort", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1753119555,
+			CanonicalVal:  "qwen/qwen3-235b-a22b-07-25",
+			HFIDVal:       "Qwen/Qwen3-235B-A22B-Instruct-2507",
+			FamilyVal:     "qwen",
+			VersionVal:    "3",
+			SizeVal:       "235B",
+			SnapshotVal:   1751328000,
 		},
 		"qwen/qwen3-235b-a22b-thinking-2507": {
 			IDVal:         "qwen/qwen3-235b-a22b-thinking-2507",
@@ -3104,8 +5302,18 @@ func init() {
 			DescCNVal:     "Qwen3-235B-A22B-Thinking-2507 是一款高性能、开源权重的混合专家（MoE）语言模型，专为复杂推理任务优化。每次前向传播激活其 2350 亿参数中的 220 亿，并原生支持最多 262,144 个上下文 token。该“纯推理”变体在结构化逻辑推理、数学、科学及长文本生成方面表现卓越，在 AIME、SuperGPQA、LiveCodeBench 和 MMLU-Redux 等基准测试中成绩优异。模型强制启用特殊推理模式（</think>），并针对高 token 输出（最高达 81,920 个 token）的挑战性领域进行优化。\n\n该模型经过指令微调，在逐步推理、工具调用、智能体工作流及多语言任务方面表现出色。此版本是 Qwen3-235B 系列中最强大的开源变体，在结构化推理应用场景中超越众多闭源模型。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  262144,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000011", Completion: "0.0000006"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-235b-a22b-thinking-2507"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			InstructVal:   "qwen3",
+			ReleasedVal:   1753449557,
+			HFIDVal:       "Qwen/Qwen3-235B-A22B-Thinking-2507",
+			FamilyVal:     "qwen-thinking",
+			VersionVal:    "3",
+			SizeVal:       "235B",
+			SnapshotVal:   1751328000,
 		},
 		"qwen/qwen3-30b-a3b": {
 			IDVal:         "qwen/qwen3-30b-a3b",
@@ -3115,8 +5323,18 @@ func init() {
 			DescCNVal:     "Qwen3 是千问大语言模型系列的最新一代，同时提供稠密架构与专家混合（MoE）架构，在推理能力、多语言支持和高级智能体任务方面表现卓越。其独特之处在于可无缝切换“思考”模式（用于复杂推理）与“非思考”模式（用于高效对话），确保多功能、高质量的性能表现。\n\n相比 QwQ 和 Qwen2.5 等前代模型，Qwen3 在数学、编程、常识推理、创意写作和交互式对话等方面显著提升。Qwen3-30B-A3B 变体包含 305 亿总参数（每任务激活 33 亿参数）、48 层网络结构、128 个专家（每任务激活 8 个），并借助 YaRN 技术支持高达 131K token 的上下文长度，为开源模型树立了新标杆。",
 			ContextLenVal: 40960,
 			MaxOutputVal:  40960,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000006", Completion: "0.00000022"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-30b-a3b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			InstructVal:   "qwen3",
+			ReleasedVal:   1745878604,
+			CanonicalVal:  "qwen/qwen3-30b-a3b-04-28",
+			HFIDVal:       "Qwen/Qwen3-30B-A3B",
+			FamilyVal:     "qwen",
+			VersionVal:    "3",
+			SizeVal:       "30B",
 		},
 		"qwen/qwen3-30b-a3b-instruct-2507": {
 			IDVal:         "qwen/qwen3-30b-a3b-instruct-2507",
@@ -3126,8 +5344,17 @@ func init() {
 			DescCNVal:     "Qwen3-30B-A3B-Instruct-2507 是千问推出的 305 亿参数混合专家（MoE）语言模型，每次推理激活 33 亿参数。该模型运行于非推理模式，专为高质量指令遵循、多语言理解及智能体工具调用而设计。经指令数据后训练，其在推理（AIME、ZebraLogic）、代码（MultiPL-E、LiveCodeBench）和对齐（IFEval、WritingBench）等基准测试中表现优异。相比非指令微调版本，该模型在主观性和开放式任务上表现更佳，同时保持强大的事实性和代码能力。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  262144,
+			PricingVal:    Pricing{Prompt: "0.00000008", Completion: "0.00000033"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-30b-a3b-instruct-2507"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1753806965,
+			HFIDVal:       "Qwen/Qwen3-30B-A3B-Instruct-2507",
+			FamilyVal:     "qwen",
+			VersionVal:    "3",
+			SizeVal:       "30B",
+			SnapshotVal:   1751328000,
 		},
 		"qwen/qwen3-30b-a3b-thinking-2507": {
 			IDVal:         "qwen/qwen3-30b-a3b-thinking-2507",
@@ -3137,8 +5364,17 @@ func init() {
 			DescCNVal:     "Qwen3-30B-A3B-Thinking-2507 是一款 300 亿参数的专家混合（MoE）推理模型，专为需要扩展多步思考的复杂任务而优化。该模型专为“思考模式”设计，将内部推理轨迹与最终答案分离。\n\n相较于早期 Qwen3-30B 版本，此版本在逻辑推理、数学、科学、编程及多语言基准测试中均有性能提升，同时展现出更强的指令遵循能力、工具使用能力以及与人类偏好的对齐度。凭借更高的推理效率和更长的输出预算，该模型特别适用于高级研究、竞赛级问题求解以及需要结构化长上下文推理的智能体应用。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.000000051", Completion: "0.00000034"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-30b-a3b-thinking-2507"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1756399192,
+			HFIDVal:       "Qwen/Qwen3-30B-A3B-Thinking-2507",
+			FamilyVal:     "qwen-thinking",
+			VersionVal:    "3",
+			SizeVal:       "30B",
+			SnapshotVal:   1751328000,
 		},
 		"qwen/qwen3-32b": {
 			IDVal:         "qwen/qwen3-32b",
@@ -3148,8 +5384,18 @@ func init() {
 			DescCNVal:     "Qwen3-32B 是 Qwen3 系列中的稠密因果语言模型，参数量达 328 亿，专为复杂推理与高效对话双重目标优化。该模型支持在“思考”模式（用于数学、编程和逻辑推理等任务）与“非思考”模式（用于更快速的通用对话）之间无缝切换。模型在指令遵循、智能体工具调用、创意写作及 100 多种语言和方言的多语言任务中均表现出色，原生支持 32K token 上下文，并可通过基于 YaRN 的扩展技术将上下文长度提升至 131K tokens。",
 			ContextLenVal: 40960,
 			MaxOutputVal:  40960,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000008", Completion: "0.00000024"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-32b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Qwen3",
+			InstructVal:   "qwen3",
+			ReleasedVal:   1745875945,
+			CanonicalVal:  "qwen/qwen3-32b-04-28",
+			HFIDVal:       "Qwen/Qwen3-32B",
+			FamilyVal:     "qwen",
+			VersionVal:    "3",
+			SizeVal:       "32B",
 		},
 		"qwen/qwen3-4b:free": {
 			IDVal:         "qwen/qwen3-4b:free",
@@ -3159,8 +5405,18 @@ func init() {
 			DescCNVal:     "Qwen3-4B 是 Qwen3 系列中的 40 亿参数稠密语言模型，兼顾通用任务与高推理强度任务。该模型引入双模架构——“思考”模式与“非思考”模式，可动态切换于高精度逻辑推理与高效对话生成之间，非常适合多轮对话、指令遵循及复杂智能体工作流场景。",
 			ContextLenVal: 40960,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-4b:free"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			InstructVal:   "qwen3",
+			ReleasedVal:   1746031104,
+			CanonicalVal:  "qwen/qwen3-4b-04-28",
+			HFIDVal:       "Qwen/Qwen3-4B",
+			FamilyVal:     "qwen",
+			VersionVal:    "3",
+			SizeVal:       "4B",
 		},
 		"qwen/qwen3-8b": {
 			IDVal:         "qwen/qwen3-8b",
@@ -3170,8 +5426,18 @@ func init() {
 			DescCNVal:     "Qwen3-8B 是 Qwen3 系列中的稠密因果语言模型，参数量达 82 亿，兼顾高推理负载任务与高效对话。该模型支持在“思考”模式（用于数学、编程和逻辑推理）与“非思考”模式（用于通用对话）之间无缝切换。模型经过指令遵循、智能体集成、创意写作及 100 多种语言和方言的多语言任务微调，原生支持 32K token 上下文窗口，并可通过 YaRN 扩展技术将上下文长度提升至 131K tokens。",
 			ContextLenVal: 32000,
 			MaxOutputVal:  8192,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000005", Completion: "0.00000025", InputCacheRead: "0.00000005"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-8b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Qwen3",
+			InstructVal:   "qwen3",
+			ReleasedVal:   1745876632,
+			CanonicalVal:  "qwen/qwen3-8b-04-28",
+			HFIDVal:       "Qwen/Qwen3-8B",
+			FamilyVal:     "qwen",
+			VersionVal:    "3",
+			SizeVal:       "8B",
 		},
 		"qwen/qwen3-coder": {
 			IDVal:         "qwen/qwen3-coder",
@@ -3181,8 +5447,17 @@ func init() {
 			DescCNVal:     "Qwen3-Coder-480B-A35B-Instruct 是千问团队开发的混合专家（MoE）代码生成模型，专为智能体编程任务优化，如函数调用、工具使用及基于仓库的长上下文推理。该模型总参数量达 4800 亿，每次前向传播激活 350 亿参数（从 160 个专家中激活 8 个）。\n\n阿里云端点的定价根据上下文长度而异。当请求输入 token 超过 128k 时，将适用更高费率。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  262144,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000022", Completion: "0.00000095"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-coder"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1753230546,
+			CanonicalVal:  "qwen/qwen3-coder-480b-a35b-07-25",
+			HFIDVal:       "Qwen/Qwen3-Coder-480B-A35B-Instruct",
+			VariantList:   []string{"qwen/qwen3-coder:exacto", "qwen/qwen3-coder:free"},
+			FamilyVal:     "qwen-coder",
+			VersionVal:    "3",
 		},
 		"qwen/qwen3-coder-30b-a3b-instruct": {
 			IDVal:         "qwen/qwen3-coder-30b-a3b-instruct",
@@ -3192,8 +5467,16 @@ func init() {
 			DescCNVal:     "Qwen3-Coder-30B-A3B-Instruct 是一款 305 亿参数的混合专家（MoE）模型，包含 128 个专家（每次前向传播激活 8 个），专为高级代码生成、仓库级理解及智能体工具调用而设计。基于 Qwen3 架构，原生支持 256K token 上下文长度（通过 Yarn 可扩展至 1M），在函数调用、浏览器操作和结构化代码补全等任务中表现强劲。\n\n该模型针对无“推理模式”的指令遵循进行优化，并与 OpenAI 兼容的工具调用格式良好集成。",
 			ContextLenVal: 160000,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.00000007", Completion: "0.00000027"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-coder-30b-a3b-instruct"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1753972379,
+			HFIDVal:       "Qwen/Qwen3-Coder-30B-A3B-Instruct",
+			FamilyVal:     "qwen-coder",
+			VersionVal:    "3",
+			SizeVal:       "30B",
 		},
 		"qwen/qwen3-coder-flash": {
 			IDVal:         "qwen/qwen3-coder-flash",
@@ -3203,8 +5486,14 @@ func init() {
 			DescCNVal:     "Qwen3 Coder Flash 是阿里巴巴推出的 Qwen3 Coder Plus 的快速且高性价比版本。该模型是一款强大的编程智能体，专注于通过工具调用与环境交互实现自主编程，兼具卓越的编码能力与通用任务处理能力。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  65536,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000015", InputCacheRead: "0.00000008", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-coder-flash"},
+			ParamList:     []string{"max_tokens", "presence_penalty", "response_format", "seed", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1758115536,
+			FamilyVal:     "qwen-coder-flash",
+			VersionVal:    "3",
 		},
 		"qwen/qwen3-coder-plus": {
 			IDVal:         "qwen/qwen3-coder-plus",
@@ -3214,8 +5503,14 @@ func init() {
 			DescCNVal:     "Qwen3 Coder Plus 是阿里巴巴基于开源 Qwen3 Coder 480B A35B 打造的专有版本，是一款强大的编码智能体模型，专注于通过工具调用和环境交互实现自主编程，兼具卓越的编码能力与通用任务处理能力。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  65536,
+			PricingVal:    Pricing{Prompt: "0.000001", Completion: "0.000005", InputCacheRead: "0.0000001", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-coder-plus"},
+			ParamList:     []string{"max_tokens", "presence_penalty", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1758662707,
+			FamilyVal:     "qwen-coder-plus",
+			VersionVal:    "3",
 		},
 		"qwen/qwen3-coder:exacto": {
 			IDVal:         "qwen/qwen3-coder:exacto",
@@ -3225,8 +5520,17 @@ func init() {
 			DescCNVal:     "Qwen3-Coder-480B-A35B-Instruct 是千问团队开发的混合专家（MoE）代码生成模型，专为智能体编程任务优化，如函数调用、工具使用及基于仓库的长上下文推理。该模型总参数量达 4800 亿，每次前向传播激活 350 亿参数（从 160 个专家中激活 8 个）。\n\n阿里云端点的定价根据上下文长度而异。当请求输入 token 超过 128k 时，将适用更高费率。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000022", Completion: "0.0000018", InputCacheRead: "0.000000022"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-coder:exacto"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1753230546,
+			CanonicalVal:  "qwen/qwen3-coder-480b-a35b-07-25",
+			HFIDVal:       "Qwen/Qwen3-Coder-480B-A35B-Instruct",
+			BaseVal:       "qwen/qwen3-coder",
+			FamilyVal:     "qwen-coder",
+			VersionVal:    "3",
 		},
 		"qwen/qwen3-coder:free": {
 			IDVal:         "qwen/qwen3-coder:free",
@@ -3236,8 +5540,17 @@ func init() {
 			DescCNVal:     "Qwen3-Coder-480B-A35B-Instruct 是千问团队开发的混合专家（MoE）代码生成模型，专为智能体编程任务优化，如函数调用、工具使用及基于仓库的长上下文推理。该模型总参数量达 4800 亿，每次前向传播激活 350 亿参数（从 160 个专家中激活 8 个）。\n\n阿里云端点的定价根据上下文长度而异。当请求输入 token 超过 128k 时，将适用更高费率。",
 			ContextLenVal: 262000,
 			MaxOutputVal:  262000,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-coder:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1753230546,
+			CanonicalVal:  "qwen/qwen3-coder-480b-a35b-07-25",
+			HFIDVal:       "Qwen/Qwen3-Coder-480B-A35B-Instruct",
+			BaseVal:       "qwen/qwen3-coder",
+			FamilyVal:     "qwen-coder",
+			VersionVal:    "3",
 		},
 		"qwen/qwen3-embedding-0.6b": {
 			IDVal:         "qwen/qwen3-embedding-0.6b",
@@ -3247,8 +5560,14 @@ func init() {
 			DescCNVal:     "Qwen3 0.6B 的嵌入模型。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{},
 			FeaturesVal:   CapEmbedding | ModalityTextIn,
 			AliasList:     []string{"qwen3-embedding-0.6b"},
+			ParamList:     []string{},
+			TokenizerVal:  "Qwen3",
+			FamilyVal:     "qwen-embedding",
+			VersionVal:    "3",
+			SizeVal:       "0.6B",
 		},
 		"qwen/qwen3-max": {
 			IDVal:         "qwen/qwen3-max",
@@ -3258,8 +5577,15 @@ func init() {
 			DescCNVal:     "Qwen3-Max 是 Qwen3 系列的最新版本，相较 2025 年 1 月版，在推理能力、指令遵循、多语言支持及长尾知识覆盖方面均有显著提升。它在数学、编程、逻辑和科学任务中精度更高，能更可靠地理解中英文复杂指令，减少幻觉现象，并在开放式问答、写作和对话中生成更高质量的回答。该模型支持 100 多种语言，具备更强的翻译能力和常识推理能力，并针对检索增强生成（RAG）和工具调用进行了优化，但未包含专用的“思考”模式。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.0000012", Completion: "0.000006", InputCacheRead: "0.00000024", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-max"},
+			ParamList:     []string{"max_tokens", "presence_penalty", "response_format", "seed", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Qwen3",
+			DefaultParams: map[string]float64{"temperature": 1, "top_p": 1},
+			ReleasedVal:   1758662808,
+			FamilyVal:     "qwen-max",
+			VersionVal:    "3",
 		},
 		"qwen/qwen3-next-80b-a3b-instruct": {
 			IDVal:         "qwen/qwen3-next-80b-a3b-instruct",
@@ -3269,8 +5595,18 @@ func init() {
 			DescCNVal:     "Qwen3-Next-80B-A3B-Instruct 是 Qwen3-Next 系列中的指令微调对话模型，专为快速、稳定响应而优化，不输出“思考”痕迹。该模型面向复杂任务场景，涵盖推理、代码生成、知识问答及多语言应用，同时在对齐性与格式规范方面保持稳健。相较早期 Qwen3 指令模型，本版本聚焦于超长输入与多轮对话下的更高吞吐量与稳定性，特别适用于需一致最终答案而非显式思维链的 RAG、工具调用及智能体工作流。\n\n模型采用高效扩展的训练与解码策略，提升参数效率与推理速度，并在广泛的公开基准测试中验证：在多个类别上达到或接近更大规模 Qwen3 系统的水平，同时显著优于早期中等规模基线。该模型最适合用于生产环境中需要确定性、严格遵循指令输出的通用助手、代码辅助及长上下文任务求解场景。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000009", Completion: "0.0000011"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-next-80b-a3b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1757612213,
+			CanonicalVal:  "qwen/qwen3-next-80b-a3b-instruct-2509",
+			HFIDVal:       "Qwen/Qwen3-Next-80B-A3B-Instruct",
+			VariantList:   []string{"qwen/qwen3-next-80b-a3b-instruct:free"},
+			FamilyVal:     "qwen-next",
+			VersionVal:    "3",
+			SizeVal:       "80B",
 		},
 		"qwen/qwen3-next-80b-a3b-instruct:free": {
 			IDVal:         "qwen/qwen3-next-80b-a3b-instruct:free",
@@ -3280,8 +5616,18 @@ func init() {
 			DescCNVal:     "Qwen3-Next-80B-A3B-Instruct 是 Qwen3-Next 系列中的指令微调对话模型，专为快速、稳定响应而优化，不输出“思考”痕迹。该模型面向复杂任务场景，涵盖推理、代码生成、知识问答及多语言应用，同时在对齐性与格式规范方面保持稳健。相较早期 Qwen3 指令模型，本版本聚焦于超长输入与多轮对话下的更高吞吐量与稳定性，特别适用于需一致最终答案而非显式思维链的 RAG、工具调用及智能体工作流。\n\n模型采用高效扩展的训练与解码策略，提升参数效率与推理速度，并在广泛的公开基准测试中验证：在多个类别上达到或接近更大规模 Qwen3 系统的水平，同时显著优于早期中等规模基线。该模型最适合用于生产环境中需要确定性、严格遵循指令输出的通用助手、代码辅助及长上下文任务求解场景。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-next-80b-a3b-instruct:free"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1757612213,
+			CanonicalVal:  "qwen/qwen3-next-80b-a3b-instruct-2509",
+			HFIDVal:       "Qwen/Qwen3-Next-80B-A3B-Instruct",
+			BaseVal:       "qwen/qwen3-next-80b-a3b-instruct",
+			FamilyVal:     "qwen-next",
+			VersionVal:    "3",
+			SizeVal:       "80B",
 		},
 		"qwen/qwen3-next-80b-a3b-thinking": {
 			IDVal:         "qwen/qwen3-next-80b-a3b-thinking",
@@ -3291,8 +5637,17 @@ func init() {
 			DescCNVal:     "Qwen3-Next-80B-A3B-Thinking 是 Qwen3-Next 系列中以推理优先的对话模型，默认输出结构化的“思考”轨迹。该模型专为高难度多步问题设计，包括数学证明、代码生成/调试、逻辑推理及智能体规划，在知识、推理、编程、对齐性与多语言评估中均表现强劲。相较早期 Qwen3 变体，本模型强调长思维链下的稳定性与推理阶段的高效扩展能力，并经过调优以更好遵循复杂指令，减少重复或偏离任务的行为。\n\n该模型适用于智能体框架与工具调用（函数调用）、重度检索工作流及需逐步解答的标准基准测试场景。支持生成长篇、详尽的回答，并采用面向吞吐量的技术（如多token预测）加速生成。请注意，该模型仅运行于纯思考模式。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000012"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwen3-next-80b-a3b-thinking"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			ReleasedVal:   1757612284,
+			CanonicalVal:  "qwen/qwen3-next-80b-a3b-thinking-2509",
+			HFIDVal:       "Qwen/Qwen3-Next-80B-A3B-Thinking",
+			FamilyVal:     "qwen-next-thinking",
+			VersionVal:    "3",
+			SizeVal:       "80B",
 		},
 		"qwen/qwen3-reranker-0.6b": {
 			IDVal:         "qwen/qwen3-reranker-0.6b",
@@ -3302,8 +5657,14 @@ func init() {
 			DescCNVal:     "Qwen3 0.6B 的 reranker 模型。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{},
 			FeaturesVal:   CapRerank | ModalityTextIn,
 			AliasList:     []string{"qwen3-reranker-0.6b"},
+			ParamList:     []string{},
+			TokenizerVal:  "Qwen3",
+			FamilyVal:     "qwen-reranker",
+			VersionVal:    "3",
+			SizeVal:       "0.6B",
 		},
 		"qwen/qwen3-vl-235b-a22b-instruct": {
 			IDVal:         "qwen/qwen3-vl-235b-a22b-instruct",
@@ -3313,8 +5674,17 @@ func init() {
 			DescCNVal:     "Qwen3-VL-235B-A22B Instruct 是一款开源多模态模型，融合了强大的文本生成能力与对图像和视频的视觉理解能力。Instruct 版本面向通用视觉-语言应用场景（如视觉问答、文档解析、图表/表格提取、多语言 OCR）。该系列强调鲁棒感知能力（识别多样化的现实与合成类别）、空间理解能力（2D/3D 定位）以及长篇幅视觉理解能力，在公开多模态基准测试中，其感知与推理性能均具竞争力。\n\n除分析能力外，Qwen3-VL 还支持智能体交互与工具调用：可在多图像、多轮对话中执行复杂指令；将文本对齐至视频时间轴以实现精确时序查询；并可操作 GUI 元素完成自动化任务。该模型还支持可视化编码工作流——将草图或原型转化为代码，并辅助 UI 调试，同时保持与旗舰版 Qwen3 语言模型相当的纯文本性能。因此，Qwen3-VL 适用于涵盖文档 AI、多语言 OCR、软件/UI 辅助、空间/具身任务及视觉-语言智能体研究等生产场景。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000012"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen3-vl-235b-a22b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Qwen3",
+			DefaultParams: map[string]float64{"temperature": 0.7, "top_p": 0.8},
+			ReleasedVal:   1758668687,
+			HFIDVal:       "Qwen/Qwen3-VL-235B-A22B-Instruct",
+			FamilyVal:     "qwen-vl",
+			VersionVal:    "3",
+			SizeVal:       "235B",
 		},
 		"qwen/qwen3-vl-235b-a22b-thinking": {
 			IDVal:         "qwen/qwen3-vl-235b-a22b-thinking",
@@ -3324,8 +5694,17 @@ func init() {
 			DescCNVal:     "Qwen3-VL-235B-A22B Thinking 是一款多模态模型，融合了强大的文本生成能力与对图像和视频的视觉理解能力。Thinking 版本专为 STEM 和数学领域的多模态推理优化。该系列强调鲁棒感知能力（识别多样化的现实与合成类别）、空间理解能力（2D/3D 定位）以及长篇幅视觉理解能力，在公开多模态基准测试中，其感知与推理性能均具竞争力。\n\n除分析能力外，Qwen3-VL 还支持智能体交互与工具调用：可在多图像、多轮对话中执行复杂指令；将文本对齐至视频时间轴以实现精确时序查询；并可操作 GUI 元素完成自动化任务。该模型还支持可视化编码工作流——将草图或原型转化为代码，并辅助 UI 调试，同时保持与旗舰版 Qwen3 语言模型相当的纯文本性能。因此，Qwen3-VL 适用于涵盖文档 AI、多语言 OCR、软件/UI 辅助、空间/具身任务及视觉-语言智能体研究等生产场景。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  262144,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000045", Completion: "0.0000035"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen3-vl-235b-a22b-thinking"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			DefaultParams: map[string]float64{"temperature": 0.8, "top_p": 0.95},
+			ReleasedVal:   1758668690,
+			HFIDVal:       "Qwen/Qwen3-VL-235B-A22B-Thinking",
+			FamilyVal:     "qwen-vl-thinking",
+			VersionVal:    "3",
+			SizeVal:       "235B",
 		},
 		"qwen/qwen3-vl-30b-a3b-instruct": {
 			IDVal:         "qwen/qwen3-vl-30b-a3b-instruct",
@@ -3335,8 +5714,17 @@ func init() {
 			DescCNVal:     "Qwen3-VL-30B-A3B-Instruct 是一款多模态模型，融合强大的文本生成能力与对图像和视频的视觉理解能力。其 Instruct 变体针对通用多模态任务的指令遵循能力进行了优化。该模型在真实/合成类别感知、2D/3D 空间定位及长篇视觉理解方面表现出色，在多模态基准测试中成绩优异。在智能体应用中，可处理多图像多轮指令、视频时间轴对齐、GUI 自动化，以及从草图到调试完成 UI 的可视化编程。其文本性能与旗舰 Qwen3 系列相当，适用于文档 AI、OCR、UI 辅助、空间任务及智能体研究。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000006", InputCacheRead: "0.000000075"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen3-vl-30b-a3b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Qwen3",
+			DefaultParams: map[string]float64{"temperature": 0.7, "top_p": 0.8},
+			ReleasedVal:   1759794476,
+			HFIDVal:       "Qwen/Qwen3-VL-30B-A3B-Instruct",
+			FamilyVal:     "qwen-vl",
+			VersionVal:    "3",
+			SizeVal:       "30B",
 		},
 		"qwen/qwen3-vl-30b-a3b-thinking": {
 			IDVal:         "qwen/qwen3-vl-30b-a3b-thinking",
@@ -3346,8 +5734,17 @@ func init() {
 			DescCNVal:     "Qwen3-VL-30B-A3B-Thinking 是一款多模态模型，融合强大的文本生成能力与对图像和视频的视觉理解能力。其 Thinking 变体强化了在 STEM、数学及复杂任务中的推理能力。该模型在真实/合成类别感知、2D/3D 空间定位及长篇视觉理解方面表现出色，在多模态基准测试中成绩优异。在智能体应用中，可处理多图像多轮指令、视频时间轴对齐、GUI 自动化，以及从草图到调试完成 UI 的可视化编程。其文本性能与旗舰 Qwen3 系列相当，适用于文档 AI、OCR、UI 辅助、空间任务及智能体研究。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  32768,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.000001"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen3-vl-30b-a3b-thinking"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			DefaultParams: map[string]float64{"temperature": 0.8, "top_p": 0.95},
+			ReleasedVal:   1759794479,
+			HFIDVal:       "Qwen/Qwen3-VL-30B-A3B-Thinking",
+			FamilyVal:     "qwen-vl-thinking",
+			VersionVal:    "3",
+			SizeVal:       "30B",
 		},
 		"qwen/qwen3-vl-32b-instruct": {
 			IDVal:         "qwen/qwen3-vl-32b-instruct",
@@ -3357,8 +5754,16 @@ func init() {
 			DescCNVal:     "Qwen3-VL-32B-Instruct 是一款大规模多模态视觉语言模型，专为文本、图像和视频的高精度理解与推理而设计。该模型拥有 320 亿参数，融合深度视觉感知与先进文本理解能力，支持细粒度空间推理、文档与场景分析以及长周期视频理解。支持 32 种语言的鲁棒 OCR，并通过 Interleaved-MRoPE 与 DeepStack 架构实现增强的多模态融合。该模型针对智能体交互和视觉工具调用进行了优化，在复杂现实世界的多模态任务中达到业界领先水平。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000005", Completion: "0.0000015"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen3-vl-32b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Qwen",
+			ReleasedVal:   1761231332,
+			HFIDVal:       "Qwen/Qwen3-VL-32B-Instruct",
+			FamilyVal:     "qwen-vl",
+			VersionVal:    "3",
+			SizeVal:       "32B",
 		},
 		"qwen/qwen3-vl-8b-instruct": {
 			IDVal:         "qwen/qwen3-vl-8b-instruct",
@@ -3368,8 +5773,17 @@ func init() {
 			DescCNVal:     "Qwen3-VL-8B-Instruct 是 Qwen3-VL 系列中的多模态视觉语言模型，专为跨文本、图像和视频的高保真理解与推理而构建。该模型采用改进的多模态融合技术，包括用于长时序推理的 Interleaved-MRoPE、用于细粒度视觉-文本对齐的 DeepStack，以及用于精确事件定位的文本-时间戳对齐机制。\n\n该模型原生支持 256K token 上下文窗口，可扩展至 1M tokens，能够处理静态与动态媒体输入，适用于文档解析、视觉问答、空间推理和 GUI 控制等任务。其文本理解能力媲美主流大语言模型，OCR 支持语言扩展至 32 种，并在多样化视觉条件下展现出更强鲁棒性。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.00000008", Completion: "0.0000005"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen3-vl-8b-instruct"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen3",
+			DefaultParams: map[string]float64{"temperature": 0.7, "top_p": 0.8},
+			ReleasedVal:   1760463308,
+			HFIDVal:       "Qwen/Qwen3-VL-8B-Instruct",
+			FamilyVal:     "qwen-vl",
+			VersionVal:    "3",
+			SizeVal:       "8B",
 		},
 		"qwen/qwen3-vl-8b-thinking": {
 			IDVal:         "qwen/qwen3-vl-8b-thinking",
@@ -3379,8 +5793,17 @@ func init() {
 			DescCNVal:     "Qwen3-VL-8B-Thinking 是 Qwen3-VL-8B 多模态模型的推理优化版本，专为复杂场景、文档和时序序列中的高级视觉与文本推理而设计。该模型集成增强的多模态对齐机制与长上下文处理能力（原生 256K，可扩展至 1M tokens），适用于科学视觉分析、因果推断以及基于图像或视频输入的数学推理等任务。\n\n相比 Instruct 版本，Thinking 版本引入了更深层次的视觉-语言融合与深思熟虑的推理路径，在长链逻辑任务、STEM 问题求解和多步视频理解方面表现更优。通过 Interleaved-MRoPE 和时间戳感知嵌入，该模型实现了更强的时间定位能力，同时保持与大型纯文本 LLM 相当的 OCR、多语言理解及文本生成能力。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  32768,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000018", Completion: "0.0000021", InternalReasoning: "0", Image: "0", Request: "0", WebSearch: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"qwen3-vl-8b-thinking"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "presence_penalty", "reasoning", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Qwen3",
+			DefaultParams: map[string]float64{"temperature": 1, "top_p": 0.95},
+			ReleasedVal:   1760463746,
+			HFIDVal:       "Qwen/Qwen3-VL-8B-Thinking",
+			FamilyVal:     "qwen-vl-thinking",
+			VersionVal:    "3",
+			SizeVal:       "8B",
 		},
 		"qwen/qwq-32b": {
 			IDVal:         "qwen/qwq-32b",
@@ -3390,8 +5813,16 @@ func init() {
 			DescCNVal:     "QwQ 是通义千问（Qwen）系列中的推理专用模型。相较于传统的指令微调模型，具备思考与推理能力的 QwQ 在下游任务（尤其是难题）上性能显著提升。QwQ-32B 是其中的中等规模推理模型，在性能上可与当前领先的推理模型（如 DeepSeek-R1、o1-mini）相媲美。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000015", Completion: "0.0000004"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"qwq-32b"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen",
+			InstructVal:   "qwq",
+			ReleasedVal:   1741208814,
+			HFIDVal:       "Qwen/QwQ-32B",
+			FamilyVal:     "qwq",
+			SizeVal:       "32B",
 		},
 		"raifle/sorcererlm-8x22b": {
 			IDVal:         "raifle/sorcererlm-8x22b",
@@ -3401,8 +5832,16 @@ func init() {
 			DescCNVal:     "SorcererLM 是一款先进的角色扮演与叙事模型，基于 [WizardLM-2 8x22B](/microsoft/wizardlm-2-8x22b) 采用低秩 16 位 LoRA 微调而成。\n\n- 具备高级推理与情感智能，可实现引人入胜的沉浸式交互；\n- 写作风格生动，融合空间感知与上下文意识；\n- 叙事深度增强，支持富有创意且动态变化的故事创作。",
 			ContextLenVal: 16000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000045", Completion: "0.0000045"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"sorcererlm-8x22b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Mistral",
+			InstructVal:   "vicuna",
+			ReleasedVal:   1731105083,
+			HFIDVal:       "rAIfle/SorcererLM-8x22b-bf16",
+			FamilyVal:     "sorcererlm",
+			SizeVal:       "8x22B",
 		},
 		"relace/relace-apply-3": {
 			IDVal:         "relace/relace-apply-3",
@@ -3412,8 +5851,14 @@ func init() {
 			DescCNVal:     "Relace Apply 3 是一款专用的代码修补大语言模型，可直接将 AI 建议的编辑内容合并到源文件中。它平均以每秒 10,000 个 token 的速度将 GPT-4o、Claude 等模型的更新应用到您的文件中。\n\n该模型要求提示格式如下：\n<instruction>{instruction}</instruction>\n<code>{initial_code}</code>\n<update>{edit_snippet}</update>\n\nRelace 已启用零数据留存策略。更多详情请参阅其[文档](https://docs.relace.ai/api-reference/instant-apply/apply)。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  128000,
+			PricingVal:    Pricing{Prompt: "0.00000085", Completion: "0.00000125"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"relace-apply-3"},
+			ParamList:     []string{"max_tokens", "seed", "stop"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1758891572,
+			FamilyVal:     "relace-apply",
+			VersionVal:    "3",
 		},
 		"relace/relace-search": {
 			IDVal:         "relace/relace-search",
@@ -3423,8 +5868,14 @@ func init() {
 			DescCNVal:     "relace-search 模型通过并行调用 4–12 个 `view_file` 和 `grep` 工具来探索代码库，并返回与用户请求相关的文件。\n\n与 RAG 不同，relace-search 采用智能体多步推理机制，生成高度精准的结果，速度比任何前沿模型快 4 倍。该模型设计为子智能体，将其发现传递给“预言机”编程智能体，由后者协调并执行后续编码任务。\n\n使用 relace-search 需构建合适的智能体框架，并解析其响应以提取相关信息交予预言机。更多详情请参阅 [Relace 文档](https://docs.relace.ai/docs/fast-agentic-search/agent)。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  128000,
+			PricingVal:    Pricing{Prompt: "0.000001", Completion: "0.000003"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"relace-search"},
+			ParamList:     []string{"max_tokens", "seed", "stop", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1765213560,
+			CanonicalVal:  "relace/relace-search-20251208",
+			FamilyVal:     "relace-search",
 		},
 		"sao10k/l3-euryale-70b": {
 			IDVal:         "sao10k/l3-euryale-70b",
@@ -3434,8 +5885,16 @@ func init() {
 			DescCNVal:     "Euryale 70B v2.1 是由 [Sao10k](https://ko-fi.com/sao10k) 开发的专注于创意角色扮演的模型。\n\n- 更强的提示遵循能力\n- 更佳的人体结构与空间感知能力\n- 对独特及自定义格式/回复样式的适应性显著提升\n- 极具创造力，提供大量新颖表达\n- 在角色扮演过程中无过多限制",
 			ContextLenVal: 8192,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.00000148", Completion: "0.00000148"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"l3-euryale-70b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1718668800,
+			HFIDVal:       "Sao10K/L3-70B-Euryale-v2.1",
+			FamilyVal:     "l3-euryale",
+			SizeVal:       "70B",
 		},
 		"sao10k/l3-lunaris-8b": {
 			IDVal:         "sao10k/l3-lunaris-8b",
@@ -3445,8 +5904,16 @@ func init() {
 			DescCNVal:     "Lunaris 8B 是一款基于 Llama 3 的多功能通用及角色扮演模型，通过战略性融合多个模型，在创造力、逻辑推理与通用知识之间取得良好平衡。\n\n由 [Sao10k](https://huggingface.co/Sao10k) 开发，旨在提供优于 Stheno v3.2 的体验，显著增强创造力与逻辑推理能力。\n\n为获得最佳效果，建议配合 Llama 3 Instruct 上下文模板使用，温度（temperature）设为 1.4，min_p 设为 0.1。",
 			ContextLenVal: 8192,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000004", Completion: "0.00000005"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"l3-lunaris-8b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1723507200,
+			HFIDVal:       "Sao10K/L3-8B-Lunaris-v1",
+			FamilyVal:     "l3-lunaris",
+			SizeVal:       "8B",
 		},
 		"sao10k/l3.1-70b-hanami-x1": {
 			IDVal:         "sao10k/l3.1-70b-hanami-x1",
@@ -3456,8 +5923,15 @@ func init() {
 			DescCNVal:     "这是 [Sao10K](/sao10k) 在 [Euryale v2.2](/sao10k/l3.1-euryale-70b) 基础上开展的实验。",
 			ContextLenVal: 16000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000003"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"l3.1-70b-hanami-x1"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			ReleasedVal:   1736302854,
+			HFIDVal:       "Sao10K/L3.1-70B-Hanami-x1",
+			FamilyVal:     "l3.1-hanami-x1",
+			SizeVal:       "70B",
 		},
 		"sao10k/l3.1-euryale-70b": {
 			IDVal:         "sao10k/l3.1-euryale-70b",
@@ -3467,8 +5941,16 @@ func init() {
 			DescCNVal:     "Euryale L3.1 70B v2.2 是由 [Sao10k](https://ko-fi.com/sao10k) 开发的专注于创意角色扮演的模型，是 [Euryale L3 70B v2.1](/models/sao10k/l3-euryale-70b) 的继任版本。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000065", Completion: "0.00000075"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"l3.1-euryale-70b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1724803200,
+			HFIDVal:       "Sao10K/L3.1-70B-Euryale-v2.2",
+			FamilyVal:     "l3.1-euryale",
+			SizeVal:       "70B",
 		},
 		"sao10k/l3.3-euryale-70b": {
 			IDVal:         "sao10k/l3.3-euryale-70b",
@@ -3478,8 +5960,17 @@ func init() {
 			DescCNVal:     "Euryale L3.3 70B 是由 [Sao10k](https://ko-fi.com/sao10k) 开发的专注于创意角色扮演的模型，是 [Euryale L3 70B v2.2](/models/sao10k/l3-euryale-70b) 的继任版本。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  16384,
+			PricingVal:    Pricing{Prompt: "0.00000065", Completion: "0.00000075"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"l3.3-euryale-70b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Llama3",
+			InstructVal:   "llama3",
+			ReleasedVal:   1734535928,
+			CanonicalVal:  "sao10k/l3.3-euryale-70b-v2.3",
+			HFIDVal:       "Sao10K/L3.3-70B-Euryale-v2.3",
+			FamilyVal:     "l3.3-euryale",
+			SizeVal:       "70B",
 		},
 		"stepfun-ai/step3": {
 			IDVal:         "stepfun-ai/step3",
@@ -3489,8 +5980,15 @@ func init() {
 			DescCNVal:     "Step3 是一款前沿的多模态推理模型，采用专家混合架构，总参数量达 3210 亿，每次前向传递激活 380 亿参数。该模型端到端设计，旨在最小化解码成本的同时，在视觉–语言推理任务中实现顶尖性能。通过多矩阵分解注意力（MFA）与注意力–前馈网络解耦（AFD）的协同设计，Step3 在旗舰级及低端加速器上均保持卓越效率。",
 			ContextLenVal: 65536,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.00000057", Completion: "0.00000142"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"step3"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "reasoning", "response_format", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1756415375,
+			HFIDVal:       "stepfun-ai/step3",
+			FamilyVal:     "step",
+			VersionVal:    "3",
 		},
 		"switchpoint/router": {
 			IDVal:         "switchpoint/router",
@@ -3500,8 +5998,13 @@ func init() {
 			DescCNVal:     "Switchpoint AI 的路由模型可即时分析您的请求，并将其动态分发至不断演进的 AI 模型库中最优选项。\n\n随着大语言模型领域的持续进步，我们的路由系统亦同步进化，确保您无需更改现有工作流即可始终受益于业界最新模型。\n\n该模型在 OpenRouter 平台上按每条响应收取统一费率，由 [Switchpoint AI](https://www.switchpoint.dev) 完整路由引擎驱动。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000085", Completion: "0.0000034"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"router"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1752272899,
+			FamilyVal:     "router",
 		},
 		"tencent/hunyuan-a13b-instruct": {
 			IDVal:         "tencent/hunyuan-a13b-instruct",
@@ -3511,8 +6014,14 @@ func init() {
 			DescCNVal:     "Hunyuan-A13B 是腾讯开发的 130 亿激活参数混合专家（MoE）语言模型，总参数量达 800 亿，支持思维链（Chain-of-Thought）推理。该模型在数学、科学、编程及多轮推理等任务的基准测试中表现优异，同时通过分组查询注意力（GQA）机制和量化支持（FP8、GPTQ 等）实现高效推理。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000014", Completion: "0.00000057"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"hunyuan-a13b-instruct"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "reasoning", "response_format", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1751987664,
+			HFIDVal:       "tencent/Hunyuan-A13B-Instruct",
+			FamilyVal:     "hunyuan",
 		},
 		"thedrummer/cydonia-24b-v4.1": {
 			IDVal:         "thedrummer/cydonia-24b-v4.1",
@@ -3522,8 +6031,16 @@ func init() {
 			DescCNVal:     "基于 Mistral Small 3.2 24B 构建的无审查创意写作模型，具备良好的记忆能力、提示遵循性与智能水平。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000005"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"cydonia-24b-v4.1"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1758931878,
+			HFIDVal:       "thedrummer/cydonia-24b-v4.1",
+			FamilyVal:     "cydonia",
+			VersionVal:    "4.1",
+			SizeVal:       "24B",
 		},
 		"thedrummer/rocinante-12b": {
 			IDVal:         "thedrummer/rocinante-12b",
@@ -3533,8 +6050,16 @@ func init() {
 			DescCNVal:     "Rocinante 12B 专为引人入胜的故事叙述和丰富文采而设计。\n\n早期测试者反馈：\n- 词汇量更广，用词独特且富有表现力\n- 创造力显著增强，可生成生动叙事\n- 故事情节充满冒险且引人入胜",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000017", Completion: "0.00000043"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"rocinante-12b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Qwen",
+			InstructVal:   "chatml",
+			ReleasedVal:   1727654400,
+			HFIDVal:       "TheDrummer/Rocinante-12B-v1.1",
+			FamilyVal:     "rocinante",
+			SizeVal:       "12B",
 		},
 		"thedrummer/skyfall-36b-v2": {
 			IDVal:         "thedrummer/skyfall-36b-v2",
@@ -3544,8 +6069,16 @@ func init() {
 			DescCNVal:     "Skyfall 36B v2 是 Mistral Small 2501 的增强版本，经过专门微调，显著提升了创造力、细腻文风、角色扮演能力及连贯叙事表现。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  32768,
+			PricingVal:    Pricing{Prompt: "0.00000055", Completion: "0.0000008"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"skyfall-36b-v2"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "max_tokens", "presence_penalty", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1741636566,
+			HFIDVal:       "TheDrummer/Skyfall-36B-v2",
+			FamilyVal:     "skyfall",
+			VersionVal:    "2",
+			SizeVal:       "36B",
 		},
 		"thedrummer/unslopnemo-12b": {
 			IDVal:         "thedrummer/unslopnemo-12b",
@@ -3555,8 +6088,16 @@ func init() {
 			DescCNVal:     "UnslopNemo v4.1 是 Rocinante 创作者推出的最新模型，专为冒险题材写作和角色扮演场景设计。",
 			ContextLenVal: 32768,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.0000004"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"unslopnemo-12b"},
+			ParamList:     []string{"frequency_penalty", "max_tokens", "presence_penalty", "response_format", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Mistral",
+			InstructVal:   "mistral",
+			ReleasedVal:   1731103448,
+			HFIDVal:       "TheDrummer/UnslopNemo-12B-v4.1",
+			FamilyVal:     "unslopnemo",
+			SizeVal:       "12B",
 		},
 		"tngtech/deepseek-r1t-chimera": {
 			IDVal:         "tngtech/deepseek-r1t-chimera",
@@ -3566,8 +6107,15 @@ func init() {
 			DescCNVal:     "DeepSeek-R1T-Chimera 通过融合 DeepSeek-R1 与 DeepSeek-V3（0324）构建而成，兼具 R1 的推理能力和 V3 的 token 效率优势。该模型基于 DeepSeek-MoE Transformer 架构，针对通用文本生成任务进行了优化。\n\n模型通过合并两个源模型的预训练权重，在推理能力、效率和指令遵循任务之间实现性能平衡。采用 MIT 许可证发布，适用于研究及商业用途。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  163840,
-			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000012"},
+			FeaturesVal:   CapChat | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-r1t-chimera"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			ReleasedVal:   1745760875,
+			HFIDVal:       "tngtech/DeepSeek-R1T-Chimera",
+			VariantList:   []string{"tngtech/deepseek-r1t-chimera:free"},
+			FamilyVal:     "deepseek-r1t-chimera",
 		},
 		"tngtech/deepseek-r1t-chimera:free": {
 			IDVal:         "tngtech/deepseek-r1t-chimera:free",
@@ -3577,8 +6125,16 @@ func init() {
 			DescCNVal:     "DeepSeek-R1T-Chimera 通过融合 DeepSeek-R1 与 DeepSeek-V3（0324）构建而成，结合了 R1 的推理能力与 V3 的 Token 效率优化优势。该模型基于 DeepSeek-MoE Transformer 架构，专为通用文本生成任务优化。\n\n模型通过合并两个源模型的预训练权重，在推理能力、效率和指令遵循任务之间实现性能平衡。本模型采用 MIT 许可证发布，适用于研究及商业用途。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-r1t-chimera:free"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			ReleasedVal:   1745760875,
+			CanonicalVal:  "tngtech/deepseek-r1t-chimera",
+			HFIDVal:       "tngtech/DeepSeek-R1T-Chimera",
+			BaseVal:       "tngtech/deepseek-r1t-chimera",
+			FamilyVal:     "deepseek-r1t-chimera",
 		},
 		"tngtech/deepseek-r1t2-chimera": {
 			IDVal:         "tngtech/deepseek-r1t2-chimera",
@@ -3588,8 +6144,15 @@ func init() {
 			DescCNVal:     "DeepSeek-TNG-R1T2-Chimera 是 TNG Tech 推出的第二代 Chimera 模型，是一款 6710 亿参数的混合专家文本生成模型，由 DeepSeek-AI 的 R1-0528、R1 和 V3-0324 三个检查点通过专家集成（Assembly-of-Experts）融合而成。三亲本设计在 vLLM 下推理速度较原始 R1 提升约 20%，较 R1-0528 提升超 2 倍，在成本与智能之间取得良好平衡。该检查点标准使用支持最长 60k tokens 上下文（实测可达约 130k），并保持一致的 <think> token 行为，适用于长上下文分析、对话及其他开放式生成任务。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  163840,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000025", Completion: "0.00000085"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-r1t2-chimera"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			ReleasedVal:   1751986985,
+			HFIDVal:       "tngtech/DeepSeek-TNG-R1T2-Chimera",
+			VariantList:   []string{"tngtech/deepseek-r1t2-chimera:free"},
+			FamilyVal:     "deepseek-r1t2-chimera",
 		},
 		"tngtech/deepseek-r1t2-chimera:free": {
 			IDVal:         "tngtech/deepseek-r1t2-chimera:free",
@@ -3599,8 +6162,16 @@ func init() {
 			DescCNVal:     "DeepSeek-TNG-R1T2-Chimera 是 TNG Tech 推出的第二代 Chimera 模型，是一款 6710 亿参数的混合专家文本生成模型，由 DeepSeek-AI 的 R1-0528、R1 和 V3-0324 三个检查点通过专家集成（Assembly-of-Experts）融合而成。三亲本设计在 vLLM 下推理速度较原始 R1 提升约 20%，较 R1-0528 提升超 2 倍，在成本与智能之间取得良好平衡。该检查点标准使用支持最长 60k tokens 上下文（实测可达约 130k），并保持一致的 <think> token 行为，适用于长上下文分析、对话及其他开放式生成任务。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"deepseek-r1t2-chimera:free"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "seed", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "DeepSeek",
+			ReleasedVal:   1751986985,
+			CanonicalVal:  "tngtech/deepseek-r1t2-chimera",
+			HFIDVal:       "tngtech/DeepSeek-TNG-R1T2-Chimera",
+			BaseVal:       "tngtech/deepseek-r1t2-chimera",
+			FamilyVal:     "deepseek-r1t2-chimera",
 		},
 		"tngtech/tng-r1t-chimera": {
 			IDVal:         "tngtech/tng-r1t-chimera",
@@ -3610,8 +6181,14 @@ func init() {
 			DescCNVal:     "TNG-R1T-Chimera 是一款实验性大语言模型，擅长创意叙事与角色互动。它是2025年4月发布的原始 TNG/DeepSeek-R1T-Chimera 模型的衍生版本，仅通过 Chutes 和 OpenRouter 平台提供。\n\n主要特性与改进包括：\n\n- 具备富有创意且令人愉悦的个性；\n- 初步 EQ-Bench3 评分为约1305；\n- 智能水平显著高于原始版本，尽管推理速度略有下降；\n- “思考令牌”一致性大幅提升，即推理块与答案块边界清晰明确；\n- 工具调用能力显著增强。\n\n模型开发者 TNG Tech 要求用户遵循微软为其“MAI-DS-R1” DeepSeek 基础模型制定的审慎使用指南。相关指南可在 Hugging Face 获取（https://huggingface.co/microsoft/MAI-DS-R1）。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000025", Completion: "0.00000085"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"tng-r1t-chimera"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1764184161,
+			VariantList:   []string{"tngtech/tng-r1t-chimera:free"},
+			FamilyVal:     "tng-r1t-chimera",
 		},
 		"tngtech/tng-r1t-chimera:free": {
 			IDVal:         "tngtech/tng-r1t-chimera:free",
@@ -3621,8 +6198,15 @@ func init() {
 			DescCNVal:     "TNG-R1T-Chimera 是一款实验性大语言模型，擅长创意叙事与角色互动。它是2025年4月发布的原始 TNG/DeepSeek-R1T-Chimera 模型的衍生版本，仅通过 Chutes 和 OpenRouter 平台提供。\n\n主要特性与改进包括：\n\n- 具备富有创意且令人愉悦的个性；\n- 初步 EQ-Bench3 评分为约1305；\n- 智能水平显著高于原始版本，尽管推理速度略有下降；\n- “思考令牌”一致性大幅提升，即推理块与答案块边界清晰明确；\n- 工具调用能力显著增强。\n\n模型开发者 TNG Tech 要求用户遵循微软为其“MAI-DS-R1” DeepSeek 基础模型制定的审慎使用指南。相关指南可在 Hugging Face 获取（https://huggingface.co/microsoft/MAI-DS-R1）。",
 			ContextLenVal: 163840,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"tng-r1t-chimera:free"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1764184161,
+			CanonicalVal:  "tngtech/tng-r1t-chimera",
+			BaseVal:       "tngtech/tng-r1t-chimera",
+			FamilyVal:     "tng-r1t-chimera",
 		},
 		"undi95/remm-slerp-l2-13b": {
 			IDVal:         "undi95/remm-slerp-l2-13b",
@@ -3632,8 +6216,16 @@ func init() {
 			DescCNVal:     "基于更新模型对原始 MythoMax-L2-B13 的复现尝试。#merge",
 			ContextLenVal: 6144,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.00000045", Completion: "0.00000065"},
 			FeaturesVal:   CapChat | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"remm-slerp-l2-13b"},
+			ParamList:     []string{"frequency_penalty", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "top_a", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Llama2",
+			InstructVal:   "alpaca",
+			ReleasedVal:   1689984000,
+			HFIDVal:       "Undi95/ReMM-SLERP-L2-13B",
+			FamilyVal:     "remm-slerp-l2",
+			SizeVal:       "13B",
 		},
 		"upstage/solar-pro-3:free": {
 			IDVal:         "upstage/solar-pro-3:free",
@@ -3643,8 +6235,17 @@ func init() {
 			DescCNVal:     "Solar Pro 3 是 Upstage 推出的高性能混合专家（MoE）语言模型，总参数量达 1020 亿，每次前向传播激活 120 亿参数，在保持计算效率的同时实现卓越性能。该模型针对韩语进行了优化，并支持英语和日语。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"solar-pro-3:free"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "response_format", "structured_outputs", "temperature", "tool_choice", "tools"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1769481200,
+			ExpiresVal:    1772409600,
+			StatusVal:     StatusDeprecated,
+			CanonicalVal:  "upstage/solar-pro-3",
+			FamilyVal:     "solar-pro",
+			VersionVal:    "3",
 		},
 		"writer/palmyra-x5": {
 			IDVal:         "writer/palmyra-x5",
@@ -3654,8 +6255,14 @@ func init() {
 			DescCNVal:     "Palmyra X5 是 Writer 公司最先进的模型，专为企业级 AI 智能体的构建与规模化部署而设计。依托创新的 Transformer 架构与混合注意力机制，该模型在高达 100 万 token 的上下文窗口内实现业界领先的推理速度与效率，从而加速推理过程并扩展内存容量，高效处理海量企业数据，为 AI 智能体的大规模应用提供关键支撑。",
 			ContextLenVal: 1040000,
 			MaxOutputVal:  8192,
+			PricingVal:    Pricing{Prompt: "0.0000006", Completion: "0.000006"},
 			FeaturesVal:   CapChat | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"palmyra-x5"},
+			ParamList:     []string{"max_tokens", "stop", "temperature", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			ReleasedVal:   1769003823,
+			CanonicalVal:  "writer/palmyra-x5-20250428",
+			FamilyVal:     "palmyra-x5",
 		},
 		"x-ai/grok-3": {
 			IDVal:         "x-ai/grok-3",
@@ -3665,8 +6272,15 @@ func init() {
 			DescCNVal:     "Grok 3 是 xAI 推出的最新模型，作为其旗舰产品，在数据提取、编程和文本摘要等企业级应用场景中表现出色。在金融、医疗、法律和科学领域具备深厚的专业知识。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000015", InputCacheRead: "0.00000075", WebSearch: "0.005"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"grok-3"},
+			ParamList:     []string{"frequency_penalty", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "Grok",
+			ReleasedVal:   1749582908,
+			FamilyVal:     "grok",
+			VersionVal:    "3",
+			EquivList:     []string{"x-ai/grok-3-beta"},
 		},
 		"x-ai/grok-3-beta": {
 			IDVal:         "x-ai/grok-3-beta",
@@ -3676,8 +6290,16 @@ func init() {
 			DescCNVal:     "Grok 3 是 xAI 最新推出的旗舰模型，在企业级应用场景（如数据提取、编程和文本摘要）中表现卓越，并在金融、医疗、法律和科学等领域具备深厚的领域知识。\n\n该模型在 GPQA、LCB 和 MMLU-Pro 等结构化任务与基准测试中表现优异，即使在高推理模式下也显著优于 Grok 3 Mini。\n\n注意：此模型提供两个 xAI 接入端点。默认情况下，使用该模型将始终路由至基础端点。若需使用高速端点，可添加 `provider: { sort: throughput }` 以按吞吐量优先排序。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000015", InputCacheRead: "0.00000075", WebSearch: "0.005"},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"grok-3-beta"},
+			ParamList:     []string{"frequency_penalty", "logprobs", "max_tokens", "presence_penalty", "response_format", "seed", "stop", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "Grok",
+			ReleasedVal:   1744240068,
+			StatusVal:     StatusPreview,
+			FamilyVal:     "grok",
+			VersionVal:    "3",
+			EquivList:     []string{"x-ai/grok-3"},
 		},
 		"x-ai/grok-3-mini": {
 			IDVal:         "x-ai/grok-3-mini",
@@ -3687,8 +6309,15 @@ func init() {
 			DescCNVal:     "一款轻量级模型，在响应前会进行思考。速度快、智能高效，适用于无需深厚领域知识的逻辑类任务。原始思考轨迹可供访问。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000005", InputCacheRead: "0.000000075", WebSearch: "0.005"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"grok-3-mini"},
+			ParamList:     []string{"include_reasoning", "logprobs", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "Grok",
+			ReleasedVal:   1749583245,
+			FamilyVal:     "grok-mini",
+			VersionVal:    "3",
+			EquivList:     []string{"x-ai/grok-3-mini-beta"},
 		},
 		"x-ai/grok-3-mini-beta": {
 			IDVal:         "x-ai/grok-3-mini-beta",
@@ -3698,8 +6327,16 @@ func init() {
 			DescCNVal:     "Grok 3 Mini 是一款轻量级、低开销的推理模型。与传统模型即时生成答案不同，Grok 3 Mini 会在响应前进行思考，特别适合无需深厚领域知识但需强推理能力的任务，在数学及量化场景（如解决高难度谜题或数学问题）中表现突出。\n\n支持透明的“思考”过程追踪。默认采用低推理强度，可通过设置 `reasoning: { effort: \"high\" }` 提升推理强度。\n\n注意：此模型提供两个 xAI 接入端点。默认情况下，使用该模型将始终路由至基础端点。若需使用高速端点，可添加 `provider: { sort: throughput }` 以按吞吐量优先排序。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000005", InputCacheRead: "0.000000075", WebSearch: "0.005"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"grok-3-mini-beta"},
+			ParamList:     []string{"include_reasoning", "logprobs", "max_tokens", "reasoning", "response_format", "seed", "stop", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "Grok",
+			ReleasedVal:   1744240195,
+			StatusVal:     StatusPreview,
+			FamilyVal:     "grok-mini",
+			VersionVal:    "3",
+			EquivList:     []string{"x-ai/grok-3-mini"},
 		},
 		"x-ai/grok-4": {
 			IDVal:         "x-ai/grok-4",
@@ -3709,8 +6346,15 @@ func init() {
 			DescCNVal:     "Grok 4 是 xAI 最新推出的推理模型，上下文窗口达 256K 令牌。支持并行工具调用、结构化输出，以及图像与文本双模态输入。请注意：该模型的推理能力不可关闭、不可调节，也无法指定推理强度。当单次请求总令牌数超过 128K 时，计费标准将上调。更多详情请参阅 [xAI 官方文档](https://docs.x.ai/docs/models/grok-4-0709)。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.000003", Completion: "0.000015", InputCacheRead: "0.00000075", WebSearch: "0.005"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"grok-4"},
+			ParamList:     []string{"include_reasoning", "logprobs", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "Grok",
+			ReleasedVal:   1752087689,
+			CanonicalVal:  "x-ai/grok-4-07-09",
+			FamilyVal:     "grok",
+			VersionVal:    "4",
 		},
 		"x-ai/grok-4-fast": {
 			IDVal:         "x-ai/grok-4-fast",
@@ -3720,8 +6364,14 @@ func init() {
 			DescCNVal:     "Grok 4 Fast 是 xAI 最新推出的多模态模型，具备业界领先的性价比，并支持200万token上下文窗口。该模型提供两种模式：非推理模式与推理模式。更多详情请参阅 xAI 官方[新闻公告](http://x.ai/news/grok-4-fast)。\n\n用户可通过 API 中的 `reasoning` 参数的 `enabled` 字段启用或禁用推理功能。[详见文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#controlling-reasoning-tokens)",
 			ContextLenVal: 2000000,
 			MaxOutputVal:  30000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000005", InputCacheRead: "0.00000005", WebSearch: "0.005"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"grok-4-fast"},
+			ParamList:     []string{"include_reasoning", "logprobs", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "Grok",
+			ReleasedVal:   1758240090,
+			FamilyVal:     "grok-fast",
+			VersionVal:    "4",
 		},
 		"x-ai/grok-4.1-fast": {
 			IDVal:         "x-ai/grok-4.1-fast",
@@ -3731,8 +6381,15 @@ func init() {
 			DescCNVal:     "Grok 4.1 Fast 是 xAI 最佳的智能体工具调用模型，在客服支持与深度研究等真实场景中表现卓越，支持 200 万 token 上下文窗口。\n\n可通过 API 中的 `reasoning` `enabled` 参数启用或禁用推理功能。[了解更多请参阅文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#controlling-reasoning-tokens)",
 			ContextLenVal: 2000000,
 			MaxOutputVal:  30000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000005", InputCacheRead: "0.00000005", WebSearch: "0.005"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"grok-4.1-fast"},
+			ParamList:     []string{"include_reasoning", "logprobs", "max_tokens", "reasoning", "response_format", "seed", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "Grok",
+			DefaultParams: map[string]float64{"temperature": 0.7, "top_p": 0.95},
+			ReleasedVal:   1763587502,
+			FamilyVal:     "grok-fast",
+			VersionVal:    "4.1",
 		},
 		"x-ai/grok-code-fast-1": {
 			IDVal:         "x-ai/grok-code-fast-1",
@@ -3742,8 +6399,14 @@ func init() {
 			DescCNVal:     "Grok Code Fast 1 是一款高效经济的推理模型，在智能体编程任务中表现卓越。其响应中包含可见的推理轨迹，便于开发者引导 Grok Code 实现高质量工作流。",
 			ContextLenVal: 256000,
 			MaxOutputVal:  10000,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000002", Completion: "0.0000015", InputCacheRead: "0.00000002", WebSearch: "0.005"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"grok-code-fast-1"},
+			ParamList:     []string{"include_reasoning", "logprobs", "max_tokens", "reasoning", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_logprobs", "top_p"},
+			TokenizerVal:  "Grok",
+			ReleasedVal:   1756238927,
+			FamilyVal:     "grok-code-fast",
+			VersionVal:    "1",
 		},
 		"xiaomi/mimo-v2-flash": {
 			IDVal:         "xiaomi/mimo-v2-flash",
@@ -3753,8 +6416,18 @@ func init() {
 			DescCNVal:     "MiMo-V2-Flash 是小米开发的开源基础语言模型，采用混合专家（Mixture-of-Experts）架构，总参数量达 3090 亿，激活参数量为 150 亿，并采用混合注意力机制。MiMo-V2-Flash 支持混合思维开关与 256K 上下文窗口，在推理、编程及智能体场景中表现卓越。在 SWE-bench Verified 与 SWE-bench Multilingual 基准测试中，MiMo-V2-Flash 均位列全球开源模型榜首，性能媲美 Claude Sonnet 4.5，成本却仅为后者的约 3.5%。\n\n用户可通过 `reasoning` 的 `enabled` 布尔值控制推理行为。[了解更多](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  0,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000009", Completion: "0.00000029"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mimo-v2-flash"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"top_p": 0.95},
+			ReleasedVal:   1765731308,
+			CanonicalVal:  "xiaomi/mimo-v2-flash-20251210",
+			HFIDVal:       "XiaomiMiMo/MiMo-V2-Flash",
+			VariantList:   []string{"xiaomi/mimo-v2-flash:free"},
+			FamilyVal:     "mimo-flash",
+			VersionVal:    "2",
 		},
 		"xiaomi/mimo-v2-flash:free": {
 			IDVal:         "xiaomi/mimo-v2-flash:free",
@@ -3764,8 +6437,15 @@ func init() {
 			DescCNVal:     "MiMo-V2-Flash 是小米开发的开源基础语言模型，采用混合专家（Mixture-of-Experts）架构，总参数量达 3090 亿，激活参数量为 150 亿，并采用混合注意力机制。MiMo-V2-Flash 支持混合思维开关与 256K 上下文窗口，在推理、编程及智能体场景中表现卓越。在 SWE-bench Verified 与 SWE-bench Multilingual 基准测试中，MiMo-V2-Flash 均位列全球开源模型榜首，性能媲美 Claude Sonnet 4.5，成本却仅为后者的约 3.5%。\n\n用户可通过 `reasoning` 的 `enabled` 布尔值控制推理行为。[了解更多](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)。",
 			ContextLenVal: 262144,
 			MaxOutputVal:  65536,
+			PricingVal:    Pricing{},
 			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"mimo-v2-flash:free"},
+			ParamList:     []string{},
+			StatusVal:     StatusRetired,
+			ReplacedByVal: "xiaomi/mimo-v2-flash",
+			BaseVal:       "xiaomi/mimo-v2-flash",
+			FamilyVal:     "mimo-flash",
+			VersionVal:    "2",
 		},
 		"z-ai/glm-4-32b": {
 			IDVal:         "z-ai/glm-4-32b",
@@ -3775,8 +6455,17 @@ func init() {
 			DescCNVal:     "GLM-4-32B 是一款高性价比的基础语言模型。\n\n可高效执行复杂任务，在工具调用、在线搜索及代码相关智能任务方面能力显著增强。\n\n由 thudm 模型背后的同一实验室研发。",
 			ContextLenVal: 128000,
 			MaxOutputVal:  0,
+			PricingVal:    Pricing{Prompt: "0.0000001", Completion: "0.0000001"},
 			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"glm-4-32b"},
+			ParamList:     []string{"max_tokens", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.75},
+			ReleasedVal:   1753376617,
+			CanonicalVal:  "z-ai/glm-4-32b-0414",
+			FamilyVal:     "glm",
+			VersionVal:    "4",
+			SizeVal:       "32B",
 		},
 		"z-ai/glm-4.5": {
 			IDVal:         "z-ai/glm-4.5",
@@ -3786,8 +6475,16 @@ func init() {
 			DescCNVal:     "GLM-4.5 是我们最新推出的旗舰基础模型，专为智能体应用打造。采用混合专家（MoE）架构，支持最高 128k token 的上下文长度。GLM-4.5 在推理、代码生成和智能体对齐方面能力显著提升。支持混合推理模式：一种为复杂推理和工具调用设计的“推理模式”，另一种为即时响应优化的“非推理模式”。用户可通过 `reasoning` `enabled` 布尔值控制推理行为。[了解更多请参阅文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)",
 			ContextLenVal: 131072,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000035", Completion: "0.00000155"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"glm-4.5"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.75},
+			ReleasedVal:   1753471347,
+			HFIDVal:       "zai-org/GLM-4.5",
+			FamilyVal:     "glm",
+			VersionVal:    "4.5",
 		},
 		"z-ai/glm-4.5-air": {
 			IDVal:         "z-ai/glm-4.5-air",
@@ -3797,8 +6494,17 @@ func init() {
 			DescCNVal:     "GLM-4.5-Air 是我们最新旗舰模型系列的轻量级变体，同样专为以智能体为中心的应用场景设计。与 GLM-4.5 类似，它也采用混合专家（MoE）架构，但参数规模更为紧凑。GLM-4.5-Air 同样支持混合推理模式：提供用于高级推理和工具调用的“推理模式”，以及用于实时交互的“非推理模式”。用户可通过 `reasoning` `enabled` 布尔值控制推理行为。[了解更多请参阅文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000005", Completion: "0.00000022"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"glm-4.5-air"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.75},
+			ReleasedVal:   1753471258,
+			HFIDVal:       "zai-org/GLM-4.5-Air",
+			VariantList:   []string{"z-ai/glm-4.5-air:free"},
+			FamilyVal:     "glm-air",
+			VersionVal:    "4.5",
 		},
 		"z-ai/glm-4.5-air:free": {
 			IDVal:         "z-ai/glm-4.5-air:free",
@@ -3808,8 +6514,18 @@ func init() {
 			DescCNVal:     "GLM-4.5-Air 是我们最新旗舰模型系列的轻量级变体，同样专为以智能体为中心的应用场景设计。与 GLM-4.5 类似，它也采用混合专家（MoE）架构，但参数规模更为紧凑。GLM-4.5-Air 同样支持混合推理模式：提供用于高级推理和工具调用的“推理模式”，以及用于实时交互的“非推理模式”。用户可通过 `reasoning` `enabled` 布尔值控制推理行为。[了解更多请参阅文档](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)",
 			ContextLenVal: 131072,
 			MaxOutputVal:  96000,
-			FeaturesVal:   CapChat | CapFunctionCall | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0", Completion: "0"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"glm-4.5-air:free"},
+			ParamList:     []string{"include_reasoning", "max_tokens", "reasoning", "temperature", "tool_choice", "tools", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.75},
+			ReleasedVal:   1753471258,
+			CanonicalVal:  "z-ai/glm-4.5-air",
+			HFIDVal:       "zai-org/GLM-4.5-Air",
+			BaseVal:       "z-ai/glm-4.5-air",
+			FamilyVal:     "glm-air",
+			VersionVal:    "4.5",
 		},
 		"z-ai/glm-4.5v": {
 			IDVal:         "z-ai/glm-4.5v",
@@ -3819,8 +6535,15 @@ func init() {
 			DescCNVal:     "GLM-4.5V 是一款面向多模态智能体应用的视觉语言基础模型。基于混合专家（MoE）架构，总参数量达 1060 亿，每 token 激活 120 亿参数，在视频理解、图像问答、OCR 和文档解析等任务上达到业界领先水平，并在前端网页编码、事实依据和空间推理方面取得显著提升。模型提供混合推理模式：“思考模式”用于深度推理，“非思考模式”用于快速响应。推理行为可通过 `reasoning` `enabled` 布尔值切换。[了解更多](https://openrouter.ai/docs/use-cases/reasoning-tokens#enable-reasoning-with-default-config)",
 			ContextLenVal: 65536,
 			MaxOutputVal:  16384,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000006", Completion: "0.0000018", InputCacheRead: "0.00000011"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | CapMultimodal,
 			AliasList:     []string{"glm-4.5v"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.75},
+			ReleasedVal:   1754922288,
+			HFIDVal:       "zai-org/GLM-4.5V",
+			FamilyVal:     "glm-4.5v",
 		},
 		"z-ai/glm-4.6": {
 			IDVal:         "z-ai/glm-4.6",
@@ -3830,8 +6553,16 @@ func init() {
 			DescCNVal:     "相比 GLM-4.5，本代模型带来多项关键改进：\n\n更长上下文窗口：上下文窗口从 128K 扩展至 200K tokens，使模型能够处理更复杂的智能体任务。\n卓越编码性能：在代码基准测试中得分更高，并在 Claude Code、Cline、Roo Code 和 Kilo Code 等实际应用中表现更佳，包括生成视觉效果更精美的前端页面。\n高级推理能力：GLM-4.6 的推理性能显著提升，支持推理过程中的工具调用，整体能力更强。\n更强大的智能体：在工具调用型和基于搜索的智能体任务中表现更优，并能更有效地集成到智能体框架中。\n精炼的写作能力：在风格与可读性方面更符合人类偏好，在角色扮演场景中表现更自然。",
 			ContextLenVal: 202752,
 			MaxOutputVal:  65536,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000035", Completion: "0.0000015"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"glm-4.6"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_a", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.6},
+			ReleasedVal:   1759235576,
+			VariantList:   []string{"z-ai/glm-4.6:exacto"},
+			FamilyVal:     "glm",
+			VersionVal:    "4.6",
 		},
 		"z-ai/glm-4.6:exacto": {
 			IDVal:         "z-ai/glm-4.6:exacto",
@@ -3841,8 +6572,17 @@ func init() {
 			DescCNVal:     "相比 GLM-4.5，本代模型带来多项关键改进：\n\n更长上下文窗口：上下文窗口从 128K 扩展至 200K tokens，使模型能够处理更复杂的智能体任务。\n卓越编码性能：在代码基准测试中得分更高，并在 Claude Code、Cline、Roo Code 和 Kilo Code 等实际应用中表现更佳，包括生成视觉效果更精美的前端页面。\n高级推理能力：GLM-4.6 的推理性能显著提升，支持推理过程中的工具调用，整体能力更强。\n更强大的智能体：在工具调用型和基于搜索的智能体任务中表现更优，并能更有效地集成到智能体框架中。\n精炼的写作能力：在风格与可读性方面更符合人类偏好，在角色扮演场景中表现更自然。",
 			ContextLenVal: 204800,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000044", Completion: "0.00000176", InputCacheRead: "0.00000011"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"glm-4.6:exacto"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.6},
+			ReleasedVal:   1759235576,
+			CanonicalVal:  "z-ai/glm-4.6",
+			BaseVal:       "z-ai/glm-4.6",
+			FamilyVal:     "glm",
+			VersionVal:    "4.6",
 		},
 		"z-ai/glm-4.6v": {
 			IDVal:         "z-ai/glm-4.6v",
@@ -3852,8 +6592,16 @@ func init() {
 			DescCNVal:     "GLM-4.6V 是一款大型多模态模型，专为高保真视觉理解及跨图像、文档和混合媒体的长上下文推理而设计。该模型支持高达 128K tokens，可直接将复杂页面布局和图表作为视觉输入进行处理，并集成原生多模态函数调用，实现感知与下游工具执行的无缝衔接。此外，该模型还支持交错式图文生成与 UI 重建工作流，包括截图转 HTML 合成及迭代式视觉编辑。",
 			ContextLenVal: 131072,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
+			PricingVal:    Pricing{Prompt: "0.0000003", Completion: "0.0000009"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityImageIn | ModalityTextIn | ModalityTextOut | ModalityVideoIn | CapMultimodal,
 			AliasList:     []string{"glm-4.6v"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 0.8, "top_p": 0.6},
+			ReleasedVal:   1765207462,
+			CanonicalVal:  "z-ai/glm-4.6-20251208",
+			HFIDVal:       "zai-org/GLM-4.6V",
+			FamilyVal:     "glm-4.6v",
 		},
 		"z-ai/glm-4.7": {
 			IDVal:         "z-ai/glm-4.7",
@@ -3863,8 +6611,17 @@ func init() {
 			DescCNVal:     "GLM-4.7 是 Z.AI 最新旗舰模型，在两大核心领域实现升级：增强的编程能力与更稳定的多步推理/执行能力。该模型在执行复杂智能体任务方面表现显著提升，同时提供更自然的对话体验与更出色的前端交互效果。",
 			ContextLenVal: 202752,
 			MaxOutputVal:  65535,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.0000004", Completion: "0.0000015"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"glm-4.7"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "logit_bias", "logprobs", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_a", "top_k", "top_logprobs", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 1, "top_p": 0.95},
+			ReleasedVal:   1766378014,
+			CanonicalVal:  "z-ai/glm-4.7-20251222",
+			HFIDVal:       "zai-org/GLM-4.7",
+			FamilyVal:     "glm",
+			VersionVal:    "4.7",
 		},
 		"z-ai/glm-4.7-flash": {
 			IDVal:         "z-ai/glm-4.7-flash",
@@ -3874,8 +6631,17 @@ func init() {
 			DescCNVal:     "作为一款 300 亿参数级别的 SOTA 模型，GLM-4.7-Flash 在性能与效率之间实现了新的平衡。该模型进一步针对智能体编程场景进行了优化，强化了代码生成能力、长周期任务规划及工具协同能力，并在多个主流公开基准排行榜上，在同规模开源模型中取得了领先性能。",
 			ContextLenVal: 200000,
 			MaxOutputVal:  131072,
-			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | ModalityTextIn | ModalityTextOut,
+			PricingVal:    Pricing{Prompt: "0.00000007", Completion: "0.0000004", InputCacheRead: "0.00000001"},
+			FeaturesVal:   CapChat | CapFunctionCall | CapJsonMode | CapReasoning | ModalityTextIn | ModalityTextOut,
 			AliasList:     []string{"glm-4.7-flash"},
+			ParamList:     []string{"frequency_penalty", "include_reasoning", "max_tokens", "min_p", "presence_penalty", "reasoning", "repetition_penalty", "response_format", "seed", "stop", "structured_outputs", "temperature", "tool_choice", "tools", "top_k", "top_p"},
+			TokenizerVal:  "Other",
+			DefaultParams: map[string]float64{"temperature": 1, "top_p": 0.95},
+			ReleasedVal:   1768833913,
+			CanonicalVal:  "z-ai/glm-4.7-flash-20260119",
+			HFIDVal:       "zai-org/GLM-4.7-Flash",
+			FamilyVal:     "glm-flash",
+			VersionVal:    "4.7",
 		},
 	}
 
@@ -4237,4 +7003,1353 @@ func init() {
 		"weaver":                                  "mancer/weaver",
 		"wizardlm-2-8x22b":                        "microsoft/wizardlm-2-8x22b",
 	}
+
+	huggingFaceIndex = map[string]string{
+		"ai21labs/ai21-jamba-large-1.7":                            "ai21/jamba-large-1.7",
+		"ai21labs/ai21-jamba-mini-1.7":                             "ai21/jamba-mini-1.7",
+		"alfredpros/codellama-7b-instruct-solidity":                "alfredpros/codellama-7b-instruct-solidity",
+		"alibaba-nlp/tongyi-deepresearch-30b-a3b":                  "alibaba/tongyi-deepresearch-30b-a3b",
+		"allenai/molmo2-8b":                                        "allenai/molmo-2-8b:free",
+		"allenai/olmo-2-0325-32b-instruct":                         "allenai/olmo-2-0325-32b-instruct",
+		"allenai/olmo-3-32b-think":                                 "allenai/olmo-3-32b-think",
+		"allenai/olmo-3-7b-instruct":                               "allenai/olmo-3-7b-instruct",
+		"allenai/olmo-3-7b-think":                                  "allenai/olmo-3-7b-think",
+		"allenai/olmo-3.1-32b-instruct":                            "allenai/olmo-3.1-32b-instruct",
+		"allenai/olmo-3.1-32b-think":                               "allenai/olmo-3.1-32b-think",
+		"alpindale/goliath-120b":                                   "alpindale/goliath-120b",
+		"anthracite-org/magnum-v4-72b":                             "anthracite-org/magnum-v4-72b",
+		"arcee-ai/trinity-large-preview":                           "arcee-ai/trinity-large-preview:free",
+		"arcee-ai/trinity-mini":                                    "arcee-ai/trinity-mini",
+		"baidu/ernie-4.5-21b-a3b-pt":                               "baidu/ernie-4.5-21b-a3b",
+		"baidu/ernie-4.5-21b-a3b-thinking":                         "baidu/ernie-4.5-21b-a3b-thinking",
+		"baidu/ernie-4.5-300b-a47b-pt":                             "baidu/ernie-4.5-300b-a47b",
+		"baidu/ernie-4.5-vl-28b-a3b-pt":                            "baidu/ernie-4.5-vl-28b-a3b",
+		"baidu/ernie-4.5-vl-424b-a47b-pt":                          "baidu/ernie-4.5-vl-424b-a47b",
+		"bytedance-seed/ui-tars-1.5-7b":                            "bytedance/ui-tars-1.5-7b",
+		"cognitivecomputations/dolphin-mistral-24b-venice-edition": "cognitivecomputations/dolphin-mistral-24b-venice-edition:free",
+		"cohereforai/c4ai-command-a-03-2025":                       "cohere/command-a",
+		"deepcogito/cogito-v2-preview-llama-109b-moe":              "deepcogito/cogito-v2-preview-llama-109b-moe",
+		"deepcogito/cogito-v2-preview-llama-405b":                  "deepcogito/cogito-v2-preview-llama-405b",
+		"deepcogito/cogito-v2-preview-llama-70b":                   "deepcogito/cogito-v2-preview-llama-70b",
+		"deepseek-ai/deepseek-r1":                                  "deepseek/deepseek-r1",
+		"deepseek-ai/deepseek-r1-0528":                             "deepseek/deepseek-r1-0528",
+		"deepseek-ai/deepseek-r1-distill-llama-70b":                "deepseek/deepseek-r1-distill-llama-70b",
+		"deepseek-ai/deepseek-r1-distill-qwen-32b":                 "deepseek/deepseek-r1-distill-qwen-32b",
+		"deepseek-ai/deepseek-v3":                                  "deepseek/deepseek-chat",
+		"deepseek-ai/deepseek-v3-0324":                             "deepseek/deepseek-chat-v3-0324",
+		"deepseek-ai/deepseek-v3.1":                                "deepseek/deepseek-chat-v3.1",
+		"deepseek-ai/deepseek-v3.1-terminus":                       "deepseek/deepseek-v3.1-terminus",
+		"deepseek-ai/deepseek-v3.2":                                "deepseek/deepseek-v3.2",
+		"deepseek-ai/deepseek-v3.2-exp":                            "deepseek/deepseek-v3.2-exp",
+		"deepseek-ai/deepseek-v3.2-speciale":                       "deepseek/deepseek-v3.2-speciale",
+		"eleutherai/llemma_7b":                                     "eleutherai/llemma_7b",
+		"essentialai/rnj-1-instruct":                               "essentialai/rnj-1-instruct",
+		"fuseai/fuseo1-deepseekr1-qwq-skyt1-32b-preview":           "aion-labs/aion-1.0-mini",
+		"google/gemma-2-27b-it":                                    "google/gemma-2-27b-it",
+		"google/gemma-2-9b-it":                                     "google/gemma-2-9b-it",
+		"google/gemma-3-12b-it":                                    "google/gemma-3-12b-it",
+		"google/gemma-3-27b-it":                                    "google/gemma-3-27b-it",
+		"google/gemma-3-4b-it":                                     "google/gemma-3-4b-it",
+		"google/gemma-3n-e2b-it":                                   "google/gemma-3n-e2b-it:free",
+		"google/gemma-3n-e4b-it":                                   "google/gemma-3n-e4b-it",
+		"gryphe/mythomax-l2-13b":                                   "gryphe/mythomax-l2-13b",
+		"ibm-granite/granite-4.0-h-micro":                          "ibm-granite/granite-4.0-h-micro",
+		"liquidai/lfm2-2.6b":                                       "liquid/lfm-2.2-6b",
+		"liquidai/lfm2-8b-a1b":                                     "liquid/lfm2-8b-a1b",
+		"liquidai/lfm2.5-1.2b-instruct":                            "liquid/lfm-2.5-1.2b-instruct:free",
+		"liquidai/lfm2.5-1.2b-thinking":                            "liquid/lfm-2.5-1.2b-thinking:free",
+		"meituan-longcat/longcat-flash-chat":                       "meituan/longcat-flash-chat",
+		"meta-llama/llama-3.1-405b":                                "meta-llama/llama-3.1-405b",
+		"meta-llama/llama-3.2-11b-vision-instruct":                 "meta-llama/llama-3.2-11b-vision-instruct",
+		"meta-llama/llama-3.2-1b-instruct":                         "meta-llama/llama-3.2-1b-instruct",
+		"meta-llama/llama-3.2-3b-instruct":                         "meta-llama/llama-3.2-3b-instruct",
+		"meta-llama/llama-3.3-70b-instruct":                        "meta-llama/llama-3.3-70b-instruct",
+		"meta-llama/llama-4-maverick-17b-128e-instruct":            "meta-llama/llama-4-maverick",
+		"meta-llama/llama-4-scout-17b-16e-instruct":                "meta-llama/llama-4-scout",
+		"meta-llama/llama-guard-3-8b":                              "meta-llama/llama-guard-3-8b",
+		"meta-llama/llama-guard-4-12b":                             "meta-llama/llama-guard-4-12b",
+		"meta-llama/meta-llama-3-70b-instruct":                     "meta-llama/llama-3-70b-instruct",
+		"meta-llama/meta-llama-3-8b-instruct":                      "meta-llama/llama-3-8b-instruct",
+		"meta-llama/meta-llama-3.1-405b-instruct":                  "meta-llama/llama-3.1-405b-instruct",
+		"meta-llama/meta-llama-3.1-70b-instruct":                   "meta-llama/llama-3.1-70b-instruct",
+		"meta-llama/meta-llama-3.1-8b-instruct":                    "meta-llama/llama-3.1-8b-instruct",
+		"meta-llama/meta-llama-guard-2-8b":                         "meta-llama/llama-guard-2-8b",
+		"microsoft/phi-4":                                          "microsoft/phi-4",
+		"microsoft/wizardlm-2-8x22b":                               "microsoft/wizardlm-2-8x22b",
+		"minimaxai/minimax-m2":                                     "minimax/minimax-m2",
+		"minimaxai/minimax-m2.1":                                   "minimax/minimax-m2.1",
+		"minimaxai/minimax-text-01":                                "minimax/minimax-01",
+		"mistralai/devstral-2-123b-instruct-2512":                  "mistralai/devstral-2512",
+		"mistralai/devstral-small-2507":                            "mistralai/devstral-small",
+		"mistralai/ministral-3-14b-instruct-2512":                  "mistralai/ministral-14b-2512",
+		"mistralai/ministral-3-3b-instruct-2512":                   "mistralai/ministral-3b-2512",
+		"mistralai/ministral-3-8b-instruct-2512":                   "mistralai/ministral-8b-2512",
+		"mistralai/mistral-7b-instruct-v0.1":                       "mistralai/mistral-7b-instruct-v0.1",
+		"mistralai/mistral-7b-instruct-v0.2":                       "mistralai/mistral-7b-instruct-v0.2",
+		"mistralai/mistral-7b-instruct-v0.3":                       "mistralai/mistral-7b-instruct",
+		"mistralai/mistral-nemo-instruct-2407":                     "mistralai/mistral-nemo",
+		"mistralai/mistral-small-24b-instruct-2501":                "mistralai/mistral-small-24b-instruct-2501",
+		"mistralai/mistral-small-3.1-24b-instruct-2503":            "mistralai/mistral-small-3.1-24b-instruct",
+		"mistralai/mistral-small-3.2-24b-instruct-2506":            "mistralai/mistral-small-3.2-24b-instruct",
+		"mistralai/mixtral-8x22b-instruct-v0.1":                    "mistralai/mixtral-8x22b-instruct",
+		"mistralai/mixtral-8x7b-instruct-v0.1":                     "mistralai/mixtral-8x7b-instruct",
+		"mistralai/pixtral-12b-2409":                               "mistralai/pixtral-12b",
+		"mistralai/voxtral-small-24b-2507":                         "mistralai/voxtral-small-24b-2507",
+		"moonshotai/kimi-dev-72b":                                  "moonshotai/kimi-dev-72b",
+		"moonshotai/kimi-k2-instruct":                              "moonshotai/kimi-k2",
+		"moonshotai/kimi-k2-instruct-0905":                         "moonshotai/kimi-k2-0905",
+		"moonshotai/kimi-k2-thinking":                              "moonshotai/kimi-k2-thinking",
+		"moonshotai/kimi-k2.5":                                     "moonshotai/kimi-k2.5",
+		"neversleep/lumimaid-v0.2-8b":                              "neversleep/llama-3.1-lumimaid-8b",
+		"neversleep/noromaid-20b-v0.1.1":                           "neversleep/noromaid-20b",
+		"nex-agi/deepseek-v3.1-nex-n1":                             "nex-agi/deepseek-v3.1-nex-n1",
+		"nousresearch/deephermes-3-mistral-24b-preview":            "nousresearch/deephermes-3-mistral-24b-preview",
+		"nousresearch/hermes-2-pro-llama-3-8b":                     "nousresearch/hermes-2-pro-llama-3-8b",
+		"nousresearch/hermes-3-llama-3.1-405b":                     "nousresearch/hermes-3-llama-3.1-405b",
+		"nousresearch/hermes-3-llama-3.1-70b":                      "nousresearch/hermes-3-llama-3.1-70b",
+		"nousresearch/hermes-4-405b":                               "nousresearch/hermes-4-405b",
+		"nousresearch/hermes-4-70b":                                "nousresearch/hermes-4-70b",
+		"nvidia/llama-3.1-nemotron-70b-instruct-hf":                "nvidia/llama-3.1-nemotron-70b-instruct",
+		"nvidia/llama-3_1-nemotron-ultra-253b-v1":                  "nvidia/llama-3.1-nemotron-ultra-253b-v1",
+		"nvidia/llama-3_3-nemotron-super-49b-v1_5":                 "nvidia/llama-3.3-nemotron-super-49b-v1.5",
+		"nvidia/nvidia-nemotron-3-nano-30b-a3b-bf16":               "nvidia/nemotron-3-nano-30b-a3b",
+		"nvidia/nvidia-nemotron-nano-12b-v2-vl-bf16":               "nvidia/nemotron-nano-12b-v2-vl",
+		"nvidia/nvidia-nemotron-nano-9b-v2":                        "nvidia/nemotron-nano-9b-v2",
+		"openai/gpt-oss-120b":                                      "openai/gpt-oss-120b",
+		"openai/gpt-oss-20b":                                       "openai/gpt-oss-20b",
+		"openai/gpt-oss-safeguard-20b":                             "openai/gpt-oss-safeguard-20b",
+		"opengvlab/internvl3-78b":                                  "opengvlab/internvl3-78b",
+		"primeintellect/intellect-3-fp8":                           "prime-intellect/intellect-3",
+		"qwen/qwen2.5-72b-instruct":                                "qwen/qwen-2.5-72b-instruct",
+		"qwen/qwen2.5-7b-instruct":                                 "qwen/qwen-2.5-7b-instruct",
+		"qwen/qwen2.5-coder-32b-instruct":                          "qwen/qwen-2.5-coder-32b-instruct",
+		"qwen/qwen2.5-coder-7b-instruct":                           "qwen/qwen2.5-coder-7b-instruct",
+		"qwen/qwen2.5-vl-32b-instruct":                             "qwen/qwen2.5-vl-32b-instruct",
+		"qwen/qwen2.5-vl-72b-instruct":                             "qwen/qwen2.5-vl-72b-instruct",
+		"qwen/qwen2.5-vl-7b-instruct":                              "qwen/qwen-2.5-vl-7b-instruct",
+		"qwen/qwen3-14b":                                           "qwen/qwen3-14b",
+		"qwen/qwen3-235b-a22b":                                     "qwen/qwen3-235b-a22b",
+		"qwen/qwen3-235b-a22b-instruct-2507":                       "qwen/qwen3-235b-a22b-2507",
+		"qwen/qwen3-235b-a22b-thinking-2507":                       "qwen/qwen3-235b-a22b-thinking-2507",
+		"qwen/qwen3-30b-a3b":                                       "qwen/qwen3-30b-a3b",
+		"qwen/qwen3-30b-a3b-instruct-2507":                         "qwen/qwen3-30b-a3b-instruct-2507",
+		"qwen/qwen3-30b-a3b-thinking-2507":                         "qwen/qwen3-30b-a3b-thinking-2507",
+		"qwen/qwen3-32b":                                           "qwen/qwen3-32b",
+		"qwen/qwen3-4b":                                            "qwen/qwen3-4b:free",
+		"qwen/qwen3-8b":                                            "qwen/qwen3-8b",
+		"qwen/qwen3-coder-30b-a3b-instruct":                        "qwen/qwen3-coder-30b-a3b-instruct",
+		"qwen/qwen3-coder-480b-a35b-instruct":                      "qwen/qwen3-coder",
+		"qwen/qwen3-next-80b-a3b-instruct":                         "qwen/qwen3-next-80b-a3b-instruct",
+		"qwen/qwen3-next-80b-a3b-thinking":                         "qwen/qwen3-next-80b-a3b-thinking",
+		"qwen/qwen3-vl-235b-a22b-instruct":                         "qwen/qwen3-vl-235b-a22b-instruct",
+		"qwen/qwen3-vl-235b-a22b-thinking":                         "qwen/qwen3-vl-235b-a22b-thinking",
+		"qwen/qwen3-vl-30b-a3b-instruct":                           "qwen/qwen3-vl-30b-a3b-instruct",
+		"qwen/qwen3-vl-30b-a3b-thinking":                           "qwen/qwen3-vl-30b-a3b-thinking",
+		"qwen/qwen3-vl-32b-instruct":                               "qwen/qwen3-vl-32b-instruct",
+		"qwen/qwen3-vl-8b-instruct":                                "qwen/qwen3-vl-8b-instruct",
+		"qwen/qwen3-vl-8b-thinking":                                "qwen/qwen3-vl-8b-thinking",
+		"qwen/qwq-32b":                                             "qwen/qwq-32b",
+		"raifle/sorcererlm-8x22b-bf16":                             "raifle/sorcererlm-8x22b",
+		"sao10k/l3-70b-euryale-v2.1":                               "sao10k/l3-euryale-70b",
+		"sao10k/l3-8b-lunaris-v1":                                  "sao10k/l3-lunaris-8b",
+		"sao10k/l3.1-70b-euryale-v2.2":                             "sao10k/l3.1-euryale-70b",
+		"sao10k/l3.1-70b-hanami-x1":                                "sao10k/l3.1-70b-hanami-x1",
+		"sao10k/l3.3-70b-euryale-v2.3":                             "sao10k/l3.3-euryale-70b",
+		"stepfun-ai/step3":                                         "stepfun-ai/step3",
+		"tencent/hunyuan-a13b-instruct":                            "tencent/hunyuan-a13b-instruct",
+		"thedrummer/cydonia-24b-v4.1":                              "thedrummer/cydonia-24b-v4.1",
+		"thedrummer/rocinante-12b-v1.1":                            "thedrummer/rocinante-12b",
+		"thedrummer/skyfall-36b-v2":                                "thedrummer/skyfall-36b-v2",
+		"thedrummer/unslopnemo-12b-v4.1":                           "thedrummer/unslopnemo-12b",
+		"tngtech/deepseek-r1t-chimera":                             "tngtech/deepseek-r1t-chimera",
+		"tngtech/deepseek-tng-r1t2-chimera":                        "tngtech/deepseek-r1t2-chimera",
+		"undi95/remm-slerp-l2-13b":                                 "undi95/remm-slerp-l2-13b",
+		"xiaomimimo/mimo-v2-flash":                                 "xiaomi/mimo-v2-flash",
+		"zai-org/glm-4.5":                                          "z-ai/glm-4.5",
+		"zai-org/glm-4.5-air":                                      "z-ai/glm-4.5-air",
+		"zai-org/glm-4.5v":                                         "z-ai/glm-4.5v",
+		"zai-org/glm-4.6v":                                         "z-ai/glm-4.6v",
+		"zai-org/glm-4.7":                                          "z-ai/glm-4.7",
+		"zai-org/glm-4.7-flash":                                    "z-ai/glm-4.7-flash",
+	}
+
+	canonicalIndex = map[string]string{
+		"allenai/molmo-2-8b-20260109":                   "allenai/molmo-2-8b:free",
+		"allenai/olmo-3-32b-think-20251121":             "allenai/olmo-3-32b-think",
+		"allenai/olmo-3-7b-instruct-20251121":           "allenai/olmo-3-7b-instruct",
+		"allenai/olmo-3-7b-think-20251121":              "allenai/olmo-3-7b-think",
+		"allenai/olmo-3.1-32b-instruct-20251215":        "allenai/olmo-3.1-32b-instruct",
+		"allenai/olmo-3.1-32b-think-20251215":           "allenai/olmo-3.1-32b-think",
+		"anthropic/claude-3-5-haiku":                    "anthropic/claude-3.5-haiku",
+		"anthropic/claude-3-7-sonnet-20250219":          "anthropic/claude-3.7-sonnet",
+		"anthropic/claude-4-opus-20250522":              "anthropic/claude-opus-4",
+		"anthropic/claude-4-sonnet-20250522":            "anthropic/claude-sonnet-4",
+		"anthropic/claude-4.1-opus-20250805":            "anthropic/claude-opus-4.1",
+		"anthropic/claude-4.5-haiku-20251001":           "anthropic/claude-haiku-4.5",
+		"anthropic/claude-4.5-opus-20251124":            "anthropic/claude-opus-4.5",
+		"anthropic/claude-4.5-sonnet-20250929":          "anthropic/claude-sonnet-4.5",
+		"arcee-ai/trinity-large-preview":                "arcee-ai/trinity-large-preview:free",
+		"arcee-ai/trinity-mini-20251201":                "arcee-ai/trinity-mini",
+		"bytedance-seed/seed-1.6-20250625":              "bytedance-seed/seed-1.6",
+		"bytedance-seed/seed-1.6-flash-20250625":        "bytedance-seed/seed-1.6-flash",
+		"cohere/command-a-03-2025":                      "cohere/command-a",
+		"deepcogito/cogito-v2.1-671b-20251118":          "deepcogito/cogito-v2.1-671b",
+		"deepseek/deepseek-chat-v3":                     "deepseek/deepseek-chat",
+		"deepseek/deepseek-r1-0528":                     "deepseek/deepseek-r1-0528",
+		"deepseek/deepseek-v3.1-terminus":               "deepseek/deepseek-v3.1-terminus",
+		"deepseek/deepseek-v3.2-20251201":               "deepseek/deepseek-v3.2",
+		"deepseek/deepseek-v3.2-speciale-20251201":      "deepseek/deepseek-v3.2-speciale",
+		"google/gemini-2.5-pro-preview-03-25":           "google/gemini-2.5-pro-preview-05-06",
+		"google/gemini-2.5-pro-preview-06-05":           "google/gemini-2.5-pro-preview",
+		"google/gemini-3-flash-preview-20251217":        "google/gemini-3-flash-preview",
+		"google/gemini-3-pro-image-preview-20251120":    "google/gemini-3-pro-image-preview",
+		"google/gemini-3-pro-preview-20251117":          "google/gemini-3-pro-preview",
+		"google/gemma-3-12b-it":                         "google/gemma-3-12b-it",
+		"google/gemma-3-27b-it":                         "google/gemma-3-27b-it",
+		"google/gemma-3-4b-it":                          "google/gemma-3-4b-it",
+		"google/gemma-3n-e2b-it":                        "google/gemma-3n-e2b-it:free",
+		"google/gemma-3n-e4b-it":                        "google/gemma-3n-e4b-it",
+		"inception/mercury-coder-small-beta":            "inception/mercury-coder",
+		"kwaipilot/kat-coder-pro-v1":                    "kwaipilot/kat-coder-pro",
+		"liquid/lfm-2.5-1.2b-instruct-20260120":         "liquid/lfm-2.5-1.2b-instruct:free",
+		"liquid/lfm-2.5-1.2b-thinking-20260120":         "liquid/lfm-2.5-1.2b-thinking:free",
+		"meta-llama/llama-3.1-405b-instruct":            "meta-llama/llama-3.1-405b-instruct",
+		"meta-llama/llama-3.2-3b-instruct":              "meta-llama/llama-3.2-3b-instruct",
+		"meta-llama/llama-3.3-70b-instruct":             "meta-llama/llama-3.3-70b-instruct",
+		"meta-llama/llama-4-maverick-17b-128e-instruct": "meta-llama/llama-4-maverick",
+		"meta-llama/llama-4-scout-17b-16e-instruct":     "meta-llama/llama-4-scout",
+		"minimax/minimax-m2-her-20260123":               "minimax/minimax-m2-her",
+		"mistralai/devstral-medium-2507":                "mistralai/devstral-medium",
+		"mistralai/devstral-small-2507":                 "mistralai/devstral-small",
+		"mistralai/mistral-saba-2502":                   "mistralai/mistral-saba",
+		"mistralai/mistral-small-3.1-24b-instruct-2503": "mistralai/mistral-small-3.1-24b-instruct",
+		"mistralai/mistral-small-3.2-24b-instruct-2506": "mistralai/mistral-small-3.2-24b-instruct",
+		"mistralai/mistral-small-creative-20251216":     "mistralai/mistral-small-creative",
+		"moonshotai/kimi-k2":                            "moonshotai/kimi-k2",
+		"moonshotai/kimi-k2-0905":                       "moonshotai/kimi-k2-0905",
+		"moonshotai/kimi-k2-thinking-20251106":          "moonshotai/kimi-k2-thinking",
+		"moonshotai/kimi-k2.5-0127":                     "moonshotai/kimi-k2.5",
+		"nousresearch/hermes-3-llama-3.1-405b":          "nousresearch/hermes-3-llama-3.1-405b",
+		"nvidia/nemotron-3-nano-30b-a3b":                "nvidia/nemotron-3-nano-30b-a3b",
+		"nvidia/nemotron-nano-12b-v2-vl":                "nvidia/nemotron-nano-12b-v2-vl",
+		"nvidia/nemotron-nano-9b-v2":                    "nvidia/nemotron-nano-9b-v2",
+		"openai/gpt-4.1-2025-04-14":                     "openai/gpt-4.1",
+		"openai/gpt-4.1-mini-2025-04-14":                "openai/gpt-4.1-mini",
+		"openai/gpt-4.1-nano-2025-04-14":                "openai/gpt-4.1-nano",
+		"openai/gpt-4o":                                 "openai/gpt-4o",
+		"openai/gpt-4o-mini-search-preview-2025-03-11":  "openai/gpt-4o-mini-search-preview",
+		"openai/gpt-4o-search-preview-2025-03-11":       "openai/gpt-4o-search-preview",
+		"openai/gpt-5-2025-08-07":                       "openai/gpt-5",
+		"openai/gpt-5-chat-2025-08-07":                  "openai/gpt-5-chat",
+		"openai/gpt-5-mini-2025-08-07":                  "openai/gpt-5-mini",
+		"openai/gpt-5-nano-2025-08-07":                  "openai/gpt-5-nano",
+		"openai/gpt-5-pro-2025-10-06":                   "openai/gpt-5-pro",
+		"openai/gpt-5.1-20251113":                       "openai/gpt-5.1",
+		"openai/gpt-5.1-chat-20251113":                  "openai/gpt-5.1-chat",
+		"openai/gpt-5.1-codex-20251113":                 "openai/gpt-5.1-codex",
+		"openai/gpt-5.1-codex-max-20251204":             "openai/gpt-5.1-codex-max",
+		"openai/gpt-5.1-codex-mini-20251113":            "openai/gpt-5.1-codex-mini",
+		"openai/gpt-5.2-20251211":                       "openai/gpt-5.2",
+		"openai/gpt-5.2-chat-20251211":                  "openai/gpt-5.2-chat",
+		"openai/gpt-5.2-codex-20260114":                 "openai/gpt-5.2-codex",
+		"openai/gpt-5.2-pro-20251211":                   "openai/gpt-5.2-pro",
+		"openai/gpt-oss-120b":                           "openai/gpt-oss-120b",
+		"openai/gpt-oss-20b":                            "openai/gpt-oss-20b",
+		"openai/o1-2024-12-17":                          "openai/o1",
+		"openai/o3-2025-04-16":                          "openai/o3",
+		"openai/o3-deep-research-2025-06-26":            "openai/o3-deep-research",
+		"openai/o3-mini-2025-01-31":                     "openai/o3-mini",
+		"openai/o3-mini-high-2025-01-31":                "openai/o3-mini-high",
+		"openai/o3-pro-2025-06-10":                      "openai/o3-pro",
+		"openai/o4-mini-2025-04-16":                     "openai/o4-mini",
+		"openai/o4-mini-deep-research-2025-06-26":       "openai/o4-mini-deep-research",
+		"openai/o4-mini-high-2025-04-16":                "openai/o4-mini-high",
+		"prime-intellect/intellect-3-20251126":          "prime-intellect/intellect-3",
+		"qwen/qwen-2-vl-7b-instruct":                    "qwen/qwen-2.5-vl-7b-instruct",
+		"qwen/qwen-max-2025-01-25":                      "qwen/qwen-max",
+		"qwen/qwen-plus-2025-01-25":                     "qwen/qwen-plus",
+		"qwen/qwen-plus-2025-07-28":                     "qwen/qwen-plus-2025-07-28",
+		"qwen/qwen-turbo-2024-11-01":                    "qwen/qwen-turbo",
+		"qwen/qwen-vl-max-2025-01-25":                   "qwen/qwen-vl-max",
+		"qwen/qwen3-14b-04-28":                          "qwen/qwen3-14b",
+		"qwen/qwen3-235b-a22b-04-28":                    "qwen/qwen3-235b-a22b",
+		"qwen/qwen3-235b-a22b-07-25":                    "qwen/qwen3-235b-a22b-2507",
+		"qwen/qwen3-30b-a3b-04-28":                      "qwen/qwen3-30b-a3b",
+		"qwen/qwen3-32b-04-28":                          "qwen/qwen3-32b",
+		"qwen/qwen3-4b-04-28":                           "qwen/qwen3-4b:free",
+		"qwen/qwen3-8b-04-28":                           "qwen/qwen3-8b",
+		"qwen/qwen3-coder-480b-a35b-07-25":              "qwen/qwen3-coder",
+		"qwen/qwen3-next-80b-a3b-instruct-2509":         "qwen/qwen3-next-80b-a3b-instruct",
+		"qwen/qwen3-next-80b-a3b-thinking-2509":         "qwen/qwen3-next-80b-a3b-thinking",
+		"relace/relace-search-20251208":                 "relace/relace-search",
+		"sao10k/l3.3-euryale-70b-v2.3":                  "sao10k/l3.3-euryale-70b",
+		"tngtech/deepseek-r1t-chimera":                  "tngtech/deepseek-r1t-chimera",
+		"tngtech/deepseek-r1t2-chimera":                 "tngtech/deepseek-r1t2-chimera",
+		"tngtech/tng-r1t-chimera":                       "tngtech/tng-r1t-chimera",
+		"upstage/solar-pro-3":                           "upstage/solar-pro-3:free",
+		"venice/uncensored":                             "cognitivecomputations/dolphin-mistral-24b-venice-edition:free",
+		"writer/palmyra-x5-20250428":                    "writer/palmyra-x5",
+		"x-ai/grok-4-07-09":                             "x-ai/grok-4",
+		"xiaomi/mimo-v2-flash-20251210":                 "xiaomi/mimo-v2-flash",
+		"z-ai/glm-4-32b-0414":                           "z-ai/glm-4-32b",
+		"z-ai/glm-4.5-air":                              "z-ai/glm-4.5-air",
+		"z-ai/glm-4.6":                                  "z-ai/glm-4.6",
+		"z-ai/glm-4.6-20251208":                         "z-ai/glm-4.6v",
+		"z-ai/glm-4.7-20251222":                         "z-ai/glm-4.7",
+		"z-ai/glm-4.7-flash-20260119":                   "z-ai/glm-4.7-flash",
+	}
+
+	normalizedIndex = map[string][]string{
+		"aion10":                              {"aion-labs/aion-1.0"},
+		"aion10mini":                          {"aion-labs/aion-1.0-mini"},
+		"aionrpllama318b":                     {"aion-labs/aion-rp-llama-3.1-8b"},
+		"auto":                                {"openrouter/auto"},
+		"bodybuilder":                         {"openrouter/bodybuilder"},
+		"chatgpt4olatest":                     {"openai/chatgpt-4o-latest"},
+		"claude35haiku":                       {"anthropic/claude-3.5-haiku"},
+		"claude35sonnet":                      {"anthropic/claude-3.5-sonnet"},
+		"claude37sonnet":                      {"anthropic/claude-3.7-sonnet"},
+		"claude37sonnet20250219":              {"anthropic/claude-3.7-sonnet"},
+		"claude37sonnet:thinking":             {"anthropic/claude-3.7-sonnet:thinking"},
+		"claude3haiku":                        {"anthropic/claude-3-haiku"},
+		"claude41opus20250805":                {"anthropic/claude-opus-4.1"},
+		"claude45haiku20251001":               {"anthropic/claude-haiku-4.5"},
+		"claude45opus20251124":                {"anthropic/claude-opus-4.5"},
+		"claude45sonnet20250929":              {"anthropic/claude-sonnet-4.5"},
+		"claude4opus20250522":                 {"anthropic/claude-opus-4"},
+		"claude4sonnet20250522":               {"anthropic/claude-sonnet-4"},
+		"claudehaiku45":                       {"anthropic/claude-haiku-4.5"},
+		"claudeopus4":                         {"anthropic/claude-opus-4"},
+		"claudeopus41":                        {"anthropic/claude-opus-4.1"},
+		"claudeopus45":                        {"anthropic/claude-opus-4.5"},
+		"claudesonnet4":                       {"anthropic/claude-sonnet-4"},
+		"claudesonnet45":                      {"anthropic/claude-sonnet-4.5"},
+		"codellama7binstructsolidity":         {"alfredpros/codellama-7b-instruct-solidity"},
+		"coderlarge":                          {"arcee-ai/coder-large"},
+		"codestral2508":                       {"mistralai/codestral-2508"},
+		"cogitov21671b":                       {"deepcogito/cogito-v2.1-671b"},
+		"cogitov21671b20251118":               {"deepcogito/cogito-v2.1-671b"},
+		"cogitov2previewllama109bmoe":         {"deepcogito/cogito-v2-preview-llama-109b-moe"},
+		"cogitov2previewllama405b":            {"deepcogito/cogito-v2-preview-llama-405b"},
+		"cogitov2previewllama70b":             {"deepcogito/cogito-v2-preview-llama-70b"},
+		"commanda":                            {"cohere/command-a"},
+		"commanda032025":                      {"cohere/command-a"},
+		"commandr082024":                      {"cohere/command-r-08-2024"},
+		"commandr7b122024":                    {"cohere/command-r7b-12-2024"},
+		"commandrplus082024":                  {"cohere/command-r-plus-08-2024"},
+		"cydonia24bv41":                       {"thedrummer/cydonia-24b-v4.1"},
+		"deephermes3mistral24bpreview":        {"nousresearch/deephermes-3-mistral-24b-preview"},
+		"deepseekchat":                        {"deepseek/deepseek-chat"},
+		"deepseekchatv3":                      {"deepseek/deepseek-chat"},
+		"deepseekchatv30324":                  {"deepseek/deepseek-chat-v3-0324"},
+		"deepseekchatv31":                     {"deepseek/deepseek-chat-v3.1"},
+		"deepseekr1":                          {"deepseek/deepseek-r1"},
+		"deepseekr10528":                      {"deepseek/deepseek-r1-0528"},
+		"deepseekr10528:free":                 {"deepseek/deepseek-r1-0528:free"},
+		"deepseekr1distillllama70b":           {"deepseek/deepseek-r1-distill-llama-70b"},
+		"deepseekr1distillqwen32b":            {"deepseek/deepseek-r1-distill-qwen-32b"},
+		"deepseekr1t2chimera":                 {"tngtech/deepseek-r1t2-chimera"},
+		"deepseekr1t2chimera:free":            {"tngtech/deepseek-r1t2-chimera:free"},
+		"deepseekr1tchimera":                  {"tngtech/deepseek-r1t-chimera"},
+		"deepseekr1tchimera:free":             {"tngtech/deepseek-r1t-chimera:free"},
+		"deepseekv31nexn1":                    {"nex-agi/deepseek-v3.1-nex-n1"},
+		"deepseekv31terminus":                 {"deepseek/deepseek-v3.1-terminus"},
+		"deepseekv31terminus:exacto":          {"deepseek/deepseek-v3.1-terminus:exacto"},
+		"deepseekv32":                         {"deepseek/deepseek-v3.2"},
+		"deepseekv3220251201":                 {"deepseek/deepseek-v3.2"},
+		"deepseekv32exp":                      {"deepseek/deepseek-v3.2-exp"},
+		"deepseekv32speciale":                 {"deepseek/deepseek-v3.2-speciale"},
+		"deepseekv32speciale20251201":         {"deepseek/deepseek-v3.2-speciale"},
+		"devstral2512":                        {"mistralai/devstral-2512"},
+		"devstral2512:free":                   {"mistralai/devstral-2512:free"},
+		"devstralmedium":                      {"mistralai/devstral-medium"},
+		"devstralmedium2507":                  {"mistralai/devstral-medium"},
+		"devstralsmall":                       {"mistralai/devstral-small"},
+		"devstralsmall2507":                   {"mistralai/devstral-small"},
+		"dolphinmistral24bveniceedition:free": {"cognitivecomputations/dolphin-mistral-24b-venice-edition:free"},
+		"ernie4521ba3b":                       {"baidu/ernie-4.5-21b-a3b"},
+		"ernie4521ba3bthinking":               {"baidu/ernie-4.5-21b-a3b-thinking"},
+		"ernie45300ba47b":                     {"baidu/ernie-4.5-300b-a47b"},
+		"ernie45vl28ba3b":                     {"baidu/ernie-4.5-vl-28b-a3b"},
+		"ernie45vl424ba47b":                   {"baidu/ernie-4.5-vl-424b-a47b"},
+		"gemini20flash001":                    {"google/gemini-2.0-flash-001"},
+		"gemini20flashexp:free":               {"google/gemini-2.0-flash-exp:free"},
+		"gemini20flashlite001":                {"google/gemini-2.0-flash-lite-001"},
+		"gemini25flash":                       {"google/gemini-2.5-flash"},
+		"gemini25flashimage":                  {"google/gemini-2.5-flash-image"},
+		"gemini25flashlite":                   {"google/gemini-2.5-flash-lite"},
+		"gemini25flashlitepreview092025":      {"google/gemini-2.5-flash-lite-preview-09-2025"},
+		"gemini25flashpreview092025":          {"google/gemini-2.5-flash-preview-09-2025"},
+		"gemini25pro":                         {"google/gemini-2.5-pro"},
+		"gemini25propreview":                  {"google/gemini-2.5-pro-preview"},
+		"gemini25propreview0325":              {"google/gemini-2.5-pro-preview-05-06"},
+		"gemini25propreview0506":              {"google/gemini-2.5-pro-preview-05-06"},
+		"gemini25propreview0605":              {"google/gemini-2.5-pro-preview"},
+		"gemini3flashpreview":                 {"google/gemini-3-flash-preview"},
+		"gemini3flashpreview20251217":         {"google/gemini-3-flash-preview"},
+		"gemini3proimagepreview":              {"google/gemini-3-pro-image-preview"},
+		"gemini3proimagepreview20251120":      {"google/gemini-3-pro-image-preview"},
+		"gemini3propreview":                   {"google/gemini-3-pro-preview"},
+		"gemini3propreview20251117":           {"google/gemini-3-pro-preview"},
+		"gemma227bit":                         {"google/gemma-2-27b-it"},
+		"gemma29bit":                          {"google/gemma-2-9b-it"},
+		"gemma312bit":                         {"google/gemma-3-12b-it"},
+		"gemma312bit:free":                    {"google/gemma-3-12b-it:free"},
+		"gemma327bit":                         {"google/gemma-3-27b-it"},
+		"gemma327bit:free":                    {"google/gemma-3-27b-it:free"},
+		"gemma34bit":                          {"google/gemma-3-4b-it"},
+		"gemma34bit:free":                     {"google/gemma-3-4b-it:free"},
+		"gemma3ne2bit:free":                   {"google/gemma-3n-e2b-it:free"},
+		"gemma3ne4bit":                        {"google/gemma-3n-e4b-it"},
+		"gemma3ne4bit:free":                   {"google/gemma-3n-e4b-it:free"},
+		"glm432b":                             {"z-ai/glm-4-32b"},
+		"glm432b0414":                         {"z-ai/glm-4-32b"},
+		"glm45":                               {"z-ai/glm-4.5"},
+		"glm45air":                            {"z-ai/glm-4.5-air"},
+		"glm45air:free":                       {"z-ai/glm-4.5-air:free"},
+		"glm45v":                              {"z-ai/glm-4.5v"},
+		"glm46":                               {"z-ai/glm-4.6"},
+		"glm4620251208":                       {"z-ai/glm-4.6v"},
+		"glm46:exacto":                        {"z-ai/glm-4.6:exacto"},
+		"glm46v":                              {"z-ai/glm-4.6v"},
+		"glm47":                               {"z-ai/glm-4.7"},
+		"glm4720251222":                       {"z-ai/glm-4.7"},
+		"glm47flash":                          {"z-ai/glm-4.7-flash"},
+		"glm47flash20260119":                  {"z-ai/glm-4.7-flash"},
+		"goliath120b":                         {"alpindale/goliath-120b"},
+		"gpt35turbo":                          {"openai/gpt-3.5-turbo"},
+		"gpt35turbo0613":                      {"openai/gpt-3.5-turbo-0613"},
+		"gpt35turbo16k":                       {"openai/gpt-3.5-turbo-16k"},
+		"gpt35turboinstruct":                  {"openai/gpt-3.5-turbo-instruct"},
+		"gpt4":                                {"openai/gpt-4"},
+		"gpt40314":                            {"openai/gpt-4-0314"},
+		"gpt41":                               {"openai/gpt-4.1"},
+		"gpt41106preview":                     {"openai/gpt-4-1106-preview"},
+		"gpt4120250414":                       {"openai/gpt-4.1"},
+		"gpt41mini":                           {"openai/gpt-4.1-mini"},
+		"gpt41mini20250414":                   {"openai/gpt-4.1-mini"},
+		"gpt41nano":                           {"openai/gpt-4.1-nano"},
+		"gpt41nano20250414":                   {"openai/gpt-4.1-nano"},
+		"gpt4o":                               {"openai/gpt-4o"},
+		"gpt4o20240513":                       {"openai/gpt-4o-2024-05-13"},
+		"gpt4o20240806":                       {"openai/gpt-4o-2024-08-06"},
+		"gpt4o20241120":                       {"openai/gpt-4o-2024-11-20"},
+		"gpt4o:extended":                      {"openai/gpt-4o:extended"},
+		"gpt4oaudiopreview":                   {"openai/gpt-4o-audio-preview"},
+		"gpt4omini":                           {"openai/gpt-4o-mini"},
+		"gpt4omini20240718":                   {"openai/gpt-4o-mini-2024-07-18"},
+		"gpt4ominisearchpreview":              {"openai/gpt-4o-mini-search-preview"},
+		"gpt4ominisearchpreview20250311":      {"openai/gpt-4o-mini-search-preview"},
+		"gpt4osearchpreview":                  {"openai/gpt-4o-search-preview"},
+		"gpt4osearchpreview20250311":          {"openai/gpt-4o-search-preview"},
+		"gpt4t":                               {"openai/gpt-4-turbo"},
+		"gpt4turbo":                           {"openai/gpt-4-turbo"},
+		"gpt4turbopreview":                    {"openai/gpt-4-turbo-preview"},
+		"gpt5":                                {"openai/gpt-5"},
+		"gpt51":                               {"openai/gpt-5.1"},
+		"gpt5120251113":                       {"openai/gpt-5.1"},
+		"gpt51chat":                           {"openai/gpt-5.1-chat"},
+		"gpt51chat20251113":                   {"openai/gpt-5.1-chat"},
+		"gpt51codex":                          {"openai/gpt-5.1-codex"},
+		"gpt51codex20251113":                  {"openai/gpt-5.1-codex"},
+		"gpt51codexmax":                       {"openai/gpt-5.1-codex-max"},
+		"gpt51codexmax20251204":               {"openai/gpt-5.1-codex-max"},
+		"gpt51codexmini":                      {"openai/gpt-5.1-codex-mini"},
+		"gpt51codexmini20251113":              {"openai/gpt-5.1-codex-mini"},
+		"gpt52":                               {"openai/gpt-5.2"},
+		"gpt520250807":                        {"openai/gpt-5"},
+		"gpt5220251211":                       {"openai/gpt-5.2"},
+		"gpt52chat":                           {"openai/gpt-5.2-chat"},
+		"gpt52chat20251211":                   {"openai/gpt-5.2-chat"},
+		"gpt52codex":                          {"openai/gpt-5.2-codex"},
+		"gpt52codex20260114":                  {"openai/gpt-5.2-codex"},
+		"gpt52pro":                            {"openai/gpt-5.2-pro"},
+		"gpt52pro20251211":                    {"openai/gpt-5.2-pro"},
+		"gpt5chat":                            {"openai/gpt-5-chat"},
+		"gpt5chat20250807":                    {"openai/gpt-5-chat"},
+		"gpt5codex":                           {"openai/gpt-5-codex"},
+		"gpt5image":                           {"openai/gpt-5-image"},
+		"gpt5imagemini":                       {"openai/gpt-5-image-mini"},
+		"gpt5mini":                            {"openai/gpt-5-mini"},
+		"gpt5mini20250807":                    {"openai/gpt-5-mini"},
+		"gpt5nano":                            {"openai/gpt-5-nano"},
+		"gpt5nano20250807":                    {"openai/gpt-5-nano"},
+		"gpt5pro":                             {"openai/gpt-5-pro"},
+		"gpt5pro20251006":                     {"openai/gpt-5-pro"},
+		"gptaudio":                            {"openai/gpt-audio"},
+		"gptaudiomini":                        {"openai/gpt-audio-mini"},
+		"gptoss120b":                          {"openai/gpt-oss-120b"},
+		"gptoss120b:exacto":                   {"openai/gpt-oss-120b:exacto"},
+		"gptoss120b:free":                     {"openai/gpt-oss-120b:free"},
+		"gptoss20b":                           {"openai/gpt-oss-20b"},
+		"gptoss20b:free":                      {"openai/gpt-oss-20b:free"},
+		"gptosssafeguard20b":                  {"openai/gpt-oss-safeguard-20b"},
+		"granite40hmicro":                     {"ibm-granite/granite-4.0-h-micro"},
+		"grok3":                               {"x-ai/grok-3"},
+		"grok3beta":                           {"x-ai/grok-3-beta"},
+		"grok3mini":                           {"x-ai/grok-3-mini"},
+		"grok3minibeta":                       {"x-ai/grok-3-mini-beta"},
+		"grok4":                               {"x-ai/grok-4"},
+		"grok40709":                           {"x-ai/grok-4"},
+		"grok41fast":                          {"x-ai/grok-4.1-fast"},
+		"grok4fast":                           {"x-ai/grok-4-fast"},
+		"grokcodefast1":                       {"x-ai/grok-code-fast-1"},
+		"hermes2prollama38b":                  {"nousresearch/hermes-2-pro-llama-3-8b"},
+		"hermes3llama31405b":                  {"nousresearch/hermes-3-llama-3.1-405b"},
+		"hermes3llama31405b:free":             {"nousresearch/hermes-3-llama-3.1-405b:free"},
+		"hermes3llama3170b":                   {"nousresearch/hermes-3-llama-3.1-70b"},
+		"hermes4405b":                         {"nousresearch/hermes-4-405b"},
+		"hermes470b":                          {"nousresearch/hermes-4-70b"},
+		"hunyuana13binstruct":                 {"tencent/hunyuan-a13b-instruct"},
+		"inflection3pi":                       {"inflection/inflection-3-pi"},
+		"inflection3productivity":             {"inflection/inflection-3-productivity"},
+		"intellect3":                          {"prime-intellect/intellect-3"},
+		"intellect320251126":                  {"prime-intellect/intellect-3"},
+		"internvl378b":                        {"opengvlab/internvl3-78b"},
+		"jambalarge17":                        {"ai21/jamba-large-1.7"},
+		"jambamini17":                         {"ai21/jamba-mini-1.7"},
+		"katcoderpro":                         {"kwaipilot/kat-coder-pro"},
+		"katcoderprov1":                       {"kwaipilot/kat-coder-pro"},
+		"kimidev72b":                          {"moonshotai/kimi-dev-72b"},
+		"kimik2":                              {"moonshotai/kimi-k2"},
+		"kimik20905":                          {"moonshotai/kimi-k2-0905"},
+		"kimik20905:exacto":                   {"moonshotai/kimi-k2-0905:exacto"},
+		"kimik25":                             {"moonshotai/kimi-k2.5"},
+		"kimik250127":                         {"moonshotai/kimi-k2.5"},
+		"kimik2:free":                         {"moonshotai/kimi-k2:free"},
+		"kimik2thinking":                      {"moonshotai/kimi-k2-thinking"},
+		"kimik2thinking20251106":              {"moonshotai/kimi-k2-thinking"},
+		"l3170bhanamix1":                      {"sao10k/l3.1-70b-hanami-x1"},
+		"l31euryale70b":                       {"sao10k/l3.1-euryale-70b"},
+		"l33euryale70b":                       {"sao10k/l3.3-euryale-70b"},
+		"l33euryale70bv23":                    {"sao10k/l3.3-euryale-70b"},
+		"l3euryale70b":                        {"sao10k/l3-euryale-70b"},
+		"l3lunaris8b":                         {"sao10k/l3-lunaris-8b"},
+		"lfm226b":                             {"liquid/lfm-2.2-6b"},
+		"lfm2512binstruct:free":               {"liquid/lfm-2.5-1.2b-instruct:free"},
+		"lfm2512bthinking:free":               {"liquid/lfm-2.5-1.2b-thinking:free"},
+		"lfm28ba1b":                           {"liquid/lfm2-8b-a1b"},
+		"llama31405b":                         {"meta-llama/llama-3.1-405b"},
+		"llama31405binstruct":                 {"meta-llama/llama-3.1-405b-instruct"},
+		"llama31405binstruct:free":            {"meta-llama/llama-3.1-405b-instruct:free"},
+		"llama3170binstruct":                  {"meta-llama/llama-3.1-70b-instruct"},
+		"llama318binstruct":                   {"meta-llama/llama-3.1-8b-instruct"},
+		"llama31lumimaid8b":                   {"neversleep/llama-3.1-lumimaid-8b"},
+		"llama31nemotron70binstruct":          {"nvidia/llama-3.1-nemotron-70b-instruct"},
+		"llama31nemotronultra253bv1":          {"nvidia/llama-3.1-nemotron-ultra-253b-v1"},
+		"llama3211bvisioninstruct":            {"meta-llama/llama-3.2-11b-vision-instruct"},
+		"llama321binstruct":                   {"meta-llama/llama-3.2-1b-instruct"},
+		"llama323binstruct":                   {"meta-llama/llama-3.2-3b-instruct"},
+		"llama323binstruct:free":              {"meta-llama/llama-3.2-3b-instruct:free"},
+		"llama3370binstruct":                  {"meta-llama/llama-3.3-70b-instruct"},
+		"llama3370binstruct:free":             {"meta-llama/llama-3.3-70b-instruct:free"},
+		"llama33nemotronsuper49bv15":          {"nvidia/llama-3.3-nemotron-super-49b-v1.5"},
+		"llama370binstruct":                   {"meta-llama/llama-3-70b-instruct"},
+		"llama38binstruct":                    {"meta-llama/llama-3-8b-instruct"},
+		"llama4maverick":                      {"meta-llama/llama-4-maverick"},
+		"llama4maverick17b128einstruct":       {"meta-llama/llama-4-maverick"},
+		"llama4scout":                         {"meta-llama/llama-4-scout"},
+		"llama4scout17b16einstruct":           {"meta-llama/llama-4-scout"},
+		"llamaguard28b":                       {"meta-llama/llama-guard-2-8b"},
+		"llamaguard38b":                       {"meta-llama/llama-guard-3-8b"},
+		"llamaguard412b":                      {"meta-llama/llama-guard-4-12b"},
+		"llemma7b":                            {"eleutherai/llemma_7b"},
+		"longcatflashchat":                    {"meituan/longcat-flash-chat"},
+		"maestroreasoning":                    {"arcee-ai/maestro-reasoning"},
+		"magnumv472b":                         {"anthracite-org/magnum-v4-72b"},
+		"mercury":                             {"inception/mercury"},
+		"mercurycoder":                        {"inception/mercury-coder"},
+		"mercurycodersmallbeta":               {"inception/mercury-coder"},
+		"mimov2flash":                         {"xiaomi/mimo-v2-flash"},
+		"mimov2flash20251210":                 {"xiaomi/mimo-v2-flash"},
+		"mimov2flash:free":                    {"xiaomi/mimo-v2-flash:free"},
+		"minimax01":                           {"minimax/minimax-01"},
+		"minimaxm1":                           {"minimax/minimax-m1"},
+		"minimaxm2":                           {"minimax/minimax-m2"},
+		"minimaxm21":                          {"minimax/minimax-m2.1"},
+		"minimaxm2her":                        {"minimax/minimax-m2-her"},
+		"minimaxm2her20260123":                {"minimax/minimax-m2-her"},
+		"ministral14b2512":                    {"mistralai/ministral-14b-2512"},
+		"ministral3b":                         {"mistralai/ministral-3b"},
+		"ministral3b2512":                     {"mistralai/ministral-3b-2512"},
+		"ministral8b":                         {"mistralai/ministral-8b"},
+		"ministral8b2512":                     {"mistralai/ministral-8b-2512"},
+		"mistral7binstruct":                   {"mistralai/mistral-7b-instruct"},
+		"mistral7binstructv01":                {"mistralai/mistral-7b-instruct-v0.1"},
+		"mistral7binstructv02":                {"mistralai/mistral-7b-instruct-v0.2"},
+		"mistral7binstructv03":                {"mistralai/mistral-7b-instruct-v0.3"},
+		"mistrallarge":                        {"mistralai/mistral-large"},
+		"mistrallarge2407":                    {"mistralai/mistral-large-2407"},
+		"mistrallarge2411":                    {"mistralai/mistral-large-2411"},
+		"mistrallarge2512":                    {"mistralai/mistral-large-2512"},
+		"mistralmedium3":                      {"mistralai/mistral-medium-3"},
+		"mistralmedium31":                     {"mistralai/mistral-medium-3.1"},
+		"mistralnemo":                         {"mistralai/mistral-nemo"},
+		"mistralsaba":                         {"mistralai/mistral-saba"},
+		"mistralsaba2502":                     {"mistralai/mistral-saba"},
+		"mistralsmall24binstruct2501":         {"mistralai/mistral-small-24b-instruct-2501"},
+		"mistralsmall3124binstruct":           {"mistralai/mistral-small-3.1-24b-instruct"},
+		"mistralsmall3124binstruct2503":       {"mistralai/mistral-small-3.1-24b-instruct"},
+		"mistralsmall3124binstruct:free":      {"mistralai/mistral-small-3.1-24b-instruct:free"},
+		"mistralsmall3224binstruct":           {"mistralai/mistral-small-3.2-24b-instruct"},
+		"mistralsmall3224binstruct2506":       {"mistralai/mistral-small-3.2-24b-instruct"},
+		"mistralsmallcreative":                {"mistralai/mistral-small-creative"},
+		"mistralsmallcreative20251216":        {"mistralai/mistral-small-creative"},
+		"mistraltiny":                         {"mistralai/mistral-tiny"},
+		"mixtral8x22binstruct":                {"mistralai/mixtral-8x22b-instruct"},
+		"mixtral8x7binstruct":                 {"mistralai/mixtral-8x7b-instruct"},
+		"molmo28b:free":                       {"allenai/molmo-2-8b:free"},
+		"morphv3fast":                         {"morph/morph-v3-fast"},
+		"morphv3large":                        {"morph/morph-v3-large"},
+		"mythomaxl213b":                       {"gryphe/mythomax-l2-13b"},
+		"nemotron3nano30ba3b":                 {"nvidia/nemotron-3-nano-30b-a3b"},
+		"nemotron3nano30ba3b:free":            {"nvidia/nemotron-3-nano-30b-a3b:free"},
+		"nemotronnano12bv2vl":                 {"nvidia/nemotron-nano-12b-v2-vl"},
+		"nemotronnano12bv2vl:free":            {"nvidia/nemotron-nano-12b-v2-vl:free"},
+		"nemotronnano9bv2":                    {"nvidia/nemotron-nano-9b-v2"},
+		"nemotronnano9bv2:free":               {"nvidia/nemotron-nano-9b-v2:free"},
+		"noromaid20b":                         {"neversleep/noromaid-20b"},
+		"nova2litev1":                         {"amazon/nova-2-lite-v1"},
+		"novalitev1":                          {"amazon/nova-lite-v1"},
+		"novamicrov1":                         {"amazon/nova-micro-v1"},
+		"novapremierv1":                       {"amazon/nova-premier-v1"},
+		"novaprov1":                           {"amazon/nova-pro-v1"},
+		"o1":                                  {"openai/o1"},
+		"o120241217":                          {"openai/o1"},
+		"o1pro":                               {"openai/o1-pro"},
+		"o3":                                  {"openai/o3"},
+		"o320250416":                          {"openai/o3"},
+		"o3deepresearch":                      {"openai/o3-deep-research"},
+		"o3deepresearch20250626":              {"openai/o3-deep-research"},
+		"o3mini":                              {"openai/o3-mini"},
+		"o3mini20250131":                      {"openai/o3-mini"},
+		"o3minihigh":                          {"openai/o3-mini-high"},
+		"o3minihigh20250131":                  {"openai/o3-mini-high"},
+		"o3pro":                               {"openai/o3-pro"},
+		"o3pro20250610":                       {"openai/o3-pro"},
+		"o4mini":                              {"openai/o4-mini"},
+		"o4mini20250416":                      {"openai/o4-mini"},
+		"o4minideepresearch":                  {"openai/o4-mini-deep-research"},
+		"o4minideepresearch20250626":          {"openai/o4-mini-deep-research"},
+		"o4minihigh":                          {"openai/o4-mini-high"},
+		"o4minihigh20250416":                  {"openai/o4-mini-high"},
+		"olmo2032532binstruct":                {"allenai/olmo-2-0325-32b-instruct"},
+		"olmo3132binstruct":                   {"allenai/olmo-3.1-32b-instruct"},
+		"olmo3132binstruct20251215":           {"allenai/olmo-3.1-32b-instruct"},
+		"olmo3132bthink":                      {"allenai/olmo-3.1-32b-think"},
+		"olmo3132bthink20251215":              {"allenai/olmo-3.1-32b-think"},
+		"olmo332bthink":                       {"allenai/olmo-3-32b-think"},
+		"olmo332bthink20251121":               {"allenai/olmo-3-32b-think"},
+		"olmo37binstruct":                     {"allenai/olmo-3-7b-instruct"},
+		"olmo37binstruct20251121":             {"allenai/olmo-3-7b-instruct"},
+		"olmo37bthink":                        {"allenai/olmo-3-7b-think"},
+		"olmo37bthink20251121":                {"allenai/olmo-3-7b-think"},
+		"opus45":                              {"anthropic/claude-opus-4.5"},
+		"palmyrax5":                           {"writer/palmyra-x5"},
+		"palmyrax520250428":                   {"writer/palmyra-x5"},
+		"phi4":                                {"microsoft/phi-4"},
+		"pixtral12b":                          {"mistralai/pixtral-12b"},
+		"pixtrallarge2411":                    {"mistralai/pixtral-large-2411"},
+		"qwen25":                              {"qwen/qwen-2.5-72b-instruct"},
+		"qwen2572b":                           {"qwen/qwen-2.5-72b-instruct"},
+		"qwen2572binstruct":                   {"qwen/qwen-2.5-72b-instruct"},
+		"qwen257binstruct":                    {"qwen/qwen-2.5-7b-instruct"},
+		"qwen25coder32binstruct":              {"qwen/qwen-2.5-coder-32b-instruct"},
+		"qwen25coder7binstruct":               {"qwen/qwen2.5-coder-7b-instruct"},
+		"qwen25vl32binstruct":                 {"qwen/qwen2.5-vl-32b-instruct"},
+		"qwen25vl72binstruct":                 {"qwen/qwen2.5-vl-72b-instruct"},
+		"qwen25vl7binstruct":                  {"qwen/qwen-2.5-vl-7b-instruct"},
+		"qwen25vl7binstruct:free":             {"qwen/qwen-2.5-vl-7b-instruct:free"},
+		"qwen2vl7binstruct":                   {"qwen/qwen-2.5-vl-7b-instruct"},
+		"qwen314b":                            {"qwen/qwen3-14b"},
+		"qwen314b0428":                        {"qwen/qwen3-14b"},
+		"qwen3235ba22b":                       {"qwen/qwen3-235b-a22b"},
+		"qwen3235ba22b0428":                   {"qwen/qwen3-235b-a22b"},
+		"qwen3235ba22b0725":                   {"qwen/qwen3-235b-a22b-2507"},
+		"qwen3235ba22b2507":                   {"qwen/qwen3-235b-a22b-2507"},
+		"qwen3235ba22bthinking2507":           {"qwen/qwen3-235b-a22b-thinking-2507"},
+		"qwen330ba3b":                         {"qwen/qwen3-30b-a3b"},
+		"qwen330ba3b0428":                     {"qwen/qwen3-30b-a3b"},
+		"qwen330ba3binstruct2507":             {"qwen/qwen3-30b-a3b-instruct-2507"},
+		"qwen330ba3bthinking2507":             {"qwen/qwen3-30b-a3b-thinking-2507"},
+		"qwen332b":                            {"qwen/qwen3-32b"},
+		"qwen332b0428":                        {"qwen/qwen3-32b"},
+		"qwen34b:free":                        {"qwen/qwen3-4b:free"},
+		"qwen38b":                             {"qwen/qwen3-8b"},
+		"qwen38b0428":                         {"qwen/qwen3-8b"},
+		"qwen3coder":                          {"qwen/qwen3-coder"},
+		"qwen3coder30ba3binstruct":            {"qwen/qwen3-coder-30b-a3b-instruct"},
+		"qwen3coder480ba35b0725":              {"qwen/qwen3-coder"},
+		"qwen3coder:exacto":                   {"qwen/qwen3-coder:exacto"},
+		"qwen3coder:free":                     {"qwen/qwen3-coder:free"},
+		"qwen3coderflash":                     {"qwen/qwen3-coder-flash"},
+		"qwen3coderplus":                      {"qwen/qwen3-coder-plus"},
+		"qwen3embedding06b":                   {"qwen/qwen3-embedding-0.6b"},
+		"qwen3max":                            {"qwen/qwen3-max"},
+		"qwen3next80ba3binstruct":             {"qwen/qwen3-next-80b-a3b-instruct"},
+		"qwen3next80ba3binstruct2509":         {"qwen/qwen3-next-80b-a3b-instruct"},
+		"qwen3next80ba3binstruct:free":        {"qwen/qwen3-next-80b-a3b-instruct:free"},
+		"qwen3next80ba3bthinking":             {"qwen/qwen3-next-80b-a3b-thinking"},
+		"qwen3next80ba3bthinking2509":         {"qwen/qwen3-next-80b-a3b-thinking"},
+		"qwen3reranker06b":                    {"qwen/qwen3-reranker-0.6b"},
+		"qwen3vl235ba22binstruct":             {"qwen/qwen3-vl-235b-a22b-instruct"},
+		"qwen3vl235ba22bthinking":             {"qwen/qwen3-vl-235b-a22b-thinking"},
+		"qwen3vl30ba3binstruct":               {"qwen/qwen3-vl-30b-a3b-instruct"},
+		"qwen3vl30ba3bthinking":               {"qwen/qwen3-vl-30b-a3b-thinking"},
+		"qwen3vl32binstruct":                  {"qwen/qwen3-vl-32b-instruct"},
+		"qwen3vl8binstruct":                   {"qwen/qwen3-vl-8b-instruct"},
+		"qwen3vl8bthinking":                   {"qwen/qwen3-vl-8b-thinking"},
+		"qwenmax":                             {"qwen/qwen-max"},
+		"qwenmax20250125":                     {"qwen/qwen-max"},
+		"qwenplus":                            {"qwen/qwen-plus"},
+		"qwenplus20250125":                    {"qwen/qwen-plus"},
+		"qwenplus20250728":                    {"qwen/qwen-plus-2025-07-28"},
+		"qwenplus20250728:thinking":           {"qwen/qwen-plus-2025-07-28:thinking"},
+		"qwenturbo":                           {"qwen/qwen-turbo"},
+		"qwenturbo20241101":                   {"qwen/qwen-turbo"},
+		"qwenvlmax":                           {"qwen/qwen-vl-max"},
+		"qwenvlmax20250125":                   {"qwen/qwen-vl-max"},
+		"qwenvlplus":                          {"qwen/qwen-vl-plus"},
+		"qwq32b":                              {"qwen/qwq-32b"},
+		"relaceapply3":                        {"relace/relace-apply-3"},
+		"relacesearch":                        {"relace/relace-search"},
+		"relacesearch20251208":                {"relace/relace-search"},
+		"remmslerpl213b":                      {"undi95/remm-slerp-l2-13b"},
+		"rnj1instruct":                        {"essentialai/rnj-1-instruct"},
+		"rocinante12b":                        {"thedrummer/rocinante-12b"},
+		"router":                              {"switchpoint/router"},
+		"seed16":                              {"bytedance-seed/seed-1.6"},
+		"seed1620250625":                      {"bytedance-seed/seed-1.6"},
+		"seed16flash":                         {"bytedance-seed/seed-1.6-flash"},
+		"seed16flash20250625":                 {"bytedance-seed/seed-1.6-flash"},
+		"skyfall36bv2":                        {"thedrummer/skyfall-36b-v2"},
+		"solarpro3:free":                      {"upstage/solar-pro-3:free"},
+		"sonar":                               {"perplexity/sonar"},
+		"sonardeepresearch":                   {"perplexity/sonar-deep-research"},
+		"sonarpro":                            {"perplexity/sonar-pro"},
+		"sonarprosearch":                      {"perplexity/sonar-pro-search"},
+		"sonarreasoningpro":                   {"perplexity/sonar-reasoning-pro"},
+		"sorcererlm8x22b":                     {"raifle/sorcererlm-8x22b"},
+		"spotlight":                           {"arcee-ai/spotlight"},
+		"step3":                               {"stepfun-ai/step3"},
+		"textembedding3large":                 {"openai/text-embedding-3-large"},
+		"tngr1tchimera":                       {"tngtech/tng-r1t-chimera"},
+		"tngr1tchimera:free":                  {"tngtech/tng-r1t-chimera:free"},
+		"tongyideepresearch30ba3b":            {"alibaba/tongyi-deepresearch-30b-a3b"},
+		"trinitylargepreview:free":            {"arcee-ai/trinity-large-preview:free"},
+		"trinitymini":                         {"arcee-ai/trinity-mini"},
+		"trinitymini20251201":                 {"arcee-ai/trinity-mini"},
+		"trinitymini:free":                    {"arcee-ai/trinity-mini:free"},
+		"uitars157b":                          {"bytedance/ui-tars-1.5-7b"},
+		"unslopnemo12b":                       {"thedrummer/unslopnemo-12b"},
+		"virtuosolarge":                       {"arcee-ai/virtuoso-large"},
+		"voxtralsmall24b2507":                 {"mistralai/voxtral-small-24b-2507"},
+		"weaver":                              {"mancer/weaver"},
+		"wizardlm28x22b":                      {"microsoft/wizardlm-2-8x22b"},
+	}
+
+	looseIndex = map[string][]string{
+		"chatgpt4o":                 {"openai/chatgpt-4o-latest"},
+		"claude37sonnet":            {"anthropic/claude-3.7-sonnet"},
+		"claude41opus":              {"anthropic/claude-opus-4.1"},
+		"claude45haiku":             {"anthropic/claude-haiku-4.5"},
+		"claude45opus":              {"anthropic/claude-opus-4.5"},
+		"claude45sonnet":            {"anthropic/claude-sonnet-4.5"},
+		"claude4opus":               {"anthropic/claude-opus-4"},
+		"claude4sonnet":             {"anthropic/claude-sonnet-4"},
+		"codestral":                 {"mistralai/codestral-2508"},
+		"cogitov21671b":             {"deepcogito/cogito-v2.1-671b"},
+		"commanda":                  {"cohere/command-a"},
+		"commandr":                  {"cohere/command-r-08-2024"},
+		"commandr7b":                {"cohere/command-r7b-12-2024"},
+		"commandrplus":              {"cohere/command-r-plus-08-2024"},
+		"deepseek":                  {"deepseek/deepseek-chat"},
+		"deepseekchatv3":            {"deepseek/deepseek-chat-v3-0324"},
+		"deepseekr1":                {"deepseek/deepseek-r1-0528"},
+		"deepseekr1:free":           {"deepseek/deepseek-r1-0528:free"},
+		"deepseekv32":               {"deepseek/deepseek-v3.2"},
+		"deepseekv32speciale":       {"deepseek/deepseek-v3.2-speciale"},
+		"devstral":                  {"mistralai/devstral-2512"},
+		"devstral:free":             {"mistralai/devstral-2512:free"},
+		"devstralmedium":            {"mistralai/devstral-medium"},
+		"devstralsmall":             {"mistralai/devstral-small"},
+		"gemini25flashlitepreview":  {"google/gemini-2.5-flash-lite-preview-09-2025"},
+		"gemini25flashpreview":      {"google/gemini-2.5-flash-preview-09-2025"},
+		"gemini25propreview":        {"google/gemini-2.5-pro-preview", "google/gemini-2.5-pro-preview-05-06"},
+		"gemini3flashpreview":       {"google/gemini-3-flash-preview"},
+		"gemini3proimagepreview":    {"google/gemini-3-pro-image-preview"},
+		"gemini3propreview":         {"google/gemini-3-pro-preview"},
+		"gemma227b":                 {"google/gemma-2-27b-it"},
+		"gemma29b":                  {"google/gemma-2-9b-it"},
+		"gemma312b":                 {"google/gemma-3-12b-it"},
+		"gemma312b:free":            {"google/gemma-3-12b-it:free"},
+		"gemma327b":                 {"google/gemma-3-27b-it"},
+		"gemma327b:free":            {"google/gemma-3-27b-it:free"},
+		"gemma34b":                  {"google/gemma-3-4b-it"},
+		"gemma34b:free":             {"google/gemma-3-4b-it:free"},
+		"gemma3ne2b:free":           {"google/gemma-3n-e2b-it:free"},
+		"gemma3ne4b":                {"google/gemma-3n-e4b-it"},
+		"gemma3ne4b:free":           {"google/gemma-3n-e4b-it:free"},
+		"glm432b":                   {"z-ai/glm-4-32b"},
+		"glm46":                     {"z-ai/glm-4.6v"},
+		"glm47":                     {"z-ai/glm-4.7"},
+		"glm47flash":                {"z-ai/glm-4.7-flash"},
+		"gpt35turbo":                {"openai/gpt-3.5-turbo-0613", "openai/gpt-3.5-turbo-instruct"},
+		"gpt4":                      {"openai/gpt-4-0314"},
+		"gpt41":                     {"openai/gpt-4.1"},
+		"gpt41mini":                 {"openai/gpt-4.1-mini"},
+		"gpt41nano":                 {"openai/gpt-4.1-nano"},
+		"gpt4o":                     {"openai/gpt-4o-2024-05-13", "openai/gpt-4o-2024-08-06", "openai/gpt-4o-2024-11-20"},
+		"gpt4omini":                 {"openai/gpt-4o-mini-2024-07-18"},
+		"gpt4ominisearchpreview":    {"openai/gpt-4o-mini-search-preview"},
+		"gpt4osearchpreview":        {"openai/gpt-4o-search-preview"},
+		"gpt4preview":               {"openai/gpt-4-1106-preview"},
+		"gpt5":                      {"openai/gpt-5", "openai/gpt-5-chat"},
+		"gpt51":                     {"openai/gpt-5.1", "openai/gpt-5.1-chat"},
+		"gpt51chat":                 {"openai/gpt-5.1-chat"},
+		"gpt51codex":                {"openai/gpt-5.1-codex"},
+		"gpt51codexmax":             {"openai/gpt-5.1-codex-max"},
+		"gpt51codexmini":            {"openai/gpt-5.1-codex-mini"},
+		"gpt52":                     {"openai/gpt-5.2", "openai/gpt-5.2-chat"},
+		"gpt52chat":                 {"openai/gpt-5.2-chat"},
+		"gpt52codex":                {"openai/gpt-5.2-codex"},
+		"gpt52pro":                  {"openai/gpt-5.2-pro"},
+		"gpt5chat":                  {"openai/gpt-5-chat"},
+		"gpt5mini":                  {"openai/gpt-5-mini"},
+		"gpt5nano":                  {"openai/gpt-5-nano"},
+		"gpt5pro":                   {"openai/gpt-5-pro"},
+		"grok4":                     {"x-ai/grok-4"},
+		"hunyuana13b":               {"tencent/hunyuan-a13b-instruct"},
+		"intellect3":                {"prime-intellect/intellect-3"},
+		"kimik2":                    {"moonshotai/kimi-k2-0905"},
+		"kimik25":                   {"moonshotai/kimi-k2.5"},
+		"kimik2:exacto":             {"moonshotai/kimi-k2-0905:exacto"},
+		"kimik2thinking":            {"moonshotai/kimi-k2-thinking"},
+		"lfm2512b:free":             {"liquid/lfm-2.5-1.2b-instruct:free"},
+		"llama31405b":               {"meta-llama/llama-3.1-405b-instruct"},
+		"llama31405b:free":          {"meta-llama/llama-3.1-405b-instruct:free"},
+		"llama3170b":                {"meta-llama/llama-3.1-70b-instruct"},
+		"llama318b":                 {"meta-llama/llama-3.1-8b-instruct"},
+		"llama31nemotron70b":        {"nvidia/llama-3.1-nemotron-70b-instruct"},
+		"llama3211bvision":          {"meta-llama/llama-3.2-11b-vision-instruct"},
+		"llama321b":                 {"meta-llama/llama-3.2-1b-instruct"},
+		"llama323b":                 {"meta-llama/llama-3.2-3b-instruct"},
+		"llama323b:free":            {"meta-llama/llama-3.2-3b-instruct:free"},
+		"llama3370b":                {"meta-llama/llama-3.3-70b-instruct"},
+		"llama3370b:free":           {"meta-llama/llama-3.3-70b-instruct:free"},
+		"llama370b":                 {"meta-llama/llama-3-70b-instruct"},
+		"llama38b":                  {"meta-llama/llama-3-8b-instruct"},
+		"llama4maverick17b128e":     {"meta-llama/llama-4-maverick"},
+		"llama4scout17b16e":         {"meta-llama/llama-4-scout"},
+		"longcatflash":              {"meituan/longcat-flash-chat"},
+		"mimov2flash":               {"xiaomi/mimo-v2-flash"},
+		"minimaxm2her":              {"minimax/minimax-m2-her"},
+		"ministral14b":              {"mistralai/ministral-14b-2512"},
+		"ministral3b":               {"mistralai/ministral-3b-2512"},
+		"ministral8b":               {"mistralai/ministral-8b-2512"},
+		"mistral7b":                 {"mistralai/mistral-7b-instruct"},
+		"mistrallarge":              {"mistralai/mistral-large-2407", "mistralai/mistral-large-2411", "mistralai/mistral-large-2512"},
+		"mistralsaba":               {"mistralai/mistral-saba"},
+		"mistralsmall24b":           {"mistralai/mistral-small-24b-instruct-2501"},
+		"mistralsmall24binstruct":   {"mistralai/mistral-small-24b-instruct-2501"},
+		"mistralsmall3124b":         {"mistralai/mistral-small-3.1-24b-instruct"},
+		"mistralsmall3124b:free":    {"mistralai/mistral-small-3.1-24b-instruct:free"},
+		"mistralsmall3124binstruct": {"mistralai/mistral-small-3.1-24b-instruct"},
+		"mistralsmall3224b":         {"mistralai/mistral-small-3.2-24b-instruct"},
+		"mistralsmall3224binstruct": {"mistralai/mistral-small-3.2-24b-instruct"},
+		"mistralsmallcreative":      {"mistralai/mistral-small-creative"},
+		"mixtral8x22b":              {"mistralai/mixtral-8x22b-instruct"},
+		"mixtral8x7b":               {"mistralai/mixtral-8x7b-instruct"},
+		"o1":                        {"openai/o1"},
+		"o3":                        {"openai/o3"},
+		"o3deepresearch":            {"openai/o3-deep-research"},
+		"o3mini":                    {"openai/o3-mini"},
+		"o3minihigh":                {"openai/o3-mini-high"},
+		"o3pro":                     {"openai/o3-pro"},
+		"o4mini":                    {"openai/o4-mini"},
+		"o4minideepresearch":        {"openai/o4-mini-deep-research"},
+		"o4minihigh":                {"openai/o4-mini-high"},
+		"olmo232b":                  {"allenai/olmo-2-0325-32b-instruct"},
+		"olmo232binstruct":          {"allenai/olmo-2-0325-32b-instruct"},
+		"olmo3132b":                 {"allenai/olmo-3.1-32b-instruct"},
+		"olmo3132binstruct":         {"allenai/olmo-3.1-32b-instruct"},
+		"olmo3132bthink":            {"allenai/olmo-3.1-32b-think"},
+		"olmo332bthink":             {"allenai/olmo-3-32b-think"},
+		"olmo37b":                   {"allenai/olmo-3-7b-instruct"},
+		"olmo37binstruct":           {"allenai/olmo-3-7b-instruct"},
+		"olmo37bthink":              {"allenai/olmo-3-7b-think"},
+		"palmyrax5":                 {"writer/palmyra-x5"},
+		"pixtrallarge":              {"mistralai/pixtral-large-2411"},
+		"qwen2572b":                 {"qwen/qwen-2.5-72b-instruct"},
+		"qwen257b":                  {"qwen/qwen-2.5-7b-instruct"},
+		"qwen25coder32b":            {"qwen/qwen-2.5-coder-32b-instruct"},
+		"qwen25coder7b":             {"qwen/qwen2.5-coder-7b-instruct"},
+		"qwen25vl32b":               {"qwen/qwen2.5-vl-32b-instruct"},
+		"qwen25vl72b":               {"qwen/qwen2.5-vl-72b-instruct"},
+		"qwen25vl7b":                {"qwen/qwen-2.5-vl-7b-instruct"},
+		"qwen25vl7b:free":           {"qwen/qwen-2.5-vl-7b-instruct:free"},
+		"qwen2vl7b":                 {"qwen/qwen-2.5-vl-7b-instruct"},
+		"qwen314b":                  {"qwen/qwen3-14b"},
+		"qwen3235ba22b":             {"qwen/qwen3-235b-a22b", "qwen/qwen3-235b-a22b-2507"},
+		"qwen3235ba22bthinking":     {"qwen/qwen3-235b-a22b-thinking-2507"},
+		"qwen330ba3b":               {"qwen/qwen3-30b-a3b", "qwen/qwen3-30b-a3b-instruct-2507"},
+		"qwen330ba3binstruct":       {"qwen/qwen3-30b-a3b-instruct-2507"},
+		"qwen330ba3bthinking":       {"qwen/qwen3-30b-a3b-thinking-2507"},
+		"qwen332b":                  {"qwen/qwen3-32b"},
+		"qwen38b":                   {"qwen/qwen3-8b"},
+		"qwen3coder30ba3b":          {"qwen/qwen3-coder-30b-a3b-instruct"},
+		"qwen3coder480ba35b":        {"qwen/qwen3-coder"},
+		"qwen3next80ba3b":           {"qwen/qwen3-next-80b-a3b-instruct"},
+		"qwen3next80ba3b:free":      {"qwen/qwen3-next-80b-a3b-instruct:free"},
+		"qwen3next80ba3binstruct":   {"qwen/qwen3-next-80b-a3b-instruct"},
+		"qwen3next80ba3bthinking":   {"qwen/qwen3-next-80b-a3b-thinking"},
+		"qwen3vl235ba22b":           {"qwen/qwen3-vl-235b-a22b-instruct"},
+		"qwen3vl30ba3b":             {"qwen/qwen3-vl-30b-a3b-instruct"},
+		"qwen3vl32b":                {"qwen/qwen3-vl-32b-instruct"},
+		"qwen3vl8b":                 {"qwen/qwen3-vl-8b-instruct"},
+		"qwenmax":                   {"qwen/qwen-max"},
+		"qwenplus":                  {"qwen/qwen-plus", "qwen/qwen-plus-2025-07-28"},
+		"qwenplus:thinking":         {"qwen/qwen-plus-2025-07-28:thinking"},
+		"qwenturbo":                 {"qwen/qwen-turbo"},
+		"qwenvlmax":                 {"qwen/qwen-vl-max"},
+		"relacesearch":              {"relace/relace-search"},
+		"rnj1":                      {"essentialai/rnj-1-instruct"},
+		"seed16":                    {"bytedance-seed/seed-1.6"},
+		"seed16flash":               {"bytedance-seed/seed-1.6-flash"},
+		"trinitymini":               {"arcee-ai/trinity-mini"},
+		"voxtralsmall24b":           {"mistralai/voxtral-small-24b-2507"},
+	}
+
+	staticIDs = []string{
+		"ai21/jamba-large-1.7",
+		"ai21/jamba-mini-1.7",
+		"aion-labs/aion-1.0",
+		"aion-labs/aion-1.0-mini",
+		"aion-labs/aion-rp-llama-3.1-8b",
+		"alfredpros/codellama-7b-instruct-solidity",
+		"alibaba/tongyi-deepresearch-30b-a3b",
+		"allenai/molmo-2-8b:free",
+		"allenai/olmo-2-0325-32b-instruct",
+		"allenai/olmo-3-32b-think",
+		"allenai/olmo-3-7b-instruct",
+		"allenai/olmo-3-7b-think",
+		"allenai/olmo-3.1-32b-instruct",
+		"allenai/olmo-3.1-32b-think",
+		"alpindale/goliath-120b",
+		"amazon/nova-2-lite-v1",
+		"amazon/nova-lite-v1",
+		"amazon/nova-micro-v1",
+		"amazon/nova-premier-v1",
+		"amazon/nova-pro-v1",
+		"anthracite-org/magnum-v4-72b",
+		"anthropic/claude-3-haiku",
+		"anthropic/claude-3.5-haiku",
+		"anthropic/claude-3.5-sonnet",
+		"anthropic/claude-3.7-sonnet",
+		"anthropic/claude-3.7-sonnet:thinking",
+		"anthropic/claude-haiku-4.5",
+		"anthropic/claude-opus-4",
+		"anthropic/claude-opus-4.1",
+		"anthropic/claude-opus-4.5",
+		"anthropic/claude-sonnet-4",
+		"anthropic/claude-sonnet-4.5",
+		"arcee-ai/coder-large",
+		"arcee-ai/maestro-reasoning",
+		"arcee-ai/spotlight",
+		"arcee-ai/trinity-large-preview:free",
+		"arcee-ai/trinity-mini",
+		"arcee-ai/trinity-mini:free",
+		"arcee-ai/virtuoso-large",
+		"baidu/ernie-4.5-21b-a3b",
+		"baidu/ernie-4.5-21b-a3b-thinking",
+		"baidu/ernie-4.5-300b-a47b",
+		"baidu/ernie-4.5-vl-28b-a3b",
+		"baidu/ernie-4.5-vl-424b-a47b",
+		"bytedance-seed/seed-1.6",
+		"bytedance-seed/seed-1.6-flash",
+		"bytedance/ui-tars-1.5-7b",
+		"cognitivecomputations/dolphin-mistral-24b-venice-edition:free",
+		"cohere/command-a",
+		"cohere/command-r-08-2024",
+		"cohere/command-r-plus-08-2024",
+		"cohere/command-r7b-12-2024",
+		"deepcogito/cogito-v2-preview-llama-109b-moe",
+		"deepcogito/cogito-v2-preview-llama-405b",
+		"deepcogito/cogito-v2-preview-llama-70b",
+		"deepcogito/cogito-v2.1-671b",
+		"deepseek/deepseek-chat",
+		"deepseek/deepseek-chat-v3-0324",
+		"deepseek/deepseek-chat-v3.1",
+		"deepseek/deepseek-r1",
+		"deepseek/deepseek-r1-0528",
+		"deepseek/deepseek-r1-0528:free",
+		"deepseek/deepseek-r1-distill-llama-70b",
+		"deepseek/deepseek-r1-distill-qwen-32b",
+		"deepseek/deepseek-v3.1-terminus",
+		"deepseek/deepseek-v3.1-terminus:exacto",
+		"deepseek/deepseek-v3.2",
+		"deepseek/deepseek-v3.2-exp",
+		"deepseek/deepseek-v3.2-speciale",
+		"eleutherai/llemma_7b",
+		"essentialai/rnj-1-instruct",
+		"google/gemini-2.0-flash-001",
+		"google/gemini-2.0-flash-exp:free",
+		"google/gemini-2.0-flash-lite-001",
+		"google/gemini-2.5-flash",
+		"google/gemini-2.5-flash-image",
+		"google/gemini-2.5-flash-lite",
+		"google/gemini-2.5-flash-lite-preview-09-2025",
+		"google/gemini-2.5-flash-preview-09-2025",
+		"google/gemini-2.5-pro",
+		"google/gemini-2.5-pro-preview",
+		"google/gemini-2.5-pro-preview-05-06",
+		"google/gemini-3-flash-preview",
+		"google/gemini-3-pro-image-preview",
+		"google/gemini-3-pro-preview",
+		"google/gemma-2-27b-it",
+		"google/gemma-2-9b-it",
+		"google/gemma-3-12b-it",
+		"google/gemma-3-12b-it:free",
+		"google/gemma-3-27b-it",
+		"google/gemma-3-27b-it:free",
+		"google/gemma-3-4b-it",
+		"google/gemma-3-4b-it:free",
+		"google/gemma-3n-e2b-it:free",
+		"google/gemma-3n-e4b-it",
+		"google/gemma-3n-e4b-it:free",
+		"gryphe/mythomax-l2-13b",
+		"ibm-granite/granite-4.0-h-micro",
+		"inception/mercury",
+		"inception/mercury-coder",
+		"inflection/inflection-3-pi",
+		"inflection/inflection-3-productivity",
+		"kwaipilot/kat-coder-pro",
+		"liquid/lfm-2.2-6b",
+		"liquid/lfm-2.5-1.2b-instruct:free",
+		"liquid/lfm-2.5-1.2b-thinking:free",
+		"liquid/lfm2-8b-a1b",
+		"mancer/weaver",
+		"meituan/longcat-flash-chat",
+		"meta-llama/llama-3-70b-instruct",
+		"meta-llama/llama-3-8b-instruct",
+		"meta-llama/llama-3.1-405b",
+		"meta-llama/llama-3.1-405b-instruct",
+		"meta-llama/llama-3.1-405b-instruct:free",
+		"meta-llama/llama-3.1-70b-instruct",
+		"meta-llama/llama-3.1-8b-instruct",
+		"meta-llama/llama-3.2-11b-vision-instruct",
+		"meta-llama/llama-3.2-1b-instruct",
+		"meta-llama/llama-3.2-3b-instruct",
+		"meta-llama/llama-3.2-3b-instruct:free",
+		"meta-llama/llama-3.3-70b-instruct",
+		"meta-llama/llama-3.3-70b-instruct:free",
+		"meta-llama/llama-4-maverick",
+		"meta-llama/llama-4-scout",
+		"meta-llama/llama-guard-2-8b",
+		"meta-llama/llama-guard-3-8b",
+		"meta-llama/llama-guard-4-12b",
+		"microsoft/phi-4",
+		"microsoft/wizardlm-2-8x22b",
+		"minimax/minimax-01",
+		"minimax/minimax-m1",
+		"minimax/minimax-m2",
+		"minimax/minimax-m2-her",
+		"minimax/minimax-m2.1",
+		"mistralai/codestral-2508",
+		"mistralai/devstral-2512",
+		"mistralai/devstral-2512:free",
+		"mistralai/devstral-medium",
+		"mistralai/devstral-small",
+		"mistralai/ministral-14b-2512",
+		"mistralai/ministral-3b",
+		"mistralai/ministral-3b-2512",
+		"mistralai/ministral-8b",
+		"mistralai/ministral-8b-2512",
+		"mistralai/mistral-7b-instruct",
+		"mistralai/mistral-7b-instruct-v0.1",
+		"mistralai/mistral-7b-instruct-v0.2",
+		"mistralai/mistral-7b-instruct-v0.3",
+		"mistralai/mistral-large",
+		"mistralai/mistral-large-2407",
+		"mistralai/mistral-large-2411",
+		"mistralai/mistral-large-2512",
+		"mistralai/mistral-medium-3",
+		"mistralai/mistral-medium-3.1",
+		"mistralai/mistral-nemo",
+		"mistralai/mistral-saba",
+		"mistralai/mistral-small-24b-instruct-2501",
+		"mistralai/mistral-small-3.1-24b-instruct",
+		"mistralai/mistral-small-3.1-24b-instruct:free",
+		"mistralai/mistral-small-3.2-24b-instruct",
+		"mistralai/mistral-small-creative",
+		"mistralai/mistral-tiny",
+		"mistralai/mixtral-8x22b-instruct",
+		"mistralai/mixtral-8x7b-instruct",
+		"mistralai/pixtral-12b",
+		"mistralai/pixtral-large-2411",
+		"mistralai/voxtral-small-24b-2507",
+		"moonshotai/kimi-dev-72b",
+		"moonshotai/kimi-k2",
+		"moonshotai/kimi-k2-0905",
+		"moonshotai/kimi-k2-0905:exacto",
+		"moonshotai/kimi-k2-thinking",
+		"moonshotai/kimi-k2.5",
+		"moonshotai/kimi-k2:free",
+		"morph/morph-v3-fast",
+		"morph/morph-v3-large",
+		"neversleep/llama-3.1-lumimaid-8b",
+		"neversleep/noromaid-20b",
+		"nex-agi/deepseek-v3.1-nex-n1",
+		"nousresearch/deephermes-3-mistral-24b-preview",
+		"nousresearch/hermes-2-pro-llama-3-8b",
+		"nousresearch/hermes-3-llama-3.1-405b",
+		"nousresearch/hermes-3-llama-3.1-405b:free",
+		"nousresearch/hermes-3-llama-3.1-70b",
+		"nousresearch/hermes-4-405b",
+		"nousresearch/hermes-4-70b",
+		"nvidia/llama-3.1-nemotron-70b-instruct",
+		"nvidia/llama-3.1-nemotron-ultra-253b-v1",
+		"nvidia/llama-3.3-nemotron-super-49b-v1.5",
+		"nvidia/nemotron-3-nano-30b-a3b",
+		"nvidia/nemotron-3-nano-30b-a3b:free",
+		"nvidia/nemotron-nano-12b-v2-vl",
+		"nvidia/nemotron-nano-12b-v2-vl:free",
+		"nvidia/nemotron-nano-9b-v2",
+		"nvidia/nemotron-nano-9b-v2:free",
+		"openai/chatgpt-4o-latest",
+		"openai/gpt-3.5-turbo",
+		"openai/gpt-3.5-turbo-0613",
+		"openai/gpt-3.5-turbo-16k",
+		"openai/gpt-3.5-turbo-instruct",
+		"openai/gpt-4",
+		"openai/gpt-4-0314",
+		"openai/gpt-4-1106-preview",
+		"openai/gpt-4-turbo",
+		"openai/gpt-4-turbo-preview",
+		"openai/gpt-4.1",
+		"openai/gpt-4.1-mini",
+		"openai/gpt-4.1-nano",
+		"openai/gpt-4o",
+		"openai/gpt-4o-2024-05-13",
+		"openai/gpt-4o-2024-08-06",
+		"openai/gpt-4o-2024-11-20",
+		"openai/gpt-4o-audio-preview",
+		"openai/gpt-4o-mini",
+		"openai/gpt-4o-mini-2024-07-18",
+		"openai/gpt-4o-mini-search-preview",
+		"openai/gpt-4o-search-preview",
+		"openai/gpt-4o:extended",
+		"openai/gpt-5",
+		"openai/gpt-5-chat",
+		"openai/gpt-5-codex",
+		"openai/gpt-5-image",
+		"openai/gpt-5-image-mini",
+		"openai/gpt-5-mini",
+		"openai/gpt-5-nano",
+		"openai/gpt-5-pro",
+		"openai/gpt-5.1",
+		"openai/gpt-5.1-chat",
+		"openai/gpt-5.1-codex",
+		"openai/gpt-5.1-codex-max",
+		"openai/gpt-5.1-codex-mini",
+		"openai/gpt-5.2",
+		"openai/gpt-5.2-chat",
+		"openai/gpt-5.2-codex",
+		"openai/gpt-5.2-pro",
+		"openai/gpt-audio",
+		"openai/gpt-audio-mini",
+		"openai/gpt-oss-120b",
+		"openai/gpt-oss-120b:exacto",
+		"openai/gpt-oss-120b:free",
+		"openai/gpt-oss-20b",
+		"openai/gpt-oss-20b:free",
+		"openai/gpt-oss-safeguard-20b",
+		"openai/o1",
+		"openai/o1-pro",
+		"openai/o3",
+		"openai/o3-deep-research",
+		"openai/o3-mini",
+		"openai/o3-mini-high",
+		"openai/o3-pro",
+		"openai/o4-mini",
+		"openai/o4-mini-deep-research",
+		"openai/o4-mini-high",
+		"openai/text-embedding-3-large",
+		"opengvlab/internvl3-78b",
+		"openrouter/auto",
+		"openrouter/bodybuilder",
+		"perplexity/sonar",
+		"perplexity/sonar-deep-research",
+		"perplexity/sonar-pro",
+		"perplexity/sonar-pro-search",
+		"perplexity/sonar-reasoning-pro",
+		"prime-intellect/intellect-3",
+		"qwen/qwen-2.5-72b-instruct",
+		"qwen/qwen-2.5-7b-instruct",
+		"qwen/qwen-2.5-coder-32b-instruct",
+		"qwen/qwen-2.5-vl-7b-instruct",
+		"qwen/qwen-2.5-vl-7b-instruct:free",
+		"qwen/qwen-max",
+		"qwen/qwen-plus",
+		"qwen/qwen-plus-2025-07-28",
+		"qwen/qwen-plus-2025-07-28:thinking",
+		"qwen/qwen-turbo",
+		"qwen/qwen-vl-max",
+		"qwen/qwen-vl-plus",
+		"qwen/qwen2.5-coder-7b-instruct",
+		"qwen/qwen2.5-vl-32b-instruct",
+		"qwen/qwen2.5-vl-72b-instruct",
+		"qwen/qwen3-14b",
+		"qwen/qwen3-235b-a22b",
+		"qwen/qwen3-235b-a22b-2507",
+		"qwen/qwen3-235b-a22b-thinking-2507",
+		"qwen/qwen3-30b-a3b",
+		"qwen/qwen3-30b-a3b-instruct-2507",
+		"qwen/qwen3-30b-a3b-thinking-2507",
+		"qwen/qwen3-32b",
+		"qwen/qwen3-4b:free",
+		"qwen/qwen3-8b",
+		"qwen/qwen3-coder",
+		"qwen/qwen3-coder-30b-a3b-instruct",
+		"qwen/qwen3-coder-flash",
+		"qwen/qwen3-coder-plus",
+		"qwen/qwen3-coder:exacto",
+		"qwen/qwen3-coder:free",
+		"qwen/qwen3-embedding-0.6b",
+		"qwen/qwen3-max",
+		"qwen/qwen3-next-80b-a3b-instruct",
+		"qwen/qwen3-next-80b-a3b-instruct:free",
+		"qwen/qwen3-next-80b-a3b-thinking",
+		"qwen/qwen3-reranker-0.6b",
+		"qwen/qwen3-vl-235b-a22b-instruct",
+		"qwen/qwen3-vl-235b-a22b-thinking",
+		"qwen/qwen3-vl-30b-a3b-instruct",
+		"qwen/qwen3-vl-30b-a3b-thinking",
+		"qwen/qwen3-vl-32b-instruct",
+		"qwen/qwen3-vl-8b-instruct",
+		"qwen/qwen3-vl-8b-thinking",
+		"qwen/qwq-32b",
+		"raifle/sorcererlm-8x22b",
+		"relace/relace-apply-3",
+		"relace/relace-search",
+		"sao10k/l3-euryale-70b",
+		"sao10k/l3-lunaris-8b",
+		"sao10k/l3.1-70b-hanami-x1",
+		"sao10k/l3.1-euryale-70b",
+		"sao10k/l3.3-euryale-70b",
+		"stepfun-ai/step3",
+		"switchpoint/router",
+		"tencent/hunyuan-a13b-instruct",
+		"thedrummer/cydonia-24b-v4.1",
+		"thedrummer/rocinante-12b",
+		"thedrummer/skyfall-36b-v2",
+		"thedrummer/unslopnemo-12b",
+		"tngtech/deepseek-r1t-chimera",
+		"tngtech/deepseek-r1t-chimera:free",
+		"tngtech/deepseek-r1t2-chimera",
+		"tngtech/deepseek-r1t2-chimera:free",
+		"tngtech/tng-r1t-chimera",
+		"tngtech/tng-r1t-chimera:free",
+		"undi95/remm-slerp-l2-13b",
+		"upstage/solar-pro-3:free",
+		"writer/palmyra-x5",
+		"x-ai/grok-3",
+		"x-ai/grok-3-beta",
+		"x-ai/grok-3-mini",
+		"x-ai/grok-3-mini-beta",
+		"x-ai/grok-4",
+		"x-ai/grok-4-fast",
+		"x-ai/grok-4.1-fast",
+		"x-ai/grok-code-fast-1",
+		"xiaomi/mimo-v2-flash",
+		"xiaomi/mimo-v2-flash:free",
+		"z-ai/glm-4-32b",
+		"z-ai/glm-4.5",
+		"z-ai/glm-4.5-air",
+		"z-ai/glm-4.5-air:free",
+		"z-ai/glm-4.5v",
+		"z-ai/glm-4.6",
+		"z-ai/glm-4.6:exacto",
+		"z-ai/glm-4.6v",
+		"z-ai/glm-4.7",
+		"z-ai/glm-4.7-flash",
+	}
+
+	providerIndex = map[string]bitset{
+		"ai21":                  {0x3},
+		"aion-labs":             {0x1c},
+		"alfredpros":            {0x20},
+		"allenai":               {0x3f80},
+		"alpindale":             {0x4000},
+		"amazon":                {0xf8000},
+		"anthracite-org":        {0x100000},
+		"anthropic":             {0xffe00000},
+		"arcee-ai":              {0x7f00000000},
+		"baidu":                 {0xf8000000000},
+		"bytedance":             {0x400000000000},
+		"bytedance-seed":        {0x300000000000},
+		"cognitivecomputations": {0x800000000000},
+		"cohere":                {0xf000000000000},
+		"deepcogito":            {0xf0000000000000},
+		"deepseek":              {0xff00000000000000, 0x1f},
+		"eleutherai":            {0x0, 0x20},
+		"essentialai":           {0x0, 0x40},
+		"google":                {0x0, 0xffffff80},
+		"gryphe":                {0x0, 0x100000000},
+		"ibm-granite":           {0x0, 0x200000000},
+		"inception":             {0x0, 0xc00000000},
+		"inflection":            {0x0, 0x3000000000},
+		"kwaipilot":             {0x0, 0x4000000000},
+		"liquid":                {0x0, 0x78000000000},
+		"mancer":                {0x0, 0x80000000000},
+		"meituan":               {0x0, 0x100000000000},
+		"meta":                  {0x0, 0x7fffe00000000000},
+		"microsoft":             {0x0, 0x8000000000000000, 0x1},
+		"minimax":               {0x0, 0x0, 0x3e},
+		"mistral":               {0x0, 0x0, 0x7fffffffc0},
+		"moonshotai":            {0x0, 0x0, 0x3f8000000000},
+		"morph":                 {0x0, 0x0, 0xc00000000000},
+		"neversleep":            {0x0, 0x0, 0x3000000000000},
+		"nex-agi":               {0x0, 0x0, 0x4000000000000},
+		"nous research":         {0x0, 0x0, 0x3f8000000000000},
+		"nvidia":                {0x0, 0x0, 0xfc00000000000000, 0x7},
+		"openai":                {0x0, 0x0, 0x0, 0x3ffffffffffffff8},
+		"opengvlab":             {0x0, 0x0, 0x0, 0x4000000000000000},
+		"openrouter":            {0x0, 0x0, 0x0, 0x8000000000000000, 0x1},
+		"perplexity":            {0x0, 0x0, 0x0, 0x0, 0x3e},
+		"prime-intellect":       {0x0, 0x0, 0x0, 0x0, 0x40},
+		"qwen":                  {0x40, 0x0, 0x0, 0x0, 0xfffffffffff80},
+		"raifle":                {0x0, 0x0, 0x0, 0x0, 0x10000000000000},
+		"relace":                {0x0, 0x0, 0x0, 0x0, 0x60000000000000},
+		"sao10k":                {0x0, 0x0, 0x0, 0x0, 0xf80000000000000},
+		"stepfun-ai":            {0x0, 0x0, 0x0, 0x0, 0x1000000000000000},
+		"switchpoint":           {0x0, 0x0, 0x0, 0x0, 0x2000000000000000},
+		"tencent":               {0x0, 0x0, 0x0, 0x0, 0x4000000000000000},
+		"thedrummer":            {0x0, 0x0, 0x0, 0x0, 0x8000000000000000, 0x7},
+		"tngtech":               {0x0, 0x0, 0x0, 0x0, 0x0, 0x1f8},
+		"undi95":                {0x0, 0x0, 0x0, 0x0, 0x0, 0x200},
+		"upstage":               {0x0, 0x0, 0x0, 0x0, 0x0, 0x400},
+		"writer":                {0x0, 0x0, 0x0, 0x0, 0x0, 0x800},
+		"x-ai":                  {0x0, 0x0, 0x0, 0x0, 0x0, 0xff000},
+		"xiaomi":                {0x0, 0x0, 0x0, 0x0, 0x0, 0x300000},
+		"z-ai":                  {0x0, 0x0, 0x0, 0x0, 0x0, 0xffc00000},
+	}
+
+	capabilityIndex = map[Capability]bitset{
+		CapChat:          {0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff, 0xdfffffffffffffff, 0xfffff7bfffffffff, 0xffffffff},
+		CapEmbedding:     {0x0, 0x0, 0x0, 0x2000000000000000, 0x4000000000},
+		CapFunctionCall:  {0x5f7634f8ffef9043, 0xf0d404c1f97f7af, 0x77fc1f7ffff8ffec, 0x1fefe7fff67fff77, 0x14cef7bfffcbf1d0, 0xfffff5a5},
+		CapJsonMode:      {0xd7efb238b0107ec3, 0xcd5d684dbebfffdf, 0xbfbf1ffefff0ffe9, 0x5ffd7ffffffffffe, 0xdd0ff79ffffff2d0, 0xfdbff7ad},
+		CapMultimodal:    {0x107c04ffed8080, 0x4c1000001f9fff80, 0x80001030e380a802, 0x5e7807fffe6fe809, 0x1007f00000360c3a, 0x24070000},
+		CapReasoning:     {0xfef03d30ff00aa4c, 0x200001ff41f, 0xfb0818800000002c, 0x1e77e6f7f4000007, 0x700ca411f7c08074, 0xff9fc5f8},
+		CapRerank:        {0x0, 0x0, 0x0, 0x0, 0x80000000000},
+		ModalityAudioIn:  {0x0, 0x17f680, 0x4000000000, 0x180000100000},
+		ModalityAudioOut: {0x0, 0x0, 0x0, 0x180000100000},
+		ModalityFileIn:   {0xfb808000, 0x17f680, 0x0, 0x1ff8058fee6fe000},
+		ModalityImageIn:  {0x107c04ffed8080, 0x4c1000001f9fff80, 0x80001030e380a802, 0x5e7807fffe6fe809, 0x1007f00000360c3a, 0x24070000},
+		ModalityImageOut: {0x0, 0x80800, 0x0, 0x60000000},
+		ModalityTextIn:   {0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff, 0xffffffff},
+		ModalityTextOut:  {0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff, 0xdfffffffffffffff, 0xfffff7bfffffffff, 0xffffffff},
+		ModalityVideoIn:  {0x300000008080, 0x16f680, 0x8000000000000000, 0x1, 0x0, 0x20000000},
+	}
 }
@@ -0,0 +1,151 @@
+package llmspecs
+
+import (
+	"sort"
+	"strings"
+)
+
+// SortKey selects the model attribute QueryBuilder.OrderBy sorts on.
+type SortKey uint8
+
+const (
+	SortByID SortKey = iota
+	SortByName
+	SortByProvider
+	SortByContext     // context window
+	SortByMaxOutput   // output token limit
+	SortByInputPrice  // prompt price
+	SortByOutputPrice // completion price
+	SortByRelease     // release date
+)
+
+// sortOrder is one key of a multi-key ordering.
+type sortOrder struct {
+	key  SortKey
+	desc bool
+}
+
+// OrderBy sorts results by key in ascending order. Further OrderBy and
+// OrderByDesc calls break ties; remaining ties are broken by ID.
+// Models with an unknown value (no price, no release date) sort last.
+func (q *QueryBuilder) OrderBy(key SortKey) *QueryBuilder {
+	q.order = append(q.order, sortOrder{key: key})
+	return q
+}
+
+// OrderByDesc sorts results by key in descending order. See OrderBy.
+func (q *QueryBuilder) OrderByDesc(key SortKey) *QueryBuilder {
+	q.order = append(q.order, sortOrder{key: key, desc: true})
+	return q
+}
+
+// Limit caps the number of results; n <= 0 means no limit.
+func (q *QueryBuilder) Limit(n int) *QueryBuilder {
+	q.limit = n
+	return q
+}
+
+// Offset skips the first n results, for pagination together with Limit.
+func (q *QueryBuilder) Offset(n int) *QueryBuilder {
+	q.offset = n
+	return q
+}
+
+// First returns the first model in query order.
+func (q *QueryBuilder) First() (Model, bool) {
+	results := q.List()
+	if len(results) == 0 {
+		return nil, false
+	}
+	return results[0], true
+}
+
+// sortModels orders models by q.order, then by ID.
+func (q *QueryBuilder) sortModels(models []Model) {
+	sort.Slice(models, func(i, j int) bool {
+		a, b := models[i], models[j]
+		for _, o := range q.order {
+			c, known := compareBy(o.key, a, b)
+			if c == 0 {
+				continue
+			}
+			if o.desc && known {
+				c = -c
+			}
+			return c < 0
+		}
+		return a.ID() < b.ID()
+	})
+}
+
+// paginate applies Offset and Limit to sorted results.
+func (q *QueryBuilder) paginate(models []Model) []Model {
+	if q.offset > 0 {
+		if q.offset >= len(models) {
+			return nil
+		}
+		models = models[q.offset:]
+	}
+	if q.limit > 0 && len(models) > q.limit {
+		models = models[:q.limit]
+	}
+	return models
+}
+
+// compareBy compares a and b by key. known is false when exactly one value
+// is missing; the result then puts the missing value last in either direction.
+func compareBy(key SortKey, a, b Model) (c int, known bool) {
+	switch key {
+	case SortByName:
+		return strings.Compare(strings.ToLower(a.Name()), strings.ToLower(b.Name())), true
+	case SortByProvider:
+		return strings.Compare(strings.ToLower(a.Provider()), strings.ToLower(b.Provider())), true
+	case SortByContext:
+		return compareInt(a.ContextLength(), b.ContextLength()), true
+	case SortByMaxOutput:
+		return compareInt(a.MaxOutput(), b.MaxOutput()), true
+	case SortByInputPrice:
+		return comparePrice(a.Pricing().Prompt, b.Pricing().Prompt)
+	case SortByOutputPrice:
+		return comparePrice(a.Pricing().Completion, b.Pricing().Completion)
+	case SortByRelease:
+		ra, rb := a.ReleasedAt(), b.ReleasedAt()
+		if ra.IsZero() != rb.IsZero() {
+			return missingLast(ra.IsZero()), false
+		}
+		return ra.Compare(rb), true
+	}
+	return strings.Compare(a.ID(), b.ID()), true
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// comparePrice compares exact prices. Unknown and per-request prices sort last.
+func comparePrice(a, b Price) (int, bool) {
+	ra, okA := a.Rat()
+	rb, okB := b.Rat()
+	okA = okA && ra.Sign() >= 0
+	okB = okB && rb.Sign() >= 0
+	if okA != okB {
+		return missingLast(!okA), false
+	}
+	if !okA {
+		return 0, true
+	}
+	return ra.Cmp(rb), true
+}
+
+func missingLast(aMissing bool) int {
+	if aMissing {
+		return 1
+	}
+	return -1
+}
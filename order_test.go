@@ -0,0 +1,84 @@
+package llmspecs
+
+import "testing"
+
+func TestQuery_DefaultOrder(t *testing.T) {
+	results := Query().Provider("OpenAI").List()
+	for i := 1; i < len(results); i++ {
+		if results[i-1].ID() >= results[i].ID() {
+			t.Fatalf("Results not sorted by ID: %s before %s", results[i-1].ID(), results[i].ID())
+		}
+	}
+}
+
+func TestQuery_OrderBy(t *testing.T) {
+	results := Query().Provider("Anthropic").OrderByDesc(SortByContext).OrderBy(SortByInputPrice).List()
+	if len(results) < 2 {
+		t.Fatal("Expected several Anthropic models")
+	}
+	for i := 1; i < len(results); i++ {
+		a, b := results[i-1], results[i]
+		if a.ContextLength() < b.ContextLength() {
+			t.Errorf("%s (%d) before %s (%d)", a.ID(), a.ContextLength(), b.ID(), b.ContextLength())
+		}
+		if a.ContextLength() == b.ContextLength() {
+			if c, _ := comparePrice(a.Pricing().Prompt, b.Pricing().Prompt); c > 0 {
+				t.Errorf("Tie on context not broken by price: %s before %s", a.ID(), b.ID())
+			}
+		}
+	}
+
+	// Unknown release dates sort last in both directions
+	for _, desc := range []bool{false, true} {
+		q := Query()
+		if desc {
+			q.OrderByDesc(SortByRelease)
+		} else {
+			q.OrderBy(SortByRelease)
+		}
+		all := q.List()
+		seenUnknown := false
+		for _, m := range all {
+			if m.ReleasedAt().IsZero() {
+				seenUnknown = true
+			} else if seenUnknown {
+				t.Fatalf("Dated model %s after an undated one (desc=%v)", m.ID(), desc)
+			}
+		}
+		if first := all[0].ReleasedAt(); desc && first.Before(all[1].ReleasedAt()) {
+			t.Error("Expected newest model first")
+		}
+	}
+}
+
+func TestQuery_LimitOffset(t *testing.T) {
+	all := Query().Provider("OpenAI").List()
+	if len(all) < 5 {
+		t.Fatal("Expected at least 5 OpenAI models")
+	}
+	page := Query().Provider("OpenAI").Offset(2).Limit(3).List()
+	if len(page) != 3 {
+		t.Fatalf("Expected 3 models, got %d", len(page))
+	}
+	for i, m := range page {
+		if m.ID() != all[i+2].ID() {
+			t.Errorf("page[%d] = %s, want %s", i, m.ID(), all[i+2].ID())
+		}
+	}
+	if got := Query().Offset(Total()).List(); len(got) != 0 {
+		t.Errorf("Expected no models past the end, got %d", len(got))
+	}
+
+	m, ok := Query().Has(CapChat).OrderByDesc(SortByContext).First()
+	if !ok {
+		t.Fatal("Expected a chat model")
+	}
+	for _, other := range Query().Has(CapChat).List() {
+		if other.ContextLength() > m.ContextLength() {
+			t.Errorf("First returned %s, but %s has a longer context", m.ID(), other.ID())
+		}
+	}
+	if _, ok := Query().Provider("no-such-provider").First(); ok {
+		t.Error("Expected no model for an unknown provider")
+	}
+}
@@ -0,0 +1,325 @@
+package llmspecs
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/kingfs/go-llm-specs/internal/modelid"
+)
+
+// ErrDuplicateModel is returned by Register when the ID is already taken.
+var ErrDuplicateModel = errors.New("llmspecs: model already registered")
+
+// overlayState holds models registered at runtime. They are layered on top
+// of staticRegistry: an overlay model hides a built-in model with the same
+// ID, and overlay aliases take precedence over built-in ones.
+//
+// A published state is never modified. Writers copy it, change the copy and
+// publish that, so readers need no lock and callbacks such as Where
+// predicates can call back into the registry while a writer waits.
+type overlayState struct {
+	models  map[string]Model
+	aliases map[string]string // lowercase alias -> overlay model ID
+	version uint64            // incremented on every change, to invalidate derived indexes
+
+	// Normalized keys of IDs and aliases -> overlay model IDs, like
+	// normalizedIndex and looseIndex
+	normalized, loose map[string][]string
+
+	// Lowercase canonical slugs and Hugging Face IDs -> overlay model IDs,
+	// like canonicalIndex and huggingFaceIndex
+	canonical, huggingFace map[string]string
+
+	// Base model ID -> sorted IDs of its overlay variants, whether or not
+	// the base is registered
+	variants map[string][]string
+}
+
+var overlay struct {
+	sync.Mutex // serializes writers
+	state      atomic.Pointer[overlayState]
+}
+
+// currentOverlay returns the published runtime models. Its maps may be nil.
+func currentOverlay() *overlayState {
+	if s := overlay.state.Load(); s != nil {
+		return s
+	}
+	return &overlayState{}
+}
+
+// updateOverlay applies fn to a copy of the runtime models and publishes the
+// copy, unless fn fails.
+func updateOverlay(fn func(s *overlayState) error) error {
+	overlay.Lock()
+	defer overlay.Unlock()
+	cur := currentOverlay()
+	next := &overlayState{
+		models:      maps.Clone(cur.models),
+		aliases:     maps.Clone(cur.aliases),
+		version:     cur.version + 1,
+		normalized:  maps.Clone(cur.normalized),
+		loose:       maps.Clone(cur.loose),
+		canonical:   maps.Clone(cur.canonical),
+		huggingFace: maps.Clone(cur.huggingFace),
+		variants:    maps.Clone(cur.variants),
+	}
+	if next.models == nil {
+		next.models, next.aliases = map[string]Model{}, map[string]string{}
+		next.normalized, next.loose = map[string][]string{}, map[string][]string{}
+		next.canonical, next.huggingFace = map[string]string{}, map[string]string{}
+		next.variants = map[string][]string{}
+	}
+	if err := fn(next); err != nil {
+		return err
+	}
+	overlay.state.Store(next)
+	return nil
+}
+
+// Register adds a model to the registry, e.g. a self-hosted fine-tune.
+// It fails with ErrDuplicateModel if a built-in or registered model already
+// uses the ID; use Override to replace one. Aliases of m are indexed
+// case-insensitively. Register is safe for concurrent use.
+func Register(m Model) error {
+	if err := validateModel(m); err != nil {
+		return err
+	}
+	return updateOverlay(func(s *overlayState) error {
+		id := m.ID()
+		if _, ok := s.models[id]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateModel, id)
+		}
+		if _, ok := staticRegistry[id]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateModel, id)
+		}
+		s.put(m)
+		return nil
+	})
+}
+
+// Override registers m, replacing any built-in or registered model with the
+// same ID. Override is safe for concurrent use.
+func Override(m Model) error {
+	if err := validateModel(m); err != nil {
+		return err
+	}
+	return updateOverlay(func(s *overlayState) error {
+		s.remove(m.ID())
+		s.put(m)
+		return nil
+	})
+}
+
+// Unregister removes a runtime model, restoring the built-in model it
+// overrode, if any. It reports whether a model was removed and is meant
+// mainly for tests.
+func Unregister(id string) bool {
+	err := updateOverlay(func(s *overlayState) error {
+		if !s.remove(id) {
+			return errNotRegistered
+		}
+		return nil
+	})
+	return err == nil
+}
+
+var errNotRegistered = errors.New("llmspecs: model not registered")
+
+func validateModel(m Model) error {
+	if m == nil {
+		return errors.New("llmspecs: cannot register a nil model")
+	}
+	if m.ID() == "" {
+		return errors.New("llmspecs: cannot register a model without an ID")
+	}
+	return nil
+}
+
+// put stores m and indexes its aliases, canonical slug, Hugging Face ID,
+// normalized names and base model.
+func (s *overlayState) put(m Model) {
+	id := m.ID()
+	s.models[id] = m
+	if base, _, ok := strings.Cut(id, ":"); ok {
+		if ids := s.variants[base]; !slices.Contains(ids, id) {
+			ids = append(slices.Clip(ids), id)
+			slices.Sort(ids)
+			s.variants[base] = ids
+		}
+	}
+	for _, alias := range m.Aliases() {
+		s.aliases[strings.ToLower(alias)] = id
+	}
+	s.eachKeyed(m, claim(id))
+	s.eachNormalized(m, func(index map[string][]string, key string) {
+		if !slices.Contains(index[key], id) {
+			// Clip so that append copies instead of writing to a slice
+			// shared with the published state
+			index[key] = append(slices.Clip(index[key]), id)
+		}
+	})
+}
+
+// eachNormalized calls fn with the index and key of every normalized key
+// of m's ID and aliases.
+func (s *overlayState) eachNormalized(m Model, fn func(index map[string][]string, key string)) {
+	for _, name := range append([]string{m.ID()}, m.Aliases()...) {
+		for i, key := range modelid.Normalize(name).Keys {
+			if i == 0 {
+				fn(s.normalized, key)
+			} else {
+				fn(s.loose, key)
+			}
+		}
+	}
+}
+
+// eachKeyed calls fn with the index and lowercase key of m's canonical slug
+// and Hugging Face ID, if set.
+func (s *overlayState) eachKeyed(m Model, fn func(index map[string]string, key string)) {
+	fn(s.canonical, strings.ToLower(m.CanonicalSlug()))
+	if hf := m.HuggingFaceID(); hf != "" {
+		fn(s.huggingFace, strings.ToLower(hf))
+	}
+}
+
+// claim returns an eachKeyed callback pointing keys at id unless a
+// preferred model already owns them.
+func claim(id string) func(index map[string]string, key string) {
+	return func(index map[string]string, key string) {
+		if cur, ok := index[key]; !ok || preferredID(id, cur) {
+			index[key] = id
+		}
+	}
+}
+
+// preferredID reports whether candidate should own a canonical slug or
+// Hugging Face ID over current. Variants such as ":free" share them with
+// their base model, so the base wins, as in the generated indexes.
+func preferredID(candidate, current string) bool {
+	cv, uv := strings.Contains(candidate, ":"), strings.Contains(current, ":")
+	if cv != uv {
+		return !cv
+	}
+	if len(candidate) != len(current) {
+		return len(candidate) < len(current)
+	}
+	return candidate < current
+}
+
+// remove drops the model with the given ID and the aliases still pointing
+// at it, reporting whether there was one.
+func (s *overlayState) remove(id string) bool {
+	m, ok := s.models[id]
+	if !ok {
+		return false
+	}
+	for _, alias := range m.Aliases() {
+		key := strings.ToLower(alias)
+		if s.aliases[key] == id {
+			delete(s.aliases, key)
+		}
+	}
+	s.eachNormalized(m, func(index map[string][]string, key string) {
+		ids := slices.DeleteFunc(slices.Clone(index[key]), func(v string) bool { return v == id })
+		if len(ids) == 0 {
+			delete(index, key)
+		} else {
+			index[key] = ids
+		}
+	})
+	delete(s.models, id)
+	if base, _, ok := strings.Cut(id, ":"); ok {
+		ids := slices.DeleteFunc(slices.Clone(s.variants[base]), func(v string) bool { return v == id })
+		if len(ids) == 0 {
+			delete(s.variants, base)
+		} else {
+			s.variants[base] = ids
+		}
+	}
+	released := false
+	s.eachKeyed(m, func(index map[string]string, key string) {
+		if index[key] == id {
+			delete(index, key)
+			released = true
+		}
+	})
+	if released {
+		// Hand the keys to other runtime models sharing them, if any
+		for _, other := range s.models {
+			s.eachKeyed(other, claim(other.ID()))
+		}
+	}
+	return true
+}
+
+// lookupID returns the model with the exact ID, preferring runtime models.
+func lookupID(id string) (Model, bool) {
+	if m, ok := currentOverlay().models[id]; ok {
+		return m, true
+	}
+	if m, ok := staticRegistry[id]; ok {
+		return m, true
+	}
+	return nil, false
+}
+
+// lookupCanonical resolves a lowercase canonical slug, preferring runtime
+// models.
+func lookupCanonical(slug string) (Model, bool) {
+	if id, ok := currentOverlay().canonical[slug]; ok {
+		return lookupID(id)
+	}
+	return lookupIndex(canonicalIndex, slug)
+}
+
+// lookupHuggingFace resolves a lowercase Hugging Face ID, preferring runtime
+// models.
+func lookupHuggingFace(hfID string) (Model, bool) {
+	if id, ok := currentOverlay().huggingFace[hfID]; ok {
+		return lookupID(id)
+	}
+	return lookupIndex(huggingFaceIndex, hfID)
+}
+
+// lookupAlias resolves a lowercase alias, preferring runtime aliases.
+func lookupAlias(alias string) (Model, bool) {
+	id, ok := currentOverlay().aliases[alias]
+	if !ok {
+		if id, ok = aliasIndex[alias]; !ok {
+			return nil, false
+		}
+	}
+	return lookupID(id)
+}
+
+// forEach calls fn for every model in the layered view until fn returns false.
+// Built-in models hidden by an override are skipped. fn sees the runtime
+// models as they were when forEach started.
+func forEach(fn func(Model) bool) {
+	s := currentOverlay()
+	for id, m := range staticRegistry {
+		if _, hidden := s.models[id]; hidden {
+			continue
+		}
+		if !fn(m) {
+			return
+		}
+	}
+	for _, m := range s.models {
+		if !fn(m) {
+			return
+		}
+	}
+}
+
+// overlayVersion returns a counter that changes whenever runtime models change.
+func overlayVersion() uint64 {
+	return currentOverlay().version
+}
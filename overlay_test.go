@@ -0,0 +1,206 @@
+package llmspecs
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRegister(t *testing.T) {
+	m := &modelData{
+		IDVal:       "acme/llama-ft-8b",
+		NameVal:     "Acme Llama FT",
+		ProviderVal: "Acme",
+		FeaturesVal: CapChat | CapFunctionCall,
+		AliasList:   []string{"Acme-FT"},
+	}
+	before := Total()
+	if err := Register(m); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	defer Unregister(m.ID())
+
+	if Total() != before+1 {
+		t.Errorf("Expected %d models, got %d", before+1, Total())
+	}
+	if got, ok := Get("acme-ft"); !ok || got.ID() != m.ID() {
+		t.Errorf("Alias lookup failed, got %v", got)
+	}
+	if got := GetMany([]string{m.ID(), "openai/gpt-4"}); len(got) != 2 {
+		t.Errorf("GetMany should see runtime models, got %d", len(got))
+	}
+	if got := Query().Provider("acme").Has(CapFunctionCall).List(); len(got) != 1 || got[0].ID() != m.ID() {
+		t.Errorf("Query should see runtime models, got %v", got)
+	}
+	if got := Search("acme llama", 1); len(got) != 1 || got[0].ID() != m.ID() {
+		t.Errorf("Search should see runtime models, got %v", got)
+	}
+
+	if err := Register(m); !errors.Is(err, ErrDuplicateModel) {
+		t.Errorf("Expected ErrDuplicateModel, got %v", err)
+	}
+	if err := Register(&modelData{IDVal: "openai/gpt-4"}); !errors.Is(err, ErrDuplicateModel) {
+		t.Errorf("Register must not replace built-in models, got %v", err)
+	}
+	if err := Register(nil); err == nil {
+		t.Error("Expected an error for a nil model")
+	}
+
+	if !Unregister(m.ID()) {
+		t.Error("Unregister should report the removed model")
+	}
+	if _, ok := Get("acme-ft"); ok {
+		t.Error("Alias should be gone after Unregister")
+	}
+}
+
+func TestRegister_CanonicalAndHuggingFace(t *testing.T) {
+	base := FromSpec(ModelSpec{ID: "acme/widget-9b", CanonicalSlug: "acme/widget-9b-20260101", HuggingFaceID: "AcmeResearch/Widget-9B"})
+	free := FromSpec(ModelSpec{ID: "acme/widget-9b:free", CanonicalSlug: "acme/widget-9b-20260101", HuggingFaceID: "AcmeResearch/Widget-9B"})
+	for _, m := range []Model{free, base} {
+		if err := Register(m); err != nil {
+			t.Fatal(err)
+		}
+		defer Unregister(m.ID())
+	}
+
+	// The base model owns the shared keys whatever the registration order
+	for _, got := range []func() (Model, bool){
+		func() (Model, bool) { return Get("ACME/Widget-9B-20260101") },
+		func() (Model, bool) { return Get("acmeresearch/widget-9B") },
+		func() (Model, bool) { return GetByCanonicalSlug("acme/widget-9b-20260101") },
+		func() (Model, bool) { return GetByHuggingFaceID("acmeresearch/widget-9b") },
+	} {
+		if m, ok := got(); !ok || m != base {
+			t.Errorf("Expected the base model, got %v", m)
+		}
+	}
+	if r, err := Resolve("AcmeResearch/Widget-9B"); err != nil || r.Model != base || r.Kind != ResolvedHuggingFace {
+		t.Errorf("Unexpected resolution %+v, %v", r, err)
+	}
+	if r, err := Resolve("acme/widget-9b-20260101"); err != nil || r.Model != base || r.Kind != ResolvedCanonical {
+		t.Errorf("Unexpected resolution %+v, %v", r, err)
+	}
+
+	// Removing the base hands the keys to the variant
+	Unregister(base.ID())
+	if m, ok := GetByHuggingFaceID("AcmeResearch/Widget-9B"); !ok || m != free {
+		t.Errorf("Expected the variant, got %v", m)
+	}
+	Unregister(free.ID())
+	if _, ok := GetByCanonicalSlug("acme/widget-9b-20260101"); ok {
+		t.Error("Canonical slug should be gone after Unregister")
+	}
+}
+
+func TestOverride(t *testing.T) {
+	const id = "openai/gpt-4"
+	builtin, _ := Get(id)
+	before := Total()
+
+	custom := &modelData{IDVal: id, NameVal: "GPT-4 (internal gateway)", ProviderVal: "OpenAI", ContextLenVal: 32768}
+	if err := Override(custom); err != nil {
+		t.Fatalf("Override failed: %v", err)
+	}
+	if m, _ := Get(id); m.ContextLength() != 32768 {
+		t.Errorf("Expected overridden context length, got %d", m.ContextLength())
+	}
+	if Total() != before {
+		t.Errorf("Override should not change Total, got %d", Total())
+	}
+	n := 0
+	for _, m := range Query().Provider("OpenAI").List() {
+		if m.ID() == id {
+			n++
+			if m.Name() != custom.Name() {
+				t.Errorf("Query returned the hidden built-in model")
+			}
+		}
+	}
+	if n != 1 {
+		t.Errorf("Expected %s once in query results, got %d", id, n)
+	}
+
+	Unregister(id)
+	if m, _ := Get(id); m != builtin {
+		t.Error("Unregister should restore the built-in model")
+	}
+}
+
+func TestRegister_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := fmt.Sprintf("acme/concurrent-%d", i)
+			if err := Register(&modelData{IDVal: id, AliasList: []string{id + "-alias"}}); err != nil {
+				t.Error(err)
+			}
+			Get(id + "-alias")
+			Query().Provider("Acme").List()
+			Unregister(id)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestWhere_ReentrantDuringRegister(t *testing.T) {
+	stop := make(chan struct{})
+	writer := make(chan struct{})
+	go func() {
+		defer close(writer)
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			id := fmt.Sprintf("acme/reentrant-%d", i%4)
+			Register(&modelData{IDVal: id})
+			Unregister(id)
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 200 {
+			Query().Provider("OpenAI").Where(func(Model) bool {
+				_, ok := Get("gpt4o")
+				return ok
+			}).List()
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Where predicate calling Get deadlocked against Register")
+	}
+	close(stop)
+	<-writer
+}
+
+// taggedModel is a Model whose dynamic value is not hashable.
+type taggedModel struct {
+	*modelData
+	tags []string
+}
+
+func TestRegister_UnhashableModel(t *testing.T) {
+	m := taggedModel{&modelData{IDVal: "acme/tagged", NameVal: "Acme Tagged"}, []string{"internal"}}
+	if err := Register(m); err != nil {
+		t.Fatal(err)
+	}
+	if got := Search("acme tagged", 1); len(got) != 1 || got[0].ID() != m.ID() {
+		t.Errorf("Search should find the runtime model, got %v", got)
+	}
+	if err := Override(m); err != nil {
+		t.Fatal(err)
+	}
+	if !Unregister(m.ID()) {
+		t.Error("Unregister should report the removed model")
+	}
+}
@@ -0,0 +1,116 @@
+package llmspecs
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// ParamRange is the accepted closed interval of a numeric request parameter.
+type ParamRange struct {
+	Min float64 `json:"min" yaml:"min"`
+	Max float64 `json:"max" yaml:"max"`
+}
+
+// Clamp returns v limited to the range.
+func (r ParamRange) Clamp(v float64) float64 {
+	return math.Min(math.Max(v, r.Min), r.Max)
+}
+
+// standardRanges are the bounds OpenRouter documents for its unified request
+// parameters. They apply when a model does not declare a narrower range.
+var standardRanges = map[string]ParamRange{
+	"temperature":        {Min: 0, Max: 2},
+	"top_p":              {Min: 0, Max: 1},
+	"top_a":              {Min: 0, Max: 1},
+	"min_p":              {Min: 0, Max: 1},
+	"frequency_penalty":  {Min: -2, Max: 2},
+	"presence_penalty":   {Min: -2, Max: 2},
+	"repetition_penalty": {Min: 0, Max: 2},
+	"top_logprobs":       {Min: 0, Max: 20},
+}
+
+// ApplyDefaults returns a copy of params with the model's default sampling
+// parameters filled in for keys the caller did not set. A nil m has no
+// defaults.
+func ApplyDefaults(m Model, params map[string]any) map[string]any {
+	var defaults map[string]float64
+	if m != nil {
+		defaults = m.DefaultParameters()
+	}
+	out := make(map[string]any, len(params)+len(defaults))
+	for k, v := range params {
+		out[k] = v
+	}
+	for k, v := range defaults {
+		if _, ok := out[k]; !ok {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// Clamp returns a copy of params with numeric values limited to the ranges
+// the model accepts. Numbers of any built-in integer or float type and
+// json.Number are clamped and keep their original Go type. Other values and
+// parameters without a known range are copied unchanged, and so is every
+// value if m is nil.
+func Clamp(m Model, params map[string]any) map[string]any {
+	out := make(map[string]any, len(params))
+	for k, v := range params {
+		out[k] = v
+		if m == nil {
+			continue
+		}
+		if r, ok := m.ParameterRange(k); ok {
+			out[k] = clampValue(r, v)
+		}
+	}
+	return out
+}
+
+// clampValue limits v to r if it is a number, keeping its type.
+func clampValue(r ParamRange, v any) any {
+	switch n := v.(type) {
+	case float64:
+		return r.Clamp(n)
+	case float32:
+		return clampNumber(r, n)
+	case int:
+		return clampNumber(r, n)
+	case int8:
+		return clampNumber(r, n)
+	case int16:
+		return clampNumber(r, n)
+	case int32:
+		return clampNumber(r, n)
+	case int64:
+		return clampNumber(r, n)
+	case uint:
+		return clampNumber(r, n)
+	case uint8:
+		return clampNumber(r, n)
+	case uint16:
+		return clampNumber(r, n)
+	case uint32:
+		return clampNumber(r, n)
+	case uint64:
+		return clampNumber(r, n)
+	case json.Number:
+		f, err := n.Float64()
+		if err != nil || r.Clamp(f) == f {
+			return n
+		}
+		return json.Number(strconv.FormatFloat(r.Clamp(f), 'f', -1, 64))
+	}
+	return v
+}
+
+// number lists the types clampValue converts through float64.
+type number interface {
+	int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32
+}
+
+func clampNumber[T number](r ParamRange, n T) T {
+	return T(r.Clamp(float64(n)))
+}
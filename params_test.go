@@ -0,0 +1,98 @@
+package llmspecs
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	m := &modelData{
+		IDVal:         "test/defaults",
+		DefaultParams: map[string]float64{"temperature": 0.6, "top_p": 0.95},
+	}
+
+	in := map[string]any{"temperature": 0.2, "max_tokens": 100}
+	got := ApplyDefaults(m, in)
+	want := map[string]any{"temperature": 0.2, "top_p": 0.95, "max_tokens": 100}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ApplyDefaults() = %v, want %v", got, want)
+	}
+	if _, ok := in["top_p"]; ok {
+		t.Error("ApplyDefaults() must not modify its input")
+	}
+}
+
+func TestClamp(t *testing.T) {
+	m := &modelData{
+		IDVal:       "test/clamp",
+		ParamRanges: map[string]ParamRange{"temperature": {Min: 0, Max: 1}},
+	}
+
+	got := Clamp(m, map[string]any{
+		"temperature":       1.5,         // model range
+		"top_p":             float32(-1), // standard range, keeps its type
+		"frequency_penalty": 5,           // standard range on an int
+		"seed":              42,          // no range
+		"stop":              "END",       // not numeric
+	})
+	want := map[string]any{
+		"temperature":       1.0,
+		"top_p":             float32(0),
+		"frequency_penalty": 2,
+		"seed":              42,
+		"stop":              "END",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Clamp() = %v, want %v", got, want)
+	}
+
+	// Every numeric kind is clamped and keeps its type
+	got = Clamp(m, map[string]any{
+		"temperature":        json.Number("1.5"),
+		"top_p":              json.Number("0.5"),
+		"top_logprobs":       uint(50),
+		"frequency_penalty":  int32(-7),
+		"presence_penalty":   int8(3),
+		"repetition_penalty": uint64(9),
+	})
+	want = map[string]any{
+		"temperature":        json.Number("1"),
+		"top_p":              json.Number("0.5"),
+		"top_logprobs":       uint(20),
+		"frequency_penalty":  int32(-2),
+		"presence_penalty":   int8(2),
+		"repetition_penalty": uint64(2),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Clamp() = %v, want %v", got, want)
+	}
+}
+
+func TestParams_NilModel(t *testing.T) {
+	in := map[string]any{"temperature": 9.0}
+	if got := ApplyDefaults(nil, in); !reflect.DeepEqual(got, in) {
+		t.Errorf("ApplyDefaults(nil) = %v, want a copy", got)
+	}
+	if got := Clamp(nil, in); !reflect.DeepEqual(got, in) {
+		t.Errorf("Clamp(nil) = %v, want a copy", got)
+	}
+}
+
+func TestGet_DefaultParameters(t *testing.T) {
+	m, ok := Get("arcee-ai/trinity-large-preview:free")
+	if !ok {
+		t.Fatal("arcee-ai/trinity-large-preview:free not found")
+	}
+	if got := m.DefaultParameters()["temperature"]; got != 0.8 {
+		t.Errorf("Expected default temperature 0.8, got %v", got)
+	}
+
+	claude, ok := Get("anthropic/claude-sonnet-4.5")
+	if !ok {
+		t.Fatal("anthropic/claude-sonnet-4.5 not found")
+	}
+	if r, ok := claude.ParameterRange("temperature"); !ok || r.Max != 1 {
+		t.Errorf("Expected Anthropic temperature range [0, 1], got %+v", r)
+	}
+}
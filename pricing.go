@@ -0,0 +1,109 @@
+package llmspecs
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// Price is a USD amount kept as the exact decimal string reported upstream,
+// e.g. "0.0000025" for $2.50 per million tokens.
+// An empty Price means no price is known. A negative Price (OpenRouter
+// reports "-1" for routers) means the price is decided per request.
+type Price string
+
+// Float64 returns the price as a float64. Unknown or malformed prices return 0.
+func (p Price) Float64() float64 {
+	f, err := strconv.ParseFloat(string(p), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// PerMillion returns the price of one million units, the scale most
+// provider price pages use for token prices.
+func (p Price) PerMillion() float64 {
+	return p.Float64() * 1e6
+}
+
+// IsZero reports whether the price is unknown or zero. Use Free to tell a
+// free item from one without a price.
+func (p Price) IsZero() bool {
+	return p.Float64() == 0
+}
+
+// Free reports whether the price is exactly zero. ok is false if the price
+// is unknown or malformed, in which case free is false too.
+func (p Price) Free() (free, ok bool) {
+	r, ok := p.Rat()
+	if !ok {
+		return false, false
+	}
+	return r.Sign() == 0, true
+}
+
+// IsVariable reports whether the price is decided per request.
+func (p Price) IsVariable() bool {
+	return p.Float64() < 0
+}
+
+// Rat returns the exact value of p. It reports false if p is empty or malformed.
+func (p Price) Rat() (*big.Rat, bool) {
+	if p == "" {
+		return nil, false
+	}
+	return new(big.Rat).SetString(string(p))
+}
+
+// Add returns the exact sum of p and q. Empty or malformed operands count as zero.
+func (p Price) Add(q Price) Price {
+	sum := new(big.Rat)
+	if r, ok := p.Rat(); ok {
+		sum.Add(sum, r)
+	}
+	if r, ok := q.Rat(); ok {
+		sum.Add(sum, r)
+	}
+	return priceFromRat(sum)
+}
+
+// priceFromRat formats r as a decimal string without losing digits.
+// Prices are decimal, so the denominator only has factors 2 and 5 and the
+// expansion terminates after max(twos, fives) digits.
+func priceFromRat(r *big.Rat) Price {
+	d := new(big.Int).Set(r.Denom())
+	twos, fives := 0, 0
+	two, five := big.NewInt(2), big.NewInt(5)
+	mod := new(big.Int)
+	for {
+		if q, m := new(big.Int).DivMod(d, two, mod); m.Sign() == 0 {
+			d, twos = q, twos+1
+			continue
+		}
+		if q, m := new(big.Int).DivMod(d, five, mod); m.Sign() == 0 {
+			d, fives = q, fives+1
+			continue
+		}
+		break
+	}
+	digits := max(twos, fives)
+	if d.Cmp(big.NewInt(1)) != 0 {
+		// Not a terminating decimal; only reachable with hand-built Rats.
+		digits = 30
+	}
+	return Price(r.FloatString(digits))
+}
+
+// Pricing lists the USD prices of a model.
+// Token prices are per token; Image, Request and WebSearch are per unit.
+type Pricing struct {
+	Prompt            Price `json:"prompt,omitempty" yaml:"prompt,omitempty"`                         // per input token
+	Completion        Price `json:"completion,omitempty" yaml:"completion,omitempty"`                 // per output token
+	InputCacheRead    Price `json:"input_cache_read,omitempty" yaml:"input_cache_read,omitempty"`     // per input token served from cache
+	InputCacheWrite   Price `json:"input_cache_write,omitempty" yaml:"input_cache_write,omitempty"`   // per input token written to cache
+	InternalReasoning Price `json:"internal_reasoning,omitempty" yaml:"internal_reasoning,omitempty"` // per reasoning token
+	Image             Price `json:"image,omitempty" yaml:"image,omitempty"`                           // per input image
+	Audio             Price `json:"audio,omitempty" yaml:"audio,omitempty"`                           // per input audio token
+	Request           Price `json:"request,omitempty" yaml:"request,omitempty"`                       // per request
+	WebSearch         Price `json:"web_search,omitempty" yaml:"web_search,omitempty"`                 // per web search
+}
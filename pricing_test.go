@@ -0,0 +1,52 @@
+package llmspecs
+
+import "testing"
+
+func TestPrice(t *testing.T) {
+	tests := []struct {
+		name         string
+		price        Price
+		wantMillion  float64
+		wantZero     bool
+		wantVariable bool
+		wantFree     bool
+		wantKnown    bool
+	}{
+		{"未知价格", "", 0, true, false, false, false},
+		{"免费", "0", 0, true, false, true, true},
+		{"按 token 计价", "0.0000025", 2.5, false, false, false, true},
+		{"按请求决定", "-1", -1e6, false, true, false, true},
+		{"格式错误", "n/a", 0, true, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.price.PerMillion(); got != tt.wantMillion {
+				t.Errorf("PerMillion() = %v, want %v", got, tt.wantMillion)
+			}
+			if got := tt.price.IsZero(); got != tt.wantZero {
+				t.Errorf("IsZero() = %v, want %v", got, tt.wantZero)
+			}
+			if got := tt.price.IsVariable(); got != tt.wantVariable {
+				t.Errorf("IsVariable() = %v, want %v", got, tt.wantVariable)
+			}
+			if free, ok := tt.price.Free(); free != tt.wantFree || ok != tt.wantKnown {
+				t.Errorf("Free() = %v, %v, want %v, %v", free, ok, tt.wantFree, tt.wantKnown)
+			}
+		})
+	}
+}
+
+func TestGet_Pricing(t *testing.T) {
+	m, ok := Get("openai/gpt-4o")
+	if !ok {
+		t.Fatal("openai/gpt-4o not found")
+	}
+	p := m.Pricing()
+	if p.Prompt.IsZero() || p.Completion.IsZero() {
+		t.Errorf("Expected prompt and completion prices, got %+v", p)
+	}
+	if p.InputCacheRead.IsZero() {
+		t.Errorf("Expected cache read price, got %+v", p)
+	}
+}
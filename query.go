@@ -0,0 +1,344 @@
+package llmspecs
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// QueryError reports a syntax error in a textual query.
+type QueryError struct {
+	Query string
+	Pos   int // byte offset of the offending term or value
+	Msg   string
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("llmspecs: query at offset %d: %s", e.Pos, e.Msg)
+}
+
+// ParseQuery compiles a textual query into a QueryBuilder. A query is a
+// whitespace-separated list of terms, all of which must match:
+//
+//	provider:anthropic,openai    any of the providers (!provider: excludes them)
+//	has:ImageIn,FunctionCall     all of the capabilities (!has: excludes any of them)
+//	any:ImageIn,VideoIn          at least one of the capabilities
+//	id:openai/gpt-4o*            ID glob (name: matches the display name)
+//	tokenizer:Llama3             tokenizer family (instruct: for the chat template)
+//	variant:free                 OpenRouter variant (!variant excludes all variants)
+//	!status:deprecated,retired   exclude lifecycle stages
+//	param:seed,top_k             supported request parameters
+//	ctx>=100k  ctx<=1m           context window; k and m scale by 10^3 and 10^6
+//	                             (an upper bound must be positive)
+//	out>=8k                      output token limit
+//	price.in<=1.0                prompt price ceiling in USD per million tokens
+//	price.out<=4                 completion price ceiling
+//	released>=2025-01-01         release date bounds (released< for an upper bound)
+//	sort:-ctx,price.in           ordering; "-" sorts descending
+//	limit:5  offset:10           pagination
+//
+// Values containing spaces can be double-quoted, e.g. name:"GPT-4o*".
+// Capability names use either spelling accepted in models/, "ImageIn" or
+// "ModalityImageIn". On bad syntax, ParseQuery returns a *QueryError.
+func ParseQuery(s string) (*QueryBuilder, error) {
+	q := Query()
+	p := queryParser{src: s}
+	for {
+		t, ok, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return q, nil
+		}
+		if err := p.apply(q, t); err != nil {
+			return nil, err
+		}
+	}
+}
+
+type queryParser struct {
+	src string
+	off int
+}
+
+func (p *queryParser) errorf(pos int, format string, args ...any) error {
+	return &QueryError{Query: p.src, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// token is a term with quotes removed. offs[i] is the offset in the query
+// of byte i of text, and offs[len(text)] the offset just past the term, so
+// errors point into the query even when the term was quoted.
+type token struct {
+	text string
+	offs []int
+}
+
+// at returns the query offset of byte i of the term.
+func (t token) at(i int) int {
+	return t.offs[min(i, len(t.text))]
+}
+
+// next returns the next whitespace-separated term.
+func (p *queryParser) next() (t token, ok bool, err error) {
+	for p.off < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.off:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		p.off += size
+	}
+	if p.off == len(p.src) {
+		return token{}, false, nil
+	}
+	var b strings.Builder
+	inQuote := false
+	quoteAt := 0
+	for p.off < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.off:])
+		if unicode.IsSpace(r) && !inQuote {
+			break
+		}
+		if r == '"' {
+			inQuote = !inQuote
+			quoteAt = p.off
+		} else {
+			b.WriteString(p.src[p.off : p.off+size])
+			for i := range size {
+				t.offs = append(t.offs, p.off+i)
+			}
+		}
+		p.off += size
+	}
+	if inQuote {
+		return token{}, false, p.errorf(quoteAt, "unterminated quote")
+	}
+	t.text = b.String()
+	t.offs = append(t.offs, p.off)
+	return t, true, nil
+}
+
+// comparisons lists the operators of numeric terms, longest first.
+var comparisons = []string{">=", "<=", ">", "<"}
+
+// apply adds the term t to q. Positions passed on are byte indexes into
+// t.text, mapped back to the query by t.at.
+func (p *queryParser) apply(q *QueryBuilder, t token) error {
+	term, pos := t.text, 0
+	negate := strings.HasPrefix(term, "!")
+	if negate {
+		term, pos = term[1:], 1
+	}
+	if term == "variant" && negate {
+		q.ExcludeVariants()
+		return nil
+	}
+
+	if field, value, ok := strings.Cut(term, ":"); ok {
+		return p.applyField(q, t, field, value, negate, pos, pos+len(field)+1)
+	}
+	for i := 0; i < len(term); i++ {
+		for _, op := range comparisons {
+			if strings.HasPrefix(term[i:], op) {
+				if negate {
+					return p.errorf(t.at(0), "comparisons cannot be negated")
+				}
+				return p.applyComparison(q, t, term[:i], op, term[i+len(op):], pos, pos+i+len(op))
+			}
+		}
+	}
+	return p.errorf(t.at(pos), "expected field:value or a comparison, got %q", term)
+}
+
+func (p *queryParser) applyField(q *QueryBuilder, t token, field, value string, negate bool, pos, valPos int) error {
+	if value == "" {
+		return p.errorf(t.at(valPos), "missing value for %q", field)
+	}
+	field = strings.ToLower(field)
+	if negate && field != "provider" && field != "has" && field != "status" {
+		return p.errorf(t.at(0), "%s cannot be negated", field)
+	}
+	list := strings.Split(value, ",")
+	// itemAt returns the query offset of list[i].
+	itemAt := func(i int) int {
+		off := valPos
+		for _, item := range list[:i] {
+			off += len(item) + 1
+		}
+		return t.at(off)
+	}
+	switch field {
+	case "provider":
+		if negate {
+			q.ExcludeProviders(list...)
+		} else {
+			q.Providers(list...)
+		}
+	case "has", "any":
+		var caps Capability
+		for i, name := range list {
+			c, ok := lookupCapability(name)
+			if !ok {
+				return p.errorf(itemAt(i), "unknown capability %q", name)
+			}
+			caps |= c
+		}
+		switch {
+		case negate:
+			q.Without(caps)
+		case field == "any":
+			q.HasAny(caps)
+		default:
+			q.Has(caps)
+		}
+	case "id":
+		q.MatchID(value)
+	case "name":
+		q.MatchName(value)
+	case "tokenizer":
+		q.Tokenizer(value)
+	case "instruct":
+		q.InstructType(value)
+	case "variant":
+		q.Variant(value)
+	case "param":
+		q.SupportsParameter(list...)
+	case "status":
+		if !negate {
+			return p.errorf(t.at(pos), "status can only be excluded, use !status:")
+		}
+		for i, name := range list {
+			s, err := ParseStatus(name)
+			if err != nil {
+				return p.errorf(itemAt(i), "unknown status %q", name)
+			}
+			q.ExcludeStatus(s)
+		}
+	case "sort":
+		for i, key := range list {
+			desc := strings.HasPrefix(key, "-")
+			k, ok := sortKeys[strings.ToLower(strings.TrimPrefix(key, "-"))]
+			if !ok {
+				return p.errorf(itemAt(i), "unknown sort key %q", key)
+			}
+			if desc {
+				q.OrderByDesc(k)
+			} else {
+				q.OrderBy(k)
+			}
+		}
+	case "limit", "offset":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return p.errorf(t.at(valPos), "invalid %s %q", field, value)
+		}
+		if field == "limit" {
+			q.Limit(n)
+		} else {
+			q.Offset(n)
+		}
+	default:
+		return p.errorf(t.at(pos), "unknown field %q", field)
+	}
+	return nil
+}
+
+func (p *queryParser) applyComparison(q *QueryBuilder, t token, field, op, value string, pos, valPos int) error {
+	unsupported := func() error {
+		return p.errorf(t.at(pos+len(field)), "operator %s is not supported for %s", op, field)
+	}
+	field = strings.ToLower(field)
+	switch field {
+	case "ctx", "out":
+		n, ok := parseTokenCount(value)
+		if !ok {
+			return p.errorf(t.at(valPos), "invalid token count %q", value)
+		}
+		term := field + op + value
+		switch op {
+		case ">":
+			n, op = n+1, ">="
+		case "<":
+			n, op = n-1, "<="
+		}
+		// MaxContext treats a bound <= 0 as unset, which would match every
+		// model instead of none
+		if field == "ctx" && op == "<=" && n <= 0 {
+			return p.errorf(t.at(valPos), "%s matches no model, the bound must be positive", term)
+		}
+		switch {
+		case field == "ctx" && op == ">=":
+			q.MinContext(n)
+		case field == "ctx":
+			q.MaxContext(n)
+		case op == ">=":
+			q.MinOutput(n)
+		default:
+			return unsupported()
+		}
+	case "price.in", "price.out":
+		if op != "<=" {
+			return unsupported()
+		}
+		usd, err := strconv.ParseFloat(value, 64)
+		// ParseFloat accepts "inf" and "NaN", which the builder would take
+		// as no ceiling at all
+		if err != nil || !(usd >= 0) || math.IsInf(usd, 0) {
+			return p.errorf(t.at(valPos), "invalid price %q", value)
+		}
+		if field == "price.in" {
+			q.MaxInputPrice(usd)
+		} else {
+			q.MaxOutputPrice(usd)
+		}
+	case "released":
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return p.errorf(t.at(valPos), "invalid date %q, want YYYY-MM-DD", value)
+		}
+		switch op {
+		case ">=":
+			q.ReleasedAfter(date)
+		case "<":
+			q.ReleasedBefore(date)
+		default:
+			return unsupported()
+		}
+	default:
+		return p.errorf(t.at(pos), "unknown field %q", field)
+	}
+	return nil
+}
+
+// sortKeys maps the sort: names of the query language to SortKeys.
+var sortKeys = map[string]SortKey{
+	"id":        SortByID,
+	"name":      SortByName,
+	"provider":  SortByProvider,
+	"ctx":       SortByContext,
+	"out":       SortByMaxOutput,
+	"price.in":  SortByInputPrice,
+	"price.out": SortByOutputPrice,
+	"released":  SortByRelease,
+}
+
+// parseTokenCount parses counts such as "8192", "100k" or "1m". NaN,
+// infinities and counts beyond MaxInt32 are rejected rather than converted.
+func parseTokenCount(s string) (int, bool) {
+	scale := 1.0
+	switch {
+	case strings.HasSuffix(s, "k"), strings.HasSuffix(s, "K"):
+		scale, s = 1e3, s[:len(s)-1]
+	case strings.HasSuffix(s, "m"), strings.HasSuffix(s, "M"):
+		scale, s = 1e6, s[:len(s)-1]
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || !(f >= 0) || f*scale > math.MaxInt32 {
+		return 0, false
+	}
+	return int(f * scale), true
+}
@@ -0,0 +1,106 @@
+package llmspecs
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseQuery(t *testing.T) {
+	q, err := ParseQuery("provider:anthropic,openai has:ImageIn,FunctionCall !has:Embedding ctx>=100k price.in<=1.0 sort:-ctx limit:5")
+	if err != nil {
+		t.Fatalf("ParseQuery failed: %v", err)
+	}
+	results := q.List()
+	if len(results) == 0 || len(results) > 5 {
+		t.Fatalf("Expected 1-5 models, got %d", len(results))
+	}
+	for i, m := range results {
+		if m.Provider() != "Anthropic" && m.Provider() != "OpenAI" {
+			t.Errorf("Unexpected provider %s for %s", m.Provider(), m.ID())
+		}
+		if !m.HasCapability(ModalityImageIn) || !m.HasCapability(CapFunctionCall) || m.HasCapability(CapEmbedding) {
+			t.Errorf("Capabilities of %s do not match: %v", m.ID(), m.Features())
+		}
+		if m.ContextLength() < 100000 || m.Pricing().Prompt.PerMillion() > 1 {
+			t.Errorf("Model %s outside range", m.ID())
+		}
+		if i > 0 && results[i-1].ContextLength() < m.ContextLength() {
+			t.Errorf("Results not sorted by context descending")
+		}
+	}
+
+	// The textual form compiles to the same builder as the chained form
+	want := Query().Has(CapReasoning).ExcludeStatus(StatusDeprecated, StatusRetired).ExcludeVariants().
+		Tokenizer("Qwen3").OrderBy(SortByID).List()
+	q, err = ParseQuery(`has:CapReasoning !status:deprecated,retired !variant tokenizer:"Qwen3" sort:id`)
+	if err != nil {
+		t.Fatalf("ParseQuery failed: %v", err)
+	}
+	got := q.List()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d models, got %d", len(want), len(got))
+	}
+	for i := range got {
+		if got[i].ID() != want[i].ID() {
+			t.Errorf("got[%d] = %s, want %s", i, got[i].ID(), want[i].ID())
+		}
+	}
+
+	// Any whitespace separates terms
+	q, err = ParseQuery("provider:openai\t has:ImageIn\n\u00a0limit:3 ")
+	if err != nil {
+		t.Fatalf("ParseQuery failed: %v", err)
+	}
+	if n := len(q.List()); n != 3 {
+		t.Errorf("Expected 3 models, got %d", n)
+	}
+
+	if q, err := ParseQuery(""); err != nil || len(q.List()) != Total() {
+		t.Errorf("Empty query should match every model, err=%v", err)
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	tests := []struct {
+		query string
+		pos   int
+	}{
+		{"has:ImageIn color:red", 12},
+		{"has:ImageIn,Telepathy", 12},
+		{"ctx>=lots", 5},
+		{"price.in>=1", 8},
+		{"sort:ctx,-size", 9},
+		{"!tokenizer:GPT", 0},
+		{"status:preview", 0},
+		{`name:"GPT`, 5},
+		{"gpt-4o", 0},
+		{"released>=last-year", 10},
+		{"ctx<1", 4},
+		{"ctx<=0", 5},
+		{"out<8k", 3},
+		// Non-finite values would silently leave the bound unset
+		{"ctx>=inf", 5},
+		{"ctx<=+Inf", 5},
+		{"out>=NaN", 5},
+		{"price.in<=NaN", 10},
+		{"price.out<=inf", 11},
+		{"ctx>=1e30", 5},
+		// Offsets count the quotes and point at the offending item, not an
+		// earlier one containing it
+		{`has:"ImageIn,In"`, 13},
+		{`name:"a b" has:ImageIn,In`, 23},
+		{"!status:preview,view", 16},
+		{"limit:5\tcolor:red", 8},
+	}
+	for _, tt := range tests {
+		_, err := ParseQuery(tt.query)
+		var qe *QueryError
+		if !errors.As(err, &qe) {
+			t.Errorf("ParseQuery(%q): expected *QueryError, got %v", tt.query, err)
+			continue
+		}
+		if qe.Pos != tt.pos {
+			t.Errorf("ParseQuery(%q): error at %d, want %d (%v)", tt.query, qe.Pos, tt.pos, err)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package llmspecs
+
+// ReasoningConfig describes how the thinking of a reasoning model can be steered.
+// Zero values mean the limit is unknown.
+type ReasoningConfig struct {
+	// Efforts lists the accepted reasoning_effort levels, e.g. "low", "medium", "high".
+	// It is empty when the levels are unknown, even if the model accepts
+	// reasoning_effort; check SupportsParameter for that.
+	Efforts []string `json:"efforts,omitempty" yaml:"efforts,omitempty"`
+	// MinBudgetTokens and MaxBudgetTokens bound an explicit thinking budget.
+	MinBudgetTokens int `json:"min_budget_tokens,omitempty" yaml:"min_budget_tokens,omitempty"`
+	MaxBudgetTokens int `json:"max_budget_tokens,omitempty" yaml:"max_budget_tokens,omitempty"`
+	// CountsTowardOutput reports whether reasoning tokens are deducted from
+	// MaxOutput, leaving less room for the visible answer.
+	CountsTowardOutput bool `json:"counts_toward_output,omitempty" yaml:"counts_toward_output,omitempty"`
+}
+
+// SupportsEffort reports whether level is an accepted reasoning_effort value.
+func (r ReasoningConfig) SupportsEffort(level string) bool {
+	for _, e := range r.Efforts {
+		if e == level {
+			return true
+		}
+	}
+	return false
+}
+
+// ClampBudget limits a thinking budget to the model's bounds.
+func (r ReasoningConfig) ClampBudget(tokens int) int {
+	if r.MinBudgetTokens > 0 && tokens < r.MinBudgetTokens {
+		return r.MinBudgetTokens
+	}
+	if r.MaxBudgetTokens > 0 && tokens > r.MaxBudgetTokens {
+		return r.MaxBudgetTokens
+	}
+	return tokens
+}
@@ -0,0 +1,50 @@
+package llmspecs
+
+import "testing"
+
+func TestReasoningConfig(t *testing.T) {
+	r := ReasoningConfig{Efforts: []string{"low", "high"}, MinBudgetTokens: 1024, MaxBudgetTokens: 32000}
+
+	if !r.SupportsEffort("high") || r.SupportsEffort("medium") {
+		t.Errorf("SupportsEffort() mismatch for %v", r.Efforts)
+	}
+
+	tests := []struct{ in, want int }{
+		{100, 1024},
+		{4096, 4096},
+		{64000, 32000},
+	}
+	for _, tt := range tests {
+		if got := r.ClampBudget(tt.in); got != tt.want {
+			t.Errorf("ClampBudget(%d) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+
+	if got := (ReasoningConfig{}).ClampBudget(500); got != 500 {
+		t.Errorf("ClampBudget() without bounds = %d, want 500", got)
+	}
+}
+
+func TestQuery_Reasoning(t *testing.T) {
+	results := Query().Has(CapReasoning).List()
+	if len(results) == 0 {
+		t.Fatal("Expected reasoning models")
+	}
+
+	found := false
+	for _, m := range results {
+		if m.ID() == "anthropic/claude-3.7-sonnet:thinking" {
+			found = true
+			if !m.Reasoning().CountsTowardOutput || m.Reasoning().MinBudgetTokens == 0 {
+				t.Errorf("Expected thinking budget metadata, got %+v", m.Reasoning())
+			}
+		}
+	}
+	if !found {
+		t.Error("anthropic/claude-3.7-sonnet:thinking should be a reasoning model")
+	}
+
+	if m, ok := Get("openai/gpt-oss-20b"); !ok || !m.Reasoning().SupportsEffort("medium") {
+		t.Error("openai/gpt-oss-20b should accept reasoning_effort")
+	}
+}
@@ -0,0 +1,207 @@
+package llmspecs
+
+import (
+	"math"
+	"slices"
+	"sort"
+)
+
+// Requirements describes what a model must offer and what to prefer among
+// the models that do. Hard constraints filter candidates out; Weights rank
+// the rest.
+type Requirements struct {
+	Capabilities Capability // all of these are required
+	MinContext   int        // minimum context window in tokens
+	MinOutput    int        // minimum output token limit
+	// MaxInputPrice and MaxOutputPrice are price ceilings passed to
+	// QueryBuilder.MaxInputPrice and MaxOutputPrice, so 0 admits only free
+	// models. nil means no ceiling.
+	MaxInputPrice  *float64
+	MaxOutputPrice *float64
+	Providers      []string // allowed providers; empty allows all
+	// Statuses lists the allowed lifecycle stages. Empty allows active and
+	// preview models, excluding deprecated and retired ones.
+	Statuses []Status
+	// Filter adds further hard constraints, e.g. Query().ExcludeVariants().
+	// Only its filters apply, not its ordering or limits. It may be nil.
+	Filter *QueryBuilder
+
+	Weights Criteria
+	Limit   int // maximum number of results; 0 returns all
+}
+
+// Criteria holds one value per ranking criterion: the weights of
+// Requirements, or the scores of a Recommendation.
+type Criteria struct {
+	Cost    float64 // cheaper is better
+	Context float64 // a larger context window is better
+	Recency float64 // a later release is better
+	Output  float64 // a larger output token limit is better
+}
+
+// Recommendation is a model returned by Recommend with its ranking.
+type Recommendation struct {
+	Model Model
+	// Score is the weighted mean of Scores, between 0 and 1.
+	Score float64
+	// Scores rates the model on each criterion between 0 (worst among the
+	// candidates, or unknown) and 1 (best), on a log scale for prices and
+	// token counts and a linear one for release dates.
+	Scores Criteria
+}
+
+// Recommend ranks the models meeting the hard constraints of r by its soft
+// weights, best first. A zero weight ignores a criterion; if all weights are
+// zero, every criterion counts equally. Recommend returns nil if a weight is
+// negative, infinite or NaN. Ties are broken by ID, so the same requirements
+// against the same registry always give the same answer.
+//
+// Cost is the mean of the prompt and completion prices per million tokens.
+// Scores are relative to the candidates, so adding a constraint can change
+// the scores of the models that remain.
+func Recommend(r Requirements) []Recommendation {
+	w := r.Weights
+	for _, v := range []float64{w.Cost, w.Context, w.Recency, w.Output} {
+		if v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil
+		}
+	}
+	if w == (Criteria{}) {
+		w = Criteria{Cost: 1, Context: 1, Recency: 1, Output: 1}
+	}
+	total := w.Cost + w.Context + w.Recency + w.Output
+
+	models := r.query().List()
+	if r.Filter != nil {
+		kept := models[:0]
+		for _, m := range models {
+			if r.Filter.match(m) {
+				kept = append(kept, m)
+			}
+		}
+		models = kept
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	cost := newCriterion(models, blendedPrice, true)
+	contextLen := newCriterion(models, func(m Model) (float64, bool) {
+		return logCount(m.ContextLength())
+	}, false)
+	recency := newCriterion(models, func(m Model) (float64, bool) {
+		t := m.ReleasedAt()
+		return float64(t.Unix()), !t.IsZero()
+	}, false)
+	output := newCriterion(models, func(m Model) (float64, bool) {
+		return logCount(m.MaxOutput())
+	}, false)
+
+	results := make([]Recommendation, len(models))
+	for i, m := range models {
+		s := Criteria{
+			Cost:    cost.score(i),
+			Context: contextLen.score(i),
+			Recency: recency.score(i),
+			Output:  output.score(i),
+		}
+		results[i] = Recommendation{
+			Model:  m,
+			Scores: s,
+			Score:  (w.Cost*s.Cost + w.Context*s.Context + w.Recency*s.Recency + w.Output*s.Output) / total,
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if r.Limit > 0 && len(results) > r.Limit {
+		results = results[:r.Limit]
+	}
+	return results
+}
+
+// query compiles the hard constraints of r.
+func (r Requirements) query() *QueryBuilder {
+	q := Query().Has(r.Capabilities).Providers(r.Providers...)
+	if r.MinContext > 0 {
+		q.MinContext(r.MinContext)
+	}
+	if r.MinOutput > 0 {
+		q.MinOutput(r.MinOutput)
+	}
+	if r.MaxInputPrice != nil {
+		q.MaxInputPrice(*r.MaxInputPrice)
+	}
+	if r.MaxOutputPrice != nil {
+		q.MaxOutputPrice(*r.MaxOutputPrice)
+	}
+	allowed := r.Statuses
+	if len(allowed) == 0 {
+		allowed = []Status{StatusActive, StatusPreview}
+	}
+	for s := range Status(len(statusNames)) {
+		if !slices.Contains(allowed, s) {
+			q.ExcludeStatus(s)
+		}
+	}
+	return q
+}
+
+// criterion normalizes one measure of the candidates to [0, 1].
+type criterion struct {
+	values      []float64
+	known       []bool
+	lo, hi      float64
+	lowerBetter bool
+}
+
+func newCriterion(models []Model, measure func(Model) (float64, bool), lowerBetter bool) *criterion {
+	c := &criterion{
+		values:      make([]float64, len(models)),
+		known:       make([]bool, len(models)),
+		lo:          math.Inf(1),
+		hi:          math.Inf(-1),
+		lowerBetter: lowerBetter,
+	}
+	for i, m := range models {
+		v, ok := measure(m)
+		c.values[i], c.known[i] = v, ok
+		if ok {
+			c.lo, c.hi = min(c.lo, v), max(c.hi, v)
+		}
+	}
+	return c
+}
+
+func (c *criterion) score(i int) float64 {
+	if !c.known[i] {
+		return 0
+	}
+	if c.hi == c.lo {
+		return 1
+	}
+	s := (c.values[i] - c.lo) / (c.hi - c.lo)
+	if c.lowerBetter {
+		s = 1 - s
+	}
+	return s
+}
+
+// blendedPrice is the log of the mean prompt and completion price per
+// million tokens. Models without a fixed prompt price are unknown.
+func blendedPrice(m Model) (float64, bool) {
+	p := m.Pricing()
+	if p.Prompt == "" || p.Prompt.IsVariable() || p.Completion.IsVariable() {
+		return 0, false
+	}
+	mean := (p.Prompt.PerMillion() + p.Completion.PerMillion()) / 2
+	return math.Log1p(mean), true
+}
+
+// logCount is the log of a token count; zero counts are unknown.
+func logCount(n int) (float64, bool) {
+	if n <= 0 {
+		return 0, false
+	}
+	return math.Log(float64(n)), true
+}
@@ -0,0 +1,117 @@
+package llmspecs
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestRecommend(t *testing.T) {
+	maxPrice := 5.0
+	req := Requirements{
+		Capabilities:  CapFunctionCall | ModalityImageIn,
+		MinContext:    100000,
+		MaxInputPrice: &maxPrice,
+		Providers:     []string{"OpenAI", "Anthropic", "Google"},
+		Filter:        Query().ExcludeVariants(),
+		Weights:       Criteria{Cost: 3, Context: 1},
+		Limit:         5,
+	}
+	results := Recommend(req)
+	if len(results) == 0 || len(results) > 5 {
+		t.Fatalf("Expected 1-5 recommendations, got %d", len(results))
+	}
+	for i, r := range results {
+		m := r.Model
+		if !m.HasCapability(CapFunctionCall|ModalityImageIn) || m.ContextLength() < 100000 ||
+			m.Pricing().Prompt.PerMillion() > 5 || m.Variant() != "" {
+			t.Errorf("%s violates a hard constraint", m.ID())
+		}
+		if m.Status() == StatusDeprecated || m.Status() == StatusRetired {
+			t.Errorf("%s is %v", m.ID(), m.Status())
+		}
+		want := (3*r.Scores.Cost + r.Scores.Context) / 4
+		if diff := r.Score - want; diff > 1e-9 || diff < -1e-9 {
+			t.Errorf("%s: score %v is not the weighted mean %v", m.ID(), r.Score, want)
+		}
+		if i > 0 && results[i-1].Score < r.Score {
+			t.Error("Recommendations not ranked by score")
+		}
+	}
+
+	if again := Recommend(req); !reflect.DeepEqual(modelIDs(recommended(again)), modelIDs(recommended(results))) {
+		t.Error("Recommend is not deterministic")
+	}
+}
+
+func TestRecommend_Criteria(t *testing.T) {
+	// Cheapest wins on cost alone, largest window on context alone
+	base := Requirements{Capabilities: CapChat, Providers: []string{"OpenAI"}, Filter: Query().ExcludeVariants()}
+
+	cheap := base
+	cheap.Weights = Criteria{Cost: 1}
+	top := Recommend(cheap)[0]
+	for _, r := range Recommend(cheap) {
+		if r.Scores.Cost > top.Scores.Cost {
+			t.Errorf("%s is cheaper than the top result %s", r.Model.ID(), top.Model.ID())
+		}
+	}
+	if top.Scores.Cost != 1 {
+		t.Errorf("The cheapest model should score 1, got %v", top.Scores.Cost)
+	}
+
+	wide := base
+	wide.Weights = Criteria{Context: 1}
+	top = Recommend(wide)[0]
+	if m, _ := Query().Provider("OpenAI").Has(CapChat).ExcludeVariants().OrderByDesc(SortByContext).First(); top.Model.ContextLength() != m.ContextLength() {
+		t.Errorf("Expected a %d-token window first, got %s", m.ContextLength(), top.Model.ID())
+	}
+
+	if got := Recommend(Requirements{Providers: []string{"Nobody"}}); got != nil {
+		t.Errorf("Expected no recommendations, got %v", got)
+	}
+	retired := Recommend(Requirements{Statuses: []Status{StatusRetired}})
+	for _, r := range retired {
+		if r.Model.Status() != StatusRetired {
+			t.Errorf("%s is not retired", r.Model.ID())
+		}
+	}
+}
+
+func TestRecommend_Invalid(t *testing.T) {
+	for _, w := range []Criteria{
+		{Cost: -1, Context: 1},
+		{Cost: 1, Context: -1},
+		{Recency: math.Inf(1)},
+		{Output: math.NaN()},
+	} {
+		if got := Recommend(Requirements{Weights: w}); got != nil {
+			t.Errorf("Weights %+v: expected nil, got %d recommendations", w, len(got))
+		}
+	}
+}
+
+func TestRecommend_FreeOnly(t *testing.T) {
+	free := 0.0
+	results := Recommend(Requirements{MaxInputPrice: &free, MaxOutputPrice: &free})
+	if len(results) == 0 {
+		t.Fatal("Expected free models")
+	}
+	for _, r := range results {
+		p := r.Model.Pricing()
+		if p.Prompt.PerMillion() != 0 || p.Completion.PerMillion() != 0 {
+			t.Errorf("%s is not free: %+v", r.Model.ID(), p)
+		}
+	}
+	if all := Recommend(Requirements{}); len(all) <= len(results) {
+		t.Errorf("No ceiling should not limit prices: %d vs %d free", len(all), len(results))
+	}
+}
+
+func recommended(results []Recommendation) []Model {
+	models := make([]Model, len(results))
+	for i, r := range results {
+		models[i] = r.Model
+	}
+	return models
+}
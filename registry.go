@@ -1,8 +1,15 @@
 package llmspecs
 
 import (
+	"math"
+	"math/big"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/kingfs/go-llm-specs/internal/modelid"
 )
 
 // staticRegistry stores all static model data.
@@ -13,30 +20,224 @@ var staticRegistry = map[string]*modelData{}
 // This will be populated in models_gen.go.
 var aliasIndex = map[string]string{}
 
-// Total number of models in the registry.
+// huggingFaceIndex maps lowercase Hugging Face repository IDs to model IDs.
+// This will be populated in models_gen.go.
+var huggingFaceIndex = map[string]string{}
+
+// canonicalIndex maps lowercase canonical slugs to model IDs.
+// This will be populated in models_gen.go.
+var canonicalIndex = map[string]string{}
+
+// normalizedIndex maps the strict modelid.Normalize keys of model IDs,
+// aliases and canonical slugs to the IDs of the models having them, and
+// looseIndex their looser keys. A key with several IDs is ambiguous.
+// These will be populated in models_gen.go.
+var (
+	normalizedIndex = map[string][]string{}
+	looseIndex      = map[string][]string{}
+)
+
+// Total number of models in the registry, including runtime models.
 func Total() int {
-	return len(staticRegistry)
+	n := len(staticRegistry)
+	for id := range currentOverlay().models {
+		if _, ok := staticRegistry[id]; !ok {
+			n++
+		}
+	}
+	return n
 }
 
 // Get retrieves a model by its ID or alias.
+//
+// Names are tried as an exact ID, then ignoring case as an ID, alias,
+// canonical slug and Hugging Face ID, and finally by the strict key of
+// modelid.Normalize, ignoring separators and punctuation, so
+// "claude-3-5-sonnet" and "GPT4o" are found too. Get never drops date
+// stamps or tags, which could turn a pinned snapshot into another model:
+// GetLoose opts into that, and Resolve does it and says so. A normalized
+// name matching several models finds nothing; Resolve reports the
+// candidates.
 func Get(name string) (Model, bool) {
 	// 1. Try exact ID
-	if m, ok := staticRegistry[name]; ok {
+	if m, ok := lookupID(name); ok {
 		return m, true
 	}
 
-	// 2. Try alias (normalized to lowercase for case-insensitive lookup)
-	if id, ok := aliasIndex[strings.ToLower(name)]; ok {
-		if m, ok := staticRegistry[id]; ok {
-			return m, true
-		}
+	// 2. Try ID and alias (normalized to lowercase for case-insensitive lookup)
+	lower := strings.ToLower(name)
+	if m, ok := lookupID(lower); ok {
+		return m, true
+	}
+	if m, ok := lookupAlias(lower); ok {
+		return m, true
+	}
+
+	// 3. Try canonical slug, then Hugging Face ID
+	if m, ok := lookupCanonical(lower); ok {
+		return m, true
+	}
+	if m, ok := lookupHuggingFace(lower); ok {
+		return m, true
 	}
 
+	// 4. Try the normalized name
+	return lookupStrict(name)
+}
+
+// GetLoose is like Get, but if nothing matches, it also drops date stamps
+// and "-instruct"-style tags as Resolve does, so "Qwen3-32B-Instruct" finds
+// "qwen/qwen3-32b" and "claude-3-5-sonnet-20241022" finds
+// "anthropic/claude-3.5-sonnet". The model found may be another snapshot
+// than the one the name pins.
+func GetLoose(name string) (Model, bool) {
+	if m, ok := Get(name); ok {
+		return m, true
+	}
+	if ms, _ := lookupNormalized(name, true); len(ms) == 1 {
+		return ms[0], true
+	}
+	return nil, false
+}
+
+// GetByHuggingFaceID retrieves the model serving the given Hugging Face
+// repository, e.g. "Qwen/Qwen3-32B". Matching is case-insensitive.
+// When several variants share the repository, the base model is returned.
+func GetByHuggingFaceID(hfID string) (Model, bool) {
+	return lookupHuggingFace(strings.ToLower(hfID))
+}
+
+// GetByCanonicalSlug retrieves a model by its canonical slug,
+// e.g. "openai/gpt-5.2-codex-20260114". Matching is case-insensitive.
+func GetByCanonicalSlug(slug string) (Model, bool) {
+	if m, ok := lookupCanonical(strings.ToLower(slug)); ok {
+		return m, true
+	}
+	// Models whose slug equals their ID are not indexed.
+	if m, ok := lookupID(slug); ok && m.CanonicalSlug() == slug {
+		return m, true
+	}
+	return nil, false
+}
+
+// GetVariant retrieves the given variant of a model, e.g. the "free" tier.
+// name may refer to the base model or to any of its variants.
+func GetVariant(name, variant string) (Model, bool) {
+	m, ok := Get(name)
+	if !ok {
+		return nil, false
+	}
+	baseID, _, _ := strings.Cut(m.ID(), ":")
+	if variant == "" {
+		return Get(baseID)
+	}
+	return Get(baseID + ":" + variant)
+}
+
+func lookupIndex(index map[string]string, key string) (Model, bool) {
+	if id, ok := index[key]; ok {
+		return lookupID(id)
+	}
+	return nil, false
+}
+
+// lookupNormalized returns the models whose strict key equals that of name,
+// sorted by ID. A vendor prefix in name must match the model's.
+//
+// If loose is set and nothing matches, the looser keys of name are tried
+// too, each against the strict keys of models before their looser ones, so
+// "claude-3-5-sonnet-20241022" finds claude-3.5-sonnet and
+// "qwen3-30b-a3b-instruct" finds qwen3-30b-a3b-instruct-2507; loosened
+// reports such a match.
+func lookupNormalized(name string, loose bool) (ms []Model, loosened bool) {
+	n := modelid.Normalize(name)
+	if len(n.Keys) == 0 {
+		return nil, false
+	}
+	if ms := normalizedMatches(n.Vendor, n.Keys[0], false); len(ms) > 0 || !loose {
+		return ms, false
+	}
+	for i, key := range n.Keys {
+		if i > 0 {
+			if ms := normalizedMatches(n.Vendor, key, false); len(ms) > 0 {
+				return ms, true
+			}
+		}
+		if ms := normalizedMatches(n.Vendor, key, true); len(ms) > 0 {
+			return ms, true
+		}
+	}
 	return nil, false
 }
 
+// lookupStrict returns the only model whose strict key equals that of name,
+// like lookupNormalized(name, false), without allocating for ASCII names so
+// that misses stay cheap.
+func lookupStrict(name string) (Model, bool) {
+	var keyBuf, vendorBuf [64]byte
+	key := modelid.AppendKey(keyBuf[:0], name)
+	if len(key) == 0 {
+		return nil, false
+	}
+	vendor := modelid.AppendVendor(vendorBuf[:0], name)
+	s := currentOverlay()
+	var found Model
+	n := 0
+	for _, id := range normalizedIndex[string(key)] {
+		if _, hidden := s.models[id]; !hidden && hasVendor(id, vendor) {
+			found, n = staticRegistry[id], n+1
+		}
+	}
+	for _, id := range s.normalized[string(key)] {
+		if hasVendor(id, vendor) {
+			found, n = s.models[id], n+1
+		}
+	}
+	if n != 1 {
+		return nil, false
+	}
+	return found, true
+}
+
+// hasVendor reports whether the normalized vendor of id is vendor, or
+// vendor is empty.
+func hasVendor(id string, vendor []byte) bool {
+	if len(vendor) == 0 {
+		return true
+	}
+	var buf [64]byte
+	return string(modelid.AppendVendor(buf[:0], id)) == string(vendor)
+}
+
+// normalizedMatches returns the models with key among their strict, or
+// else looser, keys.
+func normalizedMatches(vendor, key string, loose bool) []Model {
+	s := currentOverlay()
+	static, runtime := normalizedIndex[key], s.normalized[key]
+	if loose {
+		static, runtime = looseIndex[key], s.loose[key]
+	}
+	var matches []Model
+	add := func(m Model) {
+		if vendor == "" || modelid.Normalize(m.ID()).Vendor == vendor {
+			matches = append(matches, m)
+		}
+	}
+	for _, id := range static {
+		if _, hidden := s.models[id]; !hidden {
+			add(staticRegistry[id])
+		}
+	}
+	for _, id := range runtime {
+		add(s.models[id])
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID() < matches[j].ID() })
+	return matches
+}
+
 // GetMany retrieves multiple models by their IDs or aliases.
 // It returns a slice containing the found models. Names that do not match any model are skipped.
+// Use ResolveAll to report them instead.
 func GetMany(names []string) []Model {
 	results := make([]Model, 0, len(names))
 	for _, name := range names {
@@ -49,18 +250,52 @@ func GetMany(names []string) []Model {
 
 // QueryBuilder provides a chainable API for filtering models.
 type QueryBuilder struct {
-	provider   string
+	providers  []string
+	noProvider []string
 	capability Capability
+	anyOf      []Capability
+	without    Capability
+	params     []string
+	tokenizer  string
+	instruct   string
+	excluded   uint8 // bit set of excluded Status values
+	variant    string
+	noVariants bool
+
+	minContext, maxContext  int
+	minOutput               int
+	maxInPrice, maxOutPrice float64 // USD per million tokens, negative if unset
+	releasedAfter           time.Time
+	releasedBefore          time.Time
+
+	order         []sortOrder
+	limit, offset int
+
+	idPatterns   []*regexp.Regexp
+	namePatterns []*regexp.Regexp
+	where        []func(Model) bool
 }
 
 // Query starts a new query builder.
 func Query() *QueryBuilder {
-	return &QueryBuilder{}
+	return &QueryBuilder{maxInPrice: -1, maxOutPrice: -1}
 }
 
-// Provider filters models by provider name.
+// Provider filters models by provider name. Repeated calls accumulate,
+// like Providers.
 func (q *QueryBuilder) Provider(p string) *QueryBuilder {
-	q.provider = p
+	return q.Providers(p)
+}
+
+// Providers filters models from any of the given providers.
+func (q *QueryBuilder) Providers(names ...string) *QueryBuilder {
+	q.providers = append(q.providers, names...)
+	return q
+}
+
+// ExcludeProviders filters out models from the given providers.
+func (q *QueryBuilder) ExcludeProviders(names ...string) *QueryBuilder {
+	q.noProvider = append(q.noProvider, names...)
 	return q
 }
 
@@ -70,94 +305,323 @@ func (q *QueryBuilder) Has(cap Capability) *QueryBuilder {
 	return q
 }
 
-// List returns a slice of models matching the query criteria.
-func (q *QueryBuilder) List() []Model {
-	var results []Model
-	for _, m := range staticRegistry {
-		// Filter by provider
-		if q.provider != "" && !strings.EqualFold(m.ProviderVal, q.provider) {
-			continue
-		}
-		// Filter by capabilities
-		if q.capability != 0 && (m.FeaturesVal&q.capability) != q.capability {
-			continue
+// HasAny filters models with at least one of the given capabilities.
+// Each call adds a group that must match on its own, so
+// HasAny(ModalityImageIn|ModalityVideoIn).HasAny(CapFunctionCall|CapJsonMode)
+// means (image OR video) AND (tools OR JSON).
+func (q *QueryBuilder) HasAny(caps Capability) *QueryBuilder {
+	q.anyOf = append(q.anyOf, caps)
+	return q
+}
+
+// Without filters out models with any of the given capabilities.
+func (q *QueryBuilder) Without(caps Capability) *QueryBuilder {
+	q.without |= caps
+	return q
+}
+
+// MatchID filters models whose ID matches a case-insensitive glob pattern,
+// where "*" matches any run of characters (including "/") and "?" one character.
+func (q *QueryBuilder) MatchID(glob string) *QueryBuilder {
+	return q.IDRegexp(globRegexp(glob))
+}
+
+// MatchName filters models whose display name matches a case-insensitive glob pattern.
+func (q *QueryBuilder) MatchName(glob string) *QueryBuilder {
+	return q.NameRegexp(globRegexp(glob))
+}
+
+// IDRegexp filters models whose ID matches re.
+func (q *QueryBuilder) IDRegexp(re *regexp.Regexp) *QueryBuilder {
+	q.idPatterns = append(q.idPatterns, re)
+	return q
+}
+
+// NameRegexp filters models whose display name matches re.
+func (q *QueryBuilder) NameRegexp(re *regexp.Regexp) *QueryBuilder {
+	q.namePatterns = append(q.namePatterns, re)
+	return q
+}
+
+// Where filters models with an arbitrary predicate. fn may call back into
+// the registry, e.g. Get; the query sees the runtime models as they were
+// when it started.
+func (q *QueryBuilder) Where(fn func(Model) bool) *QueryBuilder {
+	q.where = append(q.where, fn)
+	return q
+}
+
+// globRegexp compiles a glob pattern into an anchored, case-insensitive regexp.
+func globRegexp(glob string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?i)^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
 		}
-		results = append(results, m)
 	}
-	return results
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+// Tokenizer filters models by tokenizer family, e.g. "Llama3".
+func (q *QueryBuilder) Tokenizer(name string) *QueryBuilder {
+	q.tokenizer = name
+	return q
 }
 
-// Search performs a fuzzy search across model IDs, names, and aliases.
-// It returns a ranked list of models based on relevance.
-func Search(query string, limit int) []Model {
-	if query == "" {
-		return nil
+// InstructType filters models by chat template, e.g. "chatml".
+func (q *QueryBuilder) InstructType(name string) *QueryBuilder {
+	q.instruct = name
+	return q
+}
+
+// ExcludeStatus filters out models in any of the given lifecycle stages.
+func (q *QueryBuilder) ExcludeStatus(statuses ...Status) *QueryBuilder {
+	for _, s := range statuses {
+		q.excluded |= 1 << s
 	}
+	return q
+}
+
+// ExcludeDeprecated filters out deprecated and retired models.
+func (q *QueryBuilder) ExcludeDeprecated() *QueryBuilder {
+	return q.ExcludeStatus(StatusDeprecated, StatusRetired)
+}
+
+// ExcludeRetired filters out models that are no longer served.
+func (q *QueryBuilder) ExcludeRetired() *QueryBuilder {
+	return q.ExcludeStatus(StatusRetired)
+}
+
+// Variant filters models by OpenRouter variant suffix, e.g. "free" or "thinking".
+func (q *QueryBuilder) Variant(name string) *QueryBuilder {
+	q.variant = name
+	return q
+}
 
-	query = strings.ToLower(query)
-	type searchResult struct {
-		m     Model
-		score int
+// ExcludeVariants keeps only base models, dropping IDs with a ":variant" suffix.
+func (q *QueryBuilder) ExcludeVariants() *QueryBuilder {
+	q.noVariants = true
+	return q
+}
+
+// MinContext filters models with a context window of at least n tokens.
+func (q *QueryBuilder) MinContext(n int) *QueryBuilder {
+	q.minContext = n
+	return q
+}
+
+// MaxContext filters models with a context window of at most n tokens.
+func (q *QueryBuilder) MaxContext(n int) *QueryBuilder {
+	q.maxContext = n
+	return q
+}
+
+// MinOutput filters models that can generate at least n tokens per response.
+// Models with an unknown output limit are excluded.
+func (q *QueryBuilder) MinOutput(n int) *QueryBuilder {
+	q.minOutput = n
+	return q
+}
+
+// MaxInputPrice filters models whose prompt price is at most usd per million
+// tokens, so 0 admits only free models. Models with an unknown or
+// per-request price are excluded. A negative, infinite or NaN usd removes
+// the ceiling.
+func (q *QueryBuilder) MaxInputPrice(usd float64) *QueryBuilder {
+	q.maxInPrice = priceCeiling(usd)
+	return q
+}
+
+// MaxOutputPrice filters models whose completion price is at most usd per
+// million tokens, like MaxInputPrice.
+func (q *QueryBuilder) MaxOutputPrice(usd float64) *QueryBuilder {
+	q.maxOutPrice = priceCeiling(usd)
+	return q
+}
+
+// priceCeiling maps ceilings that are not finite and non-negative to -1, unset.
+func priceCeiling(usd float64) float64 {
+	if usd < 0 || math.IsNaN(usd) || math.IsInf(usd, 0) {
+		return -1
 	}
-	var results []searchResult
+	return usd
+}
 
-	for _, m := range staticRegistry {
-		score := 0
-		id := strings.ToLower(m.ID())
-		name := strings.ToLower(m.Name())
+// ReleasedAfter filters models released at or after t.
+// Models with an unknown release date are excluded.
+func (q *QueryBuilder) ReleasedAfter(t time.Time) *QueryBuilder {
+	q.releasedAfter = t
+	return q
+}
 
-		// 1. Exact matches (Highest priority)
-		if id == query {
-			score += 100
-		} else if name == query {
-			score += 90
-		}
+// ReleasedBefore filters models released before t.
+// Models with an unknown release date are excluded.
+func (q *QueryBuilder) ReleasedBefore(t time.Time) *QueryBuilder {
+	q.releasedBefore = t
+	return q
+}
 
-		// 2. Prefix matches
-		if strings.HasPrefix(id, query) {
-			score += 50
-		} else if strings.HasPrefix(name, query) {
-			score += 40
-		}
+// SupportsParameter filters models that honor all of the given request parameters.
+func (q *QueryBuilder) SupportsParameter(names ...string) *QueryBuilder {
+	q.params = append(q.params, names...)
+	return q
+}
+
+// List returns the models matching the query criteria, sorted by ID unless
+// OrderBy says otherwise.
+func (q *QueryBuilder) List() []Model {
+	results, sorted := q.listIndexed()
+	if !sorted || len(q.order) > 0 {
+		q.sortModels(results)
+	}
+	return q.paginate(results)
+}
 
-		// 3. Substring matches
-		if strings.Contains(id, query) {
-			score += 20
-		} else if strings.Contains(name, query) {
-			score += 10
+func (q *QueryBuilder) match(m Model) bool {
+	return q.matchIndexed(m) && q.matchResidual(m)
+}
+
+// matchIndexed applies the filters backed by providerIndex and capabilityIndex.
+func (q *QueryBuilder) matchIndexed(m Model) bool {
+	// Filter by provider
+	if len(q.providers) > 0 && !containsFold(q.providers, m.Provider()) {
+		return false
+	}
+	if containsFold(q.noProvider, m.Provider()) {
+		return false
+	}
+	// Filter by capabilities
+	features := m.Features()
+	if q.capability != 0 && (features&q.capability) != q.capability {
+		return false
+	}
+	if features&q.without != 0 {
+		return false
+	}
+	for _, group := range q.anyOf {
+		if features&group == 0 {
+			return false
 		}
+	}
+	return true
+}
 
-		// 4. Alias matches
-		for _, alias := range m.Aliases() {
-			a := strings.ToLower(alias)
-			if a == query {
-				score += 80
-			} else if strings.Contains(a, query) {
-				score += 15
-			}
+// matchResidual applies the filters that have no index.
+func (q *QueryBuilder) matchResidual(m Model) bool {
+	// Filter by tokenizer and chat template
+	if q.tokenizer != "" && !strings.EqualFold(m.Tokenizer(), q.tokenizer) {
+		return false
+	}
+	if q.instruct != "" && !strings.EqualFold(m.InstructType(), q.instruct) {
+		return false
+	}
+	// Filter by variant
+	if q.noVariants && m.Variant() != "" {
+		return false
+	}
+	if q.variant != "" && !strings.EqualFold(m.Variant(), q.variant) {
+		return false
+	}
+	// Filter by lifecycle
+	if q.excluded != 0 && q.excluded&(1<<m.Status()) != 0 {
+		return false
+	}
+	// Filter by numeric ranges
+	if !q.matchRanges(m) {
+		return false
+	}
+	// Filter by patterns
+	for _, re := range q.idPatterns {
+		if !re.MatchString(m.ID()) {
+			return false
+		}
+	}
+	for _, re := range q.namePatterns {
+		if !re.MatchString(m.Name()) {
+			return false
+		}
+	}
+	// Filter by supported parameters
+	if !q.matchParams(m) {
+		return false
+	}
+	for _, fn := range q.where {
+		if !fn(m) {
+			return false
 		}
+	}
+	return true
+}
 
-		if score > 0 {
-			results = append(results, searchResult{m, score})
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
 		}
 	}
+	return false
+}
 
-	// Sort by score descending
-	sort.Slice(results, func(i, j int) bool {
-		if results[i].score == results[j].score {
-			return results[i].m.ID() < results[j].m.ID()
+func (q *QueryBuilder) matchRanges(m Model) bool {
+	if q.minContext > 0 && m.ContextLength() < q.minContext {
+		return false
+	}
+	if q.maxContext > 0 && m.ContextLength() > q.maxContext {
+		return false
+	}
+	if q.minOutput > 0 && m.MaxOutput() < q.minOutput {
+		return false
+	}
+	if q.maxInPrice >= 0 || q.maxOutPrice >= 0 {
+		p := m.Pricing()
+		if q.maxInPrice >= 0 && !priceAtMost(p.Prompt, q.maxInPrice) {
+			return false
+		}
+		if q.maxOutPrice >= 0 && !priceAtMost(p.Completion, q.maxOutPrice) {
+			return false
+		}
+	}
+	if !q.releasedAfter.IsZero() || !q.releasedBefore.IsZero() {
+		r := m.ReleasedAt()
+		if r.IsZero() {
+			return false
+		}
+		if !q.releasedAfter.IsZero() && r.Before(q.releasedAfter) {
+			return false
+		}
+		if !q.releasedBefore.IsZero() && !r.Before(q.releasedBefore) {
+			return false
 		}
-		return results[i].score > results[j].score
-	})
+	}
+	return true
+}
 
-	// Apply limit
-	if limit > 0 && len(results) > limit {
-		results = results[:limit]
+// priceAtMost reports whether a known, fixed price is at most usd per million
+// units. The comparison is exact, so a ceiling of 1 admits "0.000001".
+func priceAtMost(p Price, usd float64) bool {
+	r, ok := p.Rat()
+	if !ok || r.Sign() < 0 {
+		return false
+	}
+	limit, ok := new(big.Rat).SetString(strconv.FormatFloat(usd, 'f', -1, 64))
+	if !ok {
+		return false
 	}
+	return r.Mul(r, big.NewRat(1e6, 1)).Cmp(limit) <= 0
+}
 
-	final := make([]Model, len(results))
-	for i, r := range results {
-		final[i] = r.m
+func (q *QueryBuilder) matchParams(m Model) bool {
+	for _, p := range q.params {
+		if !m.SupportsParameter(p) {
+			return false
+		}
 	}
-	return final
+	return true
 }
@@ -1,8 +1,11 @@
 package llmspecs
 
 import (
+	"math"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestGet(t *testing.T) {
@@ -97,6 +100,252 @@ func TestQuery(t *testing.T) {
 	}
 }
 
+func TestQuery_SupportsParameter(t *testing.T) {
+	results := Query().SupportsParameter("seed", "top_k").List()
+	if len(results) == 0 {
+		t.Fatal("Expected models supporting seed and top_k")
+	}
+	for _, m := range results {
+		if !m.SupportsParameter("seed") || !m.SupportsParameter("top_k") {
+			t.Errorf("Model %s does not support seed and top_k: %v", m.ID(), m.SupportedParameters())
+		}
+	}
+
+	if got := Query().SupportsParameter("no_such_parameter").List(); len(got) != 0 {
+		t.Errorf("Expected no models for unknown parameter, got %d", len(got))
+	}
+}
+
+func TestQuery_Tokenizer(t *testing.T) {
+	results := Query().Tokenizer("llama3").List() // case-insensitive
+	if len(results) == 0 {
+		t.Fatal("Expected models with the Llama3 tokenizer")
+	}
+	for _, m := range results {
+		if m.Tokenizer() != "Llama3" {
+			t.Errorf("Model %s has tokenizer %s", m.ID(), m.Tokenizer())
+		}
+	}
+
+	results = Query().Tokenizer("Llama3").InstructType("llama3").List()
+	for _, m := range results {
+		if m.InstructType() != "llama3" {
+			t.Errorf("Model %s has instruct type %q", m.ID(), m.InstructType())
+		}
+	}
+	if len(results) == 0 {
+		t.Error("Expected Llama3 models with the llama3 chat template")
+	}
+}
+
+func TestGetByHuggingFaceID(t *testing.T) {
+	// Case-insensitive; base model wins over its variants
+	m, ok := GetByHuggingFaceID("qwen/qwen3-next-80b-a3b-instruct")
+	if !ok || m.ID() != "qwen/qwen3-next-80b-a3b-instruct" {
+		t.Errorf("Expected qwen/qwen3-next-80b-a3b-instruct, got %v", m)
+	}
+
+	// Get falls back to Hugging Face IDs
+	m, ok = Get("arcee-ai/Trinity-Large-Preview")
+	if !ok || m.ID() != "arcee-ai/trinity-large-preview:free" {
+		t.Errorf("Get by HF ID failed, got %v", m)
+	}
+	if m.HuggingFaceID() != "arcee-ai/Trinity-Large-Preview" {
+		t.Errorf("Unexpected HuggingFaceID %q", m.HuggingFaceID())
+	}
+
+	if _, ok := GetByHuggingFaceID("no-org/no-model"); ok {
+		t.Error("Expected not to find unknown HF ID")
+	}
+}
+
+func TestGetByCanonicalSlug(t *testing.T) {
+	m, ok := GetByCanonicalSlug("openai/gpt-5.2-codex-20260114")
+	if !ok || m.ID() != "openai/gpt-5.2-codex" {
+		t.Errorf("Expected openai/gpt-5.2-codex, got %v", m)
+	}
+	if m, ok := Get("openai/gpt-5.2-codex-20260114"); !ok || m.ID() != "openai/gpt-5.2-codex" {
+		t.Error("Get should resolve canonical slugs")
+	}
+
+	// A model without a dated slug is its own canonical identity
+	m, ok = GetByCanonicalSlug("openai/gpt-4")
+	if !ok || m.CanonicalSlug() != "openai/gpt-4" {
+		t.Errorf("Expected openai/gpt-4 to be its own canonical slug, got %v", m)
+	}
+
+	// Variants share the slug of their base, which wins even when the slug
+	// is the base's own ID
+	for _, id := range []string{"openai/gpt-4o", "openai/gpt-oss-120b", "z-ai/glm-4.6"} {
+		if base, _ := Get(id); base == nil || len(base.Variants()) == 0 {
+			t.Fatalf("Expected %s to have variants", id)
+		}
+		if m, ok := GetByCanonicalSlug(id); !ok || m.ID() != id {
+			t.Errorf("GetByCanonicalSlug(%q) = %v, want the base model", id, m)
+		}
+	}
+}
+
+func TestVariants(t *testing.T) {
+	m, ok := Get("arcee-ai/trinity-mini:free")
+	if !ok {
+		t.Fatal("Expected arcee-ai/trinity-mini:free")
+	}
+	if m.Variant() != "free" || m.BaseVariant() != "arcee-ai/trinity-mini" {
+		t.Errorf("Unexpected variant %q of base %q", m.Variant(), m.BaseVariant())
+	}
+
+	base, _ := Get("arcee-ai/trinity-mini")
+	if base.Variant() != "" || base.BaseVariant() != "" {
+		t.Errorf("Base model should have no variant, got %q", base.Variant())
+	}
+	found := false
+	for _, id := range base.Variants() {
+		found = found || id == m.ID()
+	}
+	if !found {
+		t.Errorf("Expected %s among variants %v", m.ID(), base.Variants())
+	}
+
+	// Variants resolve from either side
+	if v, ok := GetVariant("arcee-ai/trinity-mini", "free"); !ok || v.ID() != m.ID() {
+		t.Errorf("GetVariant(base, free) = %v", v)
+	}
+	if v, ok := GetVariant(m.ID(), ""); !ok || v.ID() != base.ID() {
+		t.Errorf("GetVariant(variant, \"\") = %v", v)
+	}
+	if _, ok := GetVariant("openai/gpt-4", "free"); ok {
+		t.Error("Expected no free tier of openai/gpt-4")
+	}
+}
+
+func TestQuery_Variant(t *testing.T) {
+	free := Query().Variant("free").List()
+	if len(free) == 0 {
+		t.Fatal("Expected :free variants")
+	}
+	for _, m := range free {
+		if m.Variant() != "free" {
+			t.Errorf("Model %s is not a free variant", m.ID())
+		}
+	}
+
+	bases := Query().ExcludeVariants().List()
+	for _, m := range bases {
+		if strings.Contains(m.ID(), ":") {
+			t.Errorf("ExcludeVariants returned %s", m.ID())
+		}
+	}
+	if len(bases) == 0 || len(bases) >= Total() {
+		t.Errorf("Expected a proper subset of base models, got %d of %d", len(bases), Total())
+	}
+}
+
+func TestQuery_Ranges(t *testing.T) {
+	results := Query().Has(ModalityImageIn | CapFunctionCall).MinContext(128000).MaxInputPrice(1).List()
+	if len(results) == 0 {
+		t.Fatal("Expected cheap vision + tools models with 128k context")
+	}
+	for _, m := range results {
+		if m.ContextLength() < 128000 || m.Pricing().Prompt.PerMillion() > 1 || m.Pricing().Prompt == "" {
+			t.Errorf("Model %s outside range: ctx=%d prompt=%q", m.ID(), m.ContextLength(), m.Pricing().Prompt)
+		}
+	}
+
+	for _, m := range Query().MaxContext(8192).MinOutput(4096).List() {
+		if m.ContextLength() > 8192 || m.MaxOutput() < 4096 {
+			t.Errorf("Model %s outside range: ctx=%d out=%d", m.ID(), m.ContextLength(), m.MaxOutput())
+		}
+	}
+
+	// The price ceiling is inclusive and exact: gpt-4o-mini costs $0.60/M output
+	found := false
+	for _, m := range Query().Provider("OpenAI").MaxOutputPrice(0.6).List() {
+		found = found || m.ID() == "openai/gpt-4o-mini"
+	}
+	if !found {
+		t.Error("Expected openai/gpt-4o-mini under $0.60/M output")
+	}
+
+	// Ceilings that are not finite remove the filter instead of panicking
+	all := len(Query().List())
+	for _, usd := range []float64{math.Inf(1), math.Inf(-1), math.NaN(), -1} {
+		if n := len(Query().MaxInputPrice(usd).MaxOutputPrice(usd).List()); n != all {
+			t.Errorf("MaxInputPrice(%v) matched %d of %d models", usd, n, all)
+		}
+	}
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	results = Query().ReleasedAfter(from).ReleasedBefore(to).List()
+	if len(results) == 0 {
+		t.Fatal("Expected models released in H1 2025")
+	}
+	for _, m := range results {
+		if r := m.ReleasedAt(); r.Before(from) || !r.Before(to) {
+			t.Errorf("Model %s released at %v", m.ID(), r)
+		}
+	}
+}
+
+func TestQuery_CapabilityLogic(t *testing.T) {
+	results := Query().HasAny(ModalityImageIn | ModalityVideoIn).Without(CapEmbedding).ExcludeProviders("google").List()
+	if len(results) == 0 {
+		t.Fatal("Expected image or video models outside Google")
+	}
+	for _, m := range results {
+		if !m.HasCapability(ModalityImageIn | ModalityVideoIn) {
+			t.Errorf("Model %s takes neither image nor video input", m.ID())
+		}
+		if m.HasCapability(CapEmbedding) || m.Provider() == "Google" {
+			t.Errorf("Model %s should have been excluded", m.ID())
+		}
+	}
+
+	// Provider calls accumulate instead of overwriting each other
+	both := Query().Provider("Anthropic").Provider("OpenAI").List()
+	if len(both) != len(Query().Providers("anthropic", "openai").List()) {
+		t.Error("Provider and Providers disagree")
+	}
+	seen := map[string]bool{}
+	for _, m := range both {
+		seen[m.Provider()] = true
+	}
+	if len(seen) != 2 || !seen["Anthropic"] || !seen["OpenAI"] {
+		t.Errorf("Expected Anthropic and OpenAI models, got providers %v", seen)
+	}
+}
+
+func TestQuery_Patterns(t *testing.T) {
+	results := Query().MatchID("openai/GPT-4o*").List()
+	if len(results) == 0 {
+		t.Fatal("Expected gpt-4o models")
+	}
+	for _, m := range results {
+		if !strings.HasPrefix(m.ID(), "openai/gpt-4o") {
+			t.Errorf("Unexpected match %s", m.ID())
+		}
+	}
+	if got := Query().MatchID("*claude-3.?-sonnet").List(); len(got) != 2 {
+		t.Errorf("Expected claude-3.5 and 3.7 sonnet, got %d models", len(got))
+	}
+	if got := Query().MatchName("Anthropic: *").Without(ModalityImageIn).Has(ModalityImageIn).List(); len(got) != 0 {
+		t.Errorf("Contradictory filters should match nothing, got %d", len(got))
+	}
+
+	results = Query().IDRegexp(regexp.MustCompile(`qwen3-\d+b$`)).Where(func(m Model) bool {
+		return m.ContextLength() >= 32768
+	}).List()
+	if len(results) == 0 {
+		t.Fatal("Expected dense Qwen3 models")
+	}
+	for _, m := range results {
+		if m.ContextLength() < 32768 {
+			t.Errorf("Where predicate ignored for %s", m.ID())
+		}
+	}
+}
+
 // Performance Benchmarks
 
 func BenchmarkGetByID(b *testing.B) {
@@ -221,3 +470,105 @@ func TestGet_Aliases(t *testing.T) {
 		t.Error("Alias lookup should be case-insensitive")
 	}
 }
+
+func TestGet_Normalized(t *testing.T) {
+	tests := map[string]string{
+		"claude-3-5-sonnet":                "anthropic/claude-3.5-sonnet",
+		"Claude 3.5 Sonnet":                "anthropic/claude-3.5-sonnet",
+		"gpt4o":                            "openai/gpt-4o",
+		"gpt_4o_2024_08_06":                "openai/gpt-4o-2024-08-06",
+		"Meta-Llama/Llama-3.1-8B-Instruct": "meta-llama/llama-3.1-8b-instruct",
+		"google/gpt-4o":                    "", // the vendor must match
+		// Get never drops date stamps or tags; Resolve does
+		"openai/gpt-4o-2099-01-01": "",
+		"gpt-3.5-turbo-0301":       "",
+		"gpt-5-chat-2099-01-01":    "",
+		"Qwen3-32B-Instruct":       "",
+	}
+	for name, want := range tests {
+		m, ok := Get(name)
+		switch {
+		case want == "" && ok:
+			t.Errorf("Get(%q) = %s, want none", name, m.ID())
+		case want != "" && !ok:
+			t.Errorf("Get(%q) found nothing, want %s", name, want)
+		case ok && m.ID() != want:
+			t.Errorf("Get(%q) = %s, want %s", name, m.ID(), want)
+		}
+	}
+}
+
+func TestGetLoose(t *testing.T) {
+	tests := map[string]string{
+		"Qwen3-32B-Instruct":         "qwen/qwen3-32b",
+		"claude-3-5-sonnet-20241022": "anthropic/claude-3.5-sonnet",
+		"openai/gpt-4o-2099-01-01":   "openai/gpt-4o",
+		"gpt-4o-2024-08-06":          "openai/gpt-4o-2024-08-06", // an exact snapshot still wins
+		"no-such-model-20250101":     "",
+	}
+	for name, want := range tests {
+		m, ok := GetLoose(name)
+		switch {
+		case want == "" && ok:
+			t.Errorf("GetLoose(%q) = %s, want none", name, m.ID())
+		case want != "" && !ok:
+			t.Errorf("GetLoose(%q) found nothing, want %s", name, want)
+		case ok && m.ID() != want:
+			t.Errorf("GetLoose(%q) = %s, want %s", name, m.ID(), want)
+		}
+	}
+}
+
+func TestGet_NormalizedRuntime(t *testing.T) {
+	a := FromSpec(ModelSpec{ID: "acme/widget-7b-instruct"})
+	b := FromSpec(ModelSpec{ID: "globex/widget-7b-instruct"})
+	for _, m := range []Model{a, b} {
+		if err := Register(m); err != nil {
+			t.Fatal(err)
+		}
+		defer Unregister(m.ID())
+	}
+	// Both vendors share the normalized name, so only a prefix resolves it
+	if m, ok := Get("Widget 7B Instruct"); ok {
+		t.Errorf("Expected an ambiguous name to find nothing, got %s", m.ID())
+	}
+	if m, ok := Get("ACME/widget_7b_instruct"); !ok || m != a {
+		t.Errorf("Expected the vendor prefix to pick %s, got %v", a.ID(), m)
+	}
+
+	// Unregistering drops the normalized keys with the model
+	Unregister(b.ID())
+	if m, ok := Get("Widget 7B Instruct"); !ok || m != a {
+		t.Errorf("Expected %s once it is the only match, got %v", a.ID(), m)
+	}
+	Unregister(a.ID())
+	if m, ok := Get("Widget 7B Instruct"); ok {
+		t.Errorf("Expected nothing after Unregister, got %s", m.ID())
+	}
+}
+
+func TestNormalizedIndex_NoCollisions(t *testing.T) {
+	if len(normalizedIndex) == 0 {
+		t.Fatal("normalizedIndex is empty")
+	}
+	for key, ids := range normalizedIndex {
+		if len(ids) != 1 {
+			t.Errorf("Key %q is shared by %v", key, ids)
+		}
+		for _, id := range ids {
+			if _, ok := staticRegistry[id]; !ok {
+				t.Errorf("Key %q refers to unknown model %s", key, id)
+			}
+		}
+	}
+}
+
+func TestGet_NoAllocs(t *testing.T) {
+	// Misses and normalized matches must stay as cheap as exact lookups,
+	// GetMany calls Get for every name
+	for _, name := range []string{"non-existent", "anthropic/claude-3-5-sonnet", "qwen3-32b", "gpt-4o-2099-01-01"} {
+		if allocs := testing.AllocsPerRun(100, func() { Get(name) }); allocs != 0 {
+			t.Errorf("Get(%q) allocated %v times", name, allocs)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package llmspecs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// How Resolve matched a name, from most to least specific.
+const (
+	ResolvedID          = "id"          // the model ID, ignoring case
+	ResolvedAlias       = "alias"       // an alias, ignoring case
+	ResolvedCanonical   = "canonical"   // a canonical slug, ignoring case
+	ResolvedHuggingFace = "huggingface" // a Hugging Face repository ID, ignoring case
+	ResolvedNormalized  = "normalized"  // a single model by its strict modelid.Normalize key
+	// ResolvedLoose matched a single model only after dropping date stamps or
+	// "-instruct"-style tags, from the name or the model's names. The model
+	// may be another snapshot than the one the name pins.
+	ResolvedLoose = "loose"
+)
+
+// Resolution describes how Resolve found a model.
+type Resolution struct {
+	Name  string // the name as given
+	Model Model
+	Kind  string // one of the Resolved* constants
+	// Candidates are other models matching the name after normalization.
+	// They did not win, but a non-empty list hints at a confusable name.
+	Candidates []Model
+}
+
+// ResolveError reports a name that matched no model, or several models
+// equally well.
+type ResolveError struct {
+	Name string
+	// Candidates lists the models an ambiguous name matched after
+	// normalization. It is empty when nothing matched.
+	Candidates []Model
+	// Suggestions are the closest models by fuzzy search when nothing matched.
+	Suggestions []Model
+}
+
+func (e *ResolveError) Error() string {
+	if len(e.Candidates) > 0 {
+		return fmt.Sprintf("llmspecs: model %q is ambiguous: could be %s", e.Name, joinIDs(e.Candidates))
+	}
+	if len(e.Suggestions) > 0 {
+		return fmt.Sprintf("llmspecs: unknown model %q (did you mean %s?)", e.Name, joinIDs(e.Suggestions))
+	}
+	return fmt.Sprintf("llmspecs: unknown model %q", e.Name)
+}
+
+// maxSuggestions caps ResolveError.Suggestions.
+const maxSuggestions = 3
+
+// Resolve is like Get, but explains its answer, to help catch misconfigured
+// model names early. It tries the ID, aliases, canonical slugs and Hugging
+// Face IDs in that order, then the normalized name, ignoring case,
+// separators and vendor prefixes ("GPT4o" finds "gpt-4o"). Unlike Get, it
+// finally drops date stamps and "-instruct"-style suffixes, reporting such
+// a match as ResolvedLoose.
+//
+// A normalized name matching several models is ambiguous. On failure,
+// Resolve returns a *ResolveError listing the candidates, or did-you-mean
+// suggestions if there were none.
+func Resolve(name string) (Resolution, error) {
+	r := Resolution{Name: name}
+	normalized, loosened := lookupNormalized(name, true)
+
+	// The same chain as Get
+	lower := strings.ToLower(name)
+	if m, ok := lookupID(name); ok {
+		r.Model, r.Kind = m, ResolvedID
+	} else if m, ok := lookupID(lower); ok {
+		r.Model, r.Kind = m, ResolvedID
+	} else if m, ok := lookupAlias(lower); ok {
+		r.Model, r.Kind = m, ResolvedAlias
+	} else if m, ok := lookupCanonical(lower); ok {
+		r.Model, r.Kind = m, ResolvedCanonical
+	} else if m, ok := lookupHuggingFace(lower); ok {
+		r.Model, r.Kind = m, ResolvedHuggingFace
+	}
+
+	if r.Model == nil {
+		switch len(normalized) {
+		case 0:
+			return r, &ResolveError{Name: name, Suggestions: Search(name, maxSuggestions)}
+		case 1:
+			r.Model, r.Kind = normalized[0], ResolvedNormalized
+			if loosened {
+				r.Kind = ResolvedLoose
+			}
+			return r, nil
+		default:
+			return r, &ResolveError{Name: name, Candidates: normalized}
+		}
+	}
+	for _, m := range normalized {
+		if m.ID() != r.Model.ID() {
+			r.Candidates = append(r.Candidates, m)
+		}
+	}
+	return r, nil
+}
+
+// ResolveAll resolves every name, returning the models found in order.
+// Unlike GetMany, it reports each name it could not resolve: the error joins
+// one *ResolveError per failure.
+func ResolveAll(names []string) ([]Model, error) {
+	models := make([]Model, 0, len(names))
+	var errs []error
+	for _, name := range names {
+		r, err := Resolve(name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		models = append(models, r.Model)
+	}
+	return models, errors.Join(errs...)
+}
+
+func joinIDs(models []Model) string {
+	ids := make([]string, len(models))
+	for i, m := range models {
+		ids[i] = m.ID()
+	}
+	return strings.Join(ids, ", ")
+}
@@ -0,0 +1,109 @@
+package llmspecs
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name, id, kind string
+	}{
+		{"openai/gpt-4o", "openai/gpt-4o", ResolvedID},
+		{"gpt4t", "openai/gpt-4-turbo", ResolvedAlias},
+		{"OpenAI/GPT-4o", "openai/gpt-4o", ResolvedID},
+		{"AI21Labs/AI21-Jamba-Large-1.7", "ai21/jamba-large-1.7", ResolvedHuggingFace},
+		{"gpt4o", "openai/gpt-4o", ResolvedNormalized},
+		{"claude-3-5-sonnet", "anthropic/claude-3.5-sonnet", ResolvedNormalized},
+		{"claude-3-5-sonnet-20241022", "anthropic/claude-3.5-sonnet", ResolvedLoose},
+		{"openai/gpt-4o-2099-01-01", "openai/gpt-4o", ResolvedLoose},
+		{"Qwen3-32B-Instruct", "qwen/qwen3-32b", ResolvedLoose},
+		{"qwen3-30b-a3b-instruct", "qwen/qwen3-30b-a3b-instruct-2507", ResolvedLoose},
+		{"gpt-5-chat-latest", "openai/gpt-5-chat", ResolvedLoose},
+		{"Meta-Llama/Llama-3.1-8B", "meta-llama/llama-3.1-8b-instruct", ResolvedLoose},
+	}
+	for _, tt := range tests {
+		r, err := Resolve(tt.name)
+		if err != nil {
+			t.Errorf("Resolve(%q): %v", tt.name, err)
+			continue
+		}
+		if r.Model.ID() != tt.id || r.Kind != tt.kind || r.Name != tt.name {
+			t.Errorf("Resolve(%q) = %s via %s, want %s via %s", tt.name, r.Model.ID(), r.Kind, tt.id, tt.kind)
+		}
+	}
+}
+
+func TestResolve_Unknown(t *testing.T) {
+	_, err := Resolve("gtp-4o")
+	var re *ResolveError
+	if !errors.As(err, &re) {
+		t.Fatalf("Expected a *ResolveError, got %v", err)
+	}
+	if len(re.Candidates) != 0 || len(re.Suggestions) == 0 || re.Suggestions[0].ID() != "openai/gpt-4o" {
+		t.Errorf("Expected openai/gpt-4o as the first suggestion, got %+v", re)
+	}
+	if !strings.Contains(err.Error(), "did you mean openai/gpt-4o") {
+		t.Errorf("Unexpected message %q", err)
+	}
+
+	_, err = Resolve("xyzzy-plugh")
+	if !errors.As(err, &re) || len(re.Suggestions) != 0 || err.Error() != `llmspecs: unknown model "xyzzy-plugh"` {
+		t.Errorf("Expected no suggestions for nonsense, got %v", err)
+	}
+}
+
+func TestResolve_Ambiguous(t *testing.T) {
+	a := FromSpec(ModelSpec{ID: "acme/chat-x", Name: "Acme Chat X"})
+	b := FromSpec(ModelSpec{ID: "globex/chat-x", Name: "Globex Chat X"})
+	for _, m := range []Model{a, b} {
+		if err := Register(m); err != nil {
+			t.Fatal(err)
+		}
+		defer Unregister(m.ID())
+	}
+
+	_, err := Resolve("Chat_X")
+	var re *ResolveError
+	if !errors.As(err, &re) {
+		t.Fatalf("Expected a *ResolveError, got %v", err)
+	}
+	if len(re.Candidates) != 2 || re.Candidates[0] != a || re.Candidates[1] != b {
+		t.Errorf("Expected both models as candidates, got %+v", re.Candidates)
+	}
+
+	// An exact ID wins, but the other model is still reported
+	r, err := Resolve("acme/chat-x")
+	if err != nil || r.Model != a || r.Kind != ResolvedID {
+		t.Fatalf("Unexpected resolution %+v, %v", r, err)
+	}
+	r, _ = Resolve("chat-x")
+	if r.Model != nil {
+		t.Errorf("Expected no model for an ambiguous name, got %s", r.Model.ID())
+	}
+}
+
+func TestResolveAll(t *testing.T) {
+	models, err := ResolveAll([]string{"gpt4o", "no-such-model", "qwen3-32b", "gtp-4o"})
+	if len(models) != 2 || models[0].ID() != "openai/gpt-4o" || models[1].ID() != "qwen/qwen3-32b" {
+		t.Errorf("Unexpected models %v", modelIDs(models))
+	}
+	if err == nil {
+		t.Fatal("Expected an error for the unresolved names")
+	}
+	var names []string
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var re *ResolveError
+		if errors.As(e, &re) {
+			names = append(names, re.Name)
+		}
+	}
+	if strings.Join(names, ",") != "no-such-model,gtp-4o" {
+		t.Errorf("Expected every unresolved name reported, got %v", names)
+	}
+
+	if _, err := ResolveAll([]string{"gpt-4o", "gpt4t"}); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
@@ -0,0 +1,378 @@
+package llmspecs
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Match kinds reported in FieldMatch, from strongest to weakest.
+const (
+	MatchExact     = "exact"     // equal after normalization
+	MatchPrefix    = "prefix"    // the field starts with the query
+	MatchSubstring = "substring" // the field contains the query
+	MatchTokens    = "tokens"    // every query word matches a word of the field
+	MatchFuzzy     = "fuzzy"     // as above, allowing typos
+)
+
+// FieldMatch explains how a query matched one field of a model.
+type FieldMatch struct {
+	Field string // "id", "name" or "alias"
+	Value string // the matched field value
+	Kind  string // one of the Match* constants
+	Score int
+}
+
+// SearchResult is a ranked model returned by SearchDetailed.
+type SearchResult struct {
+	Model   Model
+	Score   int
+	Matches []FieldMatch // best match first
+}
+
+// Base scores of the searched fields; other match kinds scale them down.
+const (
+	scoreID    = 100
+	scoreName  = 90
+	scoreAlias = 80
+)
+
+// Search performs a fuzzy search across model IDs, names, and aliases.
+// It returns a ranked list of models based on relevance.
+func Search(query string, limit int) []Model {
+	results := SearchDetailed(query, limit)
+	if results == nil {
+		return nil
+	}
+	final := make([]Model, len(results))
+	for i, r := range results {
+		final[i] = r.Model
+	}
+	return final
+}
+
+// SearchDetailed is like Search but also reports each model's score and the
+// fields that matched.
+//
+// Matching is tolerant of the usual ways model names get mistyped: case,
+// separators and punctuation are ignored ("gpt4o" finds "gpt-4o"), words can
+// come in any order ("32b qwen3"), and words may contain typos within a small
+// edit distance, transpositions included ("gtp-4o", "claude sonet").
+func SearchDetailed(query string, limit int) []SearchResult {
+	sq := newSearchText(query)
+	if sq.compact == "" {
+		return nil
+	}
+
+	var results []SearchResult
+	forEach(func(m Model) bool {
+		if r, ok := sq.score(m); ok {
+			results = append(results, r)
+		}
+		return true
+	})
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score == results[j].Score {
+			return results[i].Model.ID() < results[j].Model.ID()
+		}
+		return results[i].Score > results[j].Score
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// searchText is a string normalized for matching: compact drops everything
+// but lowercase letters and digits, and words splits on the dropped characters.
+// chars is the set of bytes in compact, see charBit.
+type searchText struct {
+	compact string
+	words   []string
+	chars   uint64
+}
+
+func newSearchText(s string) searchText {
+	var b strings.Builder
+	var words []string
+	start := -1
+	flush := func() {
+		if start >= 0 {
+			words = append(words, b.String()[start:])
+			start = -1
+		}
+	}
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = b.Len()
+			}
+			b.WriteRune(r)
+		} else {
+			flush()
+		}
+	}
+	flush()
+	t := searchText{compact: b.String(), words: words}
+	for i := 0; i < len(t.compact); i++ {
+		t.chars |= charBit(t.compact[i])
+	}
+	return t
+}
+
+// charBit maps a byte to its bit in searchText.chars. Digits and letters get
+// a bit each; all other bytes, which only occur in non-ASCII text, share one.
+func charBit(c byte) uint64 {
+	switch {
+	case '0' <= c && c <= '9':
+		return 1 << (c - '0')
+	case 'a' <= c && c <= 'z':
+		return 1 << (10 + c - 'a')
+	}
+	return 1 << 36
+}
+
+// missing counts the bytes of s that are not in chars. Each edit makes up
+// for at most one of them, so s is more than that many typos away from any
+// string made of chars.
+func missing(s string, chars uint64) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if chars&charBit(s[i]) == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// mayMatch is a cheap check that match can succeed: the whole query, or
+// else each of its words, must be within its typo budget of v's bytes.
+func (q *searchText) mayMatch(v *searchText) bool {
+	if missing(q.compact, v.chars) <= maxTypos(len(q.compact)) {
+		return true
+	}
+	for _, w := range q.words {
+		if missing(w, v.chars) > maxTypos(len(w)) {
+			return false
+		}
+	}
+	return true
+}
+
+// searchField is a normalized field value of a model.
+type searchField struct {
+	field string
+	value string
+	base  int
+	text  searchText
+}
+
+// staticSearchFields holds the normalized fields of built-in models, built
+// once on first search. Runtime models are few and may be replaced under the
+// same ID, so their fields are computed on each search.
+var staticSearchFields = sync.OnceValue(func() map[*modelData][]searchField {
+	cache := make(map[*modelData][]searchField, len(staticIDs))
+	for _, d := range staticModels() {
+		cache[d] = newSearchFields(d)
+	}
+	return cache
+})
+
+func searchFields(m Model) []searchField {
+	if d, ok := m.(*modelData); ok {
+		if fields, ok := staticSearchFields()[d]; ok {
+			return fields
+		}
+	}
+	return newSearchFields(m)
+}
+
+func newSearchFields(m Model) []searchField {
+	var fields []searchField
+	add := func(field, value string, base int) {
+		fields = append(fields, searchField{field, value, base, newSearchText(value)})
+	}
+	id := m.ID()
+	add("id", id, scoreID)
+	// The ID without its vendor prefix is how people usually type it
+	if _, short, ok := strings.Cut(id, "/"); ok {
+		add("id", short, scoreID)
+	}
+	add("name", m.Name(), scoreName)
+	for _, alias := range m.Aliases() {
+		add("alias", alias, scoreAlias)
+	}
+	return fields
+}
+
+// score matches q against every searchable field of m. The best field sets
+// the score; other matching fields add a tenth of theirs.
+func (q *searchText) score(m Model) (SearchResult, bool) {
+	var matches []FieldMatch
+	fields := searchFields(m)
+	for i := range fields {
+		f := &fields[i]
+		if fm, ok := q.match(&f.text, f.base); ok {
+			fm.Field, fm.Value = f.field, f.value
+			matches = append(matches, fm)
+		}
+	}
+	if len(matches) == 0 {
+		return SearchResult{}, false
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	r := SearchResult{Model: m, Score: matches[0].Score, Matches: []FieldMatch{matches[0]}}
+	for _, fm := range matches[1:] {
+		// Report each field once, e.g. not both the full and the short ID
+		if fm.Field != "alias" && hasField(r.Matches, fm.Field) {
+			continue
+		}
+		r.Score += fm.Score / 10
+		r.Matches = append(r.Matches, fm)
+	}
+	return r, true
+}
+
+func hasField(matches []FieldMatch, field string) bool {
+	for _, fm := range matches {
+		if fm.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// match scores q against a single field value; Field and Value are left to the caller.
+func (q *searchText) match(v *searchText, base int) (FieldMatch, bool) {
+	if !q.mayMatch(v) {
+		return FieldMatch{}, false
+	}
+	switch {
+	case v.compact == q.compact:
+		return FieldMatch{Kind: MatchExact, Score: base}, true
+	case strings.HasPrefix(v.compact, q.compact):
+		return FieldMatch{Kind: MatchPrefix, Score: base * 6 / 10}, true
+	case strings.Contains(v.compact, q.compact):
+		return FieldMatch{Kind: MatchSubstring, Score: base * 4 / 10}, true
+	}
+
+	// Whole-string typo, e.g. "gtp4o" for "gpt4o"
+	if d := editDistance(q.compact, v.compact, maxTypos(len(q.compact))); d > 0 {
+		return FieldMatch{Kind: MatchFuzzy, Score: base * 5 / 10 / d}, true
+	}
+
+	// Word by word, in any order
+	total, fuzzy := 0, false
+	used := make([]bool, len(v.words))
+	for _, w := range q.words {
+		best, bestAt := 0, -1
+		for i, fw := range v.words {
+			s := wordScore(w, fw)
+			if s > best {
+				best, bestAt = s, i
+			}
+		}
+		if bestAt < 0 {
+			return FieldMatch{}, false
+		}
+		used[bestAt] = true
+		fuzzy = fuzzy || best < 80
+		total += best
+	}
+	covered := 0
+	for _, u := range used {
+		if u {
+			covered++
+		}
+	}
+	// Up to half the base for the words, plus a bonus for covering the field
+	score := base * total / len(q.words) / 200
+	score += base * covered / len(v.words) / 10
+	kind := MatchTokens
+	if fuzzy {
+		kind = MatchFuzzy
+	}
+	return FieldMatch{Kind: kind, Score: score}, score > 0
+}
+
+// wordScore rates how well query word w matches field word fw, from 0 to 100.
+func wordScore(w, fw string) int {
+	switch {
+	case w == fw:
+		return 100
+	case strings.HasPrefix(fw, w):
+		return 80
+	case len(w) >= 3 && strings.Contains(fw, w):
+		return 60
+	}
+	if d := editDistance(w, fw, maxTypos(len(w))); d > 0 {
+		return 60 / d
+	}
+	return 0
+}
+
+// maxTypos is the edit distance tolerated for a word of n bytes.
+func maxTypos(n int) int {
+	switch {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	}
+	return 2
+}
+
+// editDistance returns the optimal string alignment distance between a and b
+// (Levenshtein plus adjacent transpositions), or -1 if it exceeds max.
+// Equal strings return 0.
+func editDistance(a, b string, max int) int {
+	if max <= 0 || abs(len(a)-len(b)) > max {
+		if a == b {
+			return 0
+		}
+		return -1
+	}
+	var buf [3 * 32]int
+	rows := buf[:]
+	if 3*(len(b)+1) > len(rows) {
+		rows = make([]int, 3*(len(b)+1))
+	}
+	n := len(b) + 1
+	prev2, prev, cur := rows[:n], rows[n:2*n], rows[2*n:3*n]
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > max {
+			return -1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	if d := prev[len(b)]; d <= max {
+		return d
+	}
+	return -1
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
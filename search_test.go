@@ -0,0 +1,83 @@
+package llmspecs
+
+import "testing"
+
+func TestSearch_Typos(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string // expected among the top 5
+	}{
+		{"gtp-4o", "openai/gpt-4o"},
+		{"gpt4o", "openai/gpt-4o"},
+		{"claude sonet", "anthropic/claude-sonnet-4.5"},
+		{"qwen3 32b", "qwen/qwen3-32b"},
+		{"32B Qwen3", "qwen/qwen3-32b"},
+		{"Llama_3.1 70B", "meta-llama/llama-3.1-70b-instruct"},
+	}
+	for _, tt := range tests {
+		found := false
+		for _, m := range Search(tt.query, 5) {
+			found = found || m.ID() == tt.want
+		}
+		if !found {
+			t.Errorf("Search(%q) did not return %s in the top 5", tt.query, tt.want)
+		}
+	}
+
+	if got := Search("gpt4o", 1); len(got) != 1 || got[0].ID() != "openai/gpt-4o" {
+		t.Errorf("Expected openai/gpt-4o first for gpt4o, got %v", got)
+	}
+	if got := Search("xyzzy-plugh", 5); len(got) != 0 {
+		t.Errorf("Expected no results for nonsense, got %v", got)
+	}
+	if got := Search("  -- ", 5); got != nil {
+		t.Errorf("Expected nil for a query without letters or digits, got %v", got)
+	}
+}
+
+func TestSearchDetailed(t *testing.T) {
+	results := SearchDetailed("gtp-4o", 1)
+	if len(results) != 1 {
+		t.Fatal("Expected a result for gtp-4o")
+	}
+	r := results[0]
+	if r.Model.ID() != "openai/gpt-4o" || r.Score <= 0 {
+		t.Errorf("Unexpected top result %s (%d)", r.Model.ID(), r.Score)
+	}
+	if len(r.Matches) == 0 || r.Matches[0].Kind != MatchFuzzy || r.Matches[0].Field != "id" {
+		t.Errorf("Expected a fuzzy ID match, got %+v", r.Matches)
+	}
+
+	results = SearchDetailed("gpt4t", 1)
+	if len(results) != 1 || results[0].Matches[0].Field != "alias" || results[0].Matches[0].Kind != MatchExact {
+		t.Errorf("Expected an exact alias match, got %+v", results)
+	}
+
+	// Scores are non-increasing
+	results = SearchDetailed("claude", 0)
+	for i := 1; i < len(results); i++ {
+		if results[i].Score > results[i-1].Score {
+			t.Fatalf("Results not ranked: %d after %d", results[i].Score, results[i-1].Score)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		max  int
+		want int
+	}{
+		{"gpt", "gpt", 1, 0},
+		{"gtp4o", "gpt4o", 1, 1},
+		{"sonet", "sonnet", 1, 1},
+		{"sonet", "sonnet", 0, -1},
+		{"kitten", "sitting", 2, -1},
+		{"kitten", "sitting", 3, 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b, tt.max); got != tt.want {
+			t.Errorf("editDistance(%q, %q, %d) = %d, want %d", tt.a, tt.b, tt.max, got, tt.want)
+		}
+	}
+}
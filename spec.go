@@ -0,0 +1,159 @@
+package llmspecs
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/kingfs/go-llm-specs/internal/modelid"
+)
+
+// ModelSpec is a plain value describing a model. Its JSON and YAML keys
+// mirror the models/ schema, so a spec can be written by hand, loaded with
+// LoadOverlay, or built in code and turned into a Model with FromSpec.
+type ModelSpec struct {
+	ID                  string                `json:"id" yaml:"id"`
+	Name                string                `json:"name" yaml:"name"`
+	NameCN              string                `json:"name_cn,omitempty" yaml:"name_cn,omitempty"`
+	Provider            string                `json:"provider" yaml:"provider"`
+	Description         string                `json:"description,omitempty" yaml:"description,omitempty"`
+	DescriptionCN       string                `json:"description_cn,omitempty" yaml:"description_cn,omitempty"`
+	ContextLength       int                   `json:"context_length" yaml:"context_length"`
+	MaxOutput           int                   `json:"max_output,omitempty" yaml:"max_output,omitempty"`
+	Features            Capability            `json:"features,omitempty" yaml:"features,omitempty"`
+	Aliases             []string              `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	Pricing             Pricing               `json:"pricing,omitzero" yaml:"pricing,omitempty"`
+	SupportedParameters []string              `json:"supported_parameters,omitempty" yaml:"supported_parameters,omitempty"`
+	DefaultParameters   map[string]float64    `json:"default_parameters,omitempty" yaml:"default_parameters,omitempty"`
+	ParameterRanges     map[string]ParamRange `json:"parameter_ranges,omitempty" yaml:"parameter_ranges,omitempty"`
+	Reasoning           ReasoningConfig       `json:"reasoning,omitzero" yaml:"reasoning,omitempty"`
+	Tokenizer           string                `json:"tokenizer,omitempty" yaml:"tokenizer,omitempty"`
+	InstructType        string                `json:"instruct_type,omitempty" yaml:"instruct_type,omitempty"`
+	CanonicalSlug       string                `json:"canonical_slug,omitempty" yaml:"canonical_slug,omitempty"`
+	HuggingFaceID       string                `json:"hugging_face_id,omitempty" yaml:"hugging_face_id,omitempty"`
+	ReleasedAt          time.Time             `json:"released_at,omitzero" yaml:"released_at,omitempty"`
+	ExpiresAt           time.Time             `json:"expires_at,omitzero" yaml:"expires_at,omitempty"`
+	Status              Status                `json:"status,omitempty" yaml:"status,omitempty"`
+	ReplacedBy          string                `json:"replaced_by,omitempty" yaml:"replaced_by,omitempty"`
+	EquivalentTo        []string              `json:"equivalent_to,omitempty" yaml:"equivalent_to,omitempty"`
+	// Family, Version, SizeClass and SnapshotDate are parsed from the ID
+	// by FromSpec when empty.
+	Family       string    `json:"family,omitempty" yaml:"family,omitempty"`
+	Version      string    `json:"version,omitempty" yaml:"version,omitempty"`
+	SizeClass    string    `json:"size_class,omitempty" yaml:"size_class,omitempty"`
+	SnapshotDate time.Time `json:"snapshot_date,omitzero" yaml:"snapshot_date,omitempty"`
+}
+
+// Spec returns a copy of m's metadata. Slices and maps are copied, so the
+// spec can be modified without affecting the registry.
+func Spec(m Model) ModelSpec {
+	r := m.Reasoning()
+	r.Efforts = slices.Clone(r.Efforts)
+	spec := ModelSpec{
+		ID:                  m.ID(),
+		Name:                m.Name(),
+		NameCN:              m.NameCN(),
+		Provider:            m.Provider(),
+		Description:         m.Description(),
+		DescriptionCN:       m.DescriptionCN(),
+		ContextLength:       m.ContextLength(),
+		MaxOutput:           m.MaxOutput(),
+		Features:            m.Features(),
+		Aliases:             slices.Clone(m.Aliases()),
+		Pricing:             m.Pricing(),
+		SupportedParameters: slices.Clone(m.SupportedParameters()),
+		DefaultParameters:   maps.Clone(m.DefaultParameters()),
+		Reasoning:           r,
+		Tokenizer:           m.Tokenizer(),
+		InstructType:        m.InstructType(),
+		HuggingFaceID:       m.HuggingFaceID(),
+		ReleasedAt:          m.ReleasedAt(),
+		ExpiresAt:           m.ExpiresAt(),
+		Status:              m.Status(),
+		ReplacedBy:          m.ReplacedBy(),
+		EquivalentTo:        slices.Clone(m.EquivalentTo()),
+		Family:              m.Family(),
+		Version:             m.Version(),
+		SizeClass:           m.SizeClass(),
+		SnapshotDate:        m.SnapshotDate(),
+	}
+	if slug := m.CanonicalSlug(); slug != m.ID() {
+		spec.CanonicalSlug = slug
+	}
+	if md, ok := m.(*modelData); ok {
+		// ParameterRange falls back to standard ranges; keep only declared ones.
+		spec.ParameterRanges = maps.Clone(md.ParamRanges)
+	}
+	return spec
+}
+
+// FromSpec builds a Model from spec, e.g. to pass it to Register.
+func FromSpec(spec ModelSpec) Model {
+	return spec.model()
+}
+
+func (s ModelSpec) model() *modelData {
+	m := &modelData{
+		IDVal:         s.ID,
+		NameVal:       s.Name,
+		NameCNVal:     s.NameCN,
+		ProviderVal:   s.Provider,
+		DescVal:       s.Description,
+		DescCNVal:     s.DescriptionCN,
+		ContextLenVal: s.ContextLength,
+		MaxOutputVal:  s.MaxOutput,
+		PricingVal:    s.Pricing,
+		TokenizerVal:  s.Tokenizer,
+		InstructVal:   s.InstructType,
+		FeaturesVal:   s.Features,
+		AliasList:     slices.Clone(s.Aliases),
+		ParamList:     slices.Clone(s.SupportedParameters),
+		DefaultParams: maps.Clone(s.DefaultParameters),
+		ParamRanges:   maps.Clone(s.ParameterRanges),
+		ReasoningVal:  s.Reasoning,
+		StatusVal:     s.Status,
+		ReplacedByVal: s.ReplacedBy,
+		HFIDVal:       s.HuggingFaceID,
+		EquivList:     slices.Clone(s.EquivalentTo),
+		FamilyVal:     s.Family,
+		VersionVal:    s.Version,
+		SizeVal:       s.SizeClass,
+	}
+	m.ReasoningVal.Efforts = slices.Clone(s.Reasoning.Efforts)
+	if s.CanonicalSlug != s.ID {
+		m.CanonicalVal = s.CanonicalSlug
+	}
+	if !s.ReleasedAt.IsZero() {
+		m.ReleasedVal = s.ReleasedAt.Unix()
+	}
+	if !s.ExpiresAt.IsZero() {
+		m.ExpiresVal = s.ExpiresAt.Unix()
+	}
+	// Only built-in models decide whether a flavor is a family of its own, so
+	// the result does not depend on registration order.
+	parsed := modelid.ParseAmong(s.ID, s.ReleasedAt, func(id string) bool {
+		_, ok := staticRegistry[id]
+		return ok
+	})
+	if m.FamilyVal == "" {
+		m.FamilyVal = parsed.Family
+	}
+	if m.VersionVal == "" {
+		m.VersionVal = parsed.Version
+	}
+	if m.SizeVal == "" {
+		m.SizeVal = parsed.Size
+	}
+	if snapshot := s.SnapshotDate; !snapshot.IsZero() {
+		m.SnapshotVal = snapshot.Unix()
+	} else if !parsed.Snapshot.IsZero() {
+		m.SnapshotVal = parsed.Snapshot.Unix()
+	}
+	return m
+}
+
+// MarshalJSON encodes the model as its ModelSpec.
+func (m *modelData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Spec(m))
+}
@@ -0,0 +1,144 @@
+package llmspecs
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestSpec_RoundTrip(t *testing.T) {
+	for _, id := range []string{"qwen/qwen3-32b", "anthropic/claude-3.7-sonnet:thinking", "openai/gpt-4o", "openai/gpt-4-turbo"} {
+		m, ok := Get(id)
+		if !ok {
+			t.Fatalf("Expected %s", id)
+		}
+		spec := Spec(m)
+		if got := Spec(FromSpec(spec)); !reflect.DeepEqual(got, spec) {
+			t.Errorf("%s: FromSpec/Spec round-trip changed the spec:\n got %+v\nwant %+v", id, got, spec)
+		}
+
+		data, err := json.Marshal(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fromJSON ModelSpec
+		if err := json.Unmarshal(data, &fromJSON); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(Spec(FromSpec(fromJSON)), spec) {
+			t.Errorf("%s: JSON round-trip changed the spec: %s", id, data)
+		}
+
+		out, err := yaml.Marshal(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fromYAML ModelSpec
+		if err := yaml.Unmarshal(out, &fromYAML); err != nil {
+			t.Fatal(err)
+		}
+		if fromYAML.ID != spec.ID || fromYAML.NameCN != spec.NameCN || fromYAML.Features != spec.Features || fromYAML.Pricing != spec.Pricing ||
+			!fromYAML.ReleasedAt.Equal(spec.ReleasedAt) {
+			t.Errorf("%s: YAML round-trip changed the spec:\n%s", id, out)
+		}
+	}
+}
+
+func TestSpec_Copies(t *testing.T) {
+	m, _ := Get("qwen/qwen3-32b")
+	spec := Spec(m)
+	spec.SupportedParameters[0] = "mutated"
+	if m.SupportedParameters()[0] == "mutated" {
+		t.Error("Spec must not share slices with the registry")
+	}
+}
+
+func TestSpec_NameCN(t *testing.T) {
+	m, _ := Get("openai/gpt-4-turbo")
+	if m.NameCN() == "" || Spec(m).NameCN != m.NameCN() {
+		t.Errorf("Expected the name_cn of models/, got %q", m.NameCN())
+	}
+	data, _ := json.Marshal(m)
+	if !strings.Contains(string(data), `"name_cn":`) {
+		t.Errorf("JSON lacks name_cn: %s", data)
+	}
+}
+
+func TestModel_MarshalJSON(t *testing.T) {
+	m, _ := Get("qwen/qwen3-32b")
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(data)
+	for _, want := range []string{
+		`"id":"qwen/qwen3-32b"`,
+		`"features":["TextIn","TextOut","FunctionCall","JsonMode","Reasoning","Chat"]`,
+		`"pricing":{"prompt":"0.00000008","completion":"0.00000024"}`,
+		`"hugging_face_id":"Qwen/Qwen3-32B"`,
+		`"released_at":"2025-04-28T21:32:25Z"`,
+	} {
+		if !strings.Contains(s, want) {
+			t.Errorf("JSON lacks %s: %s", want, s)
+		}
+	}
+	if strings.Contains(s, `"status"`) || strings.Contains(s, `"expires_at"`) {
+		t.Errorf("Zero values should be omitted: %s", s)
+	}
+}
+
+func TestFromSpec_Register(t *testing.T) {
+	m := FromSpec(ModelSpec{
+		ID:            "acme/spec-model",
+		Name:          "Acme Spec Model",
+		Provider:      "Acme",
+		ContextLength: 8192,
+		Features:      CapChat | ModalityTextIn,
+		Status:        StatusPreview,
+	})
+	if err := Register(m); err != nil {
+		t.Fatal(err)
+	}
+	defer Unregister(m.ID())
+	got, ok := Get("acme/spec-model")
+	if !ok || got.Status() != StatusPreview || got.CanonicalSlug() != "acme/spec-model" {
+		t.Errorf("Unexpected registered model %+v", Spec(got))
+	}
+}
+
+func TestFromSpec_VariantLinks(t *testing.T) {
+	// The variant is registered before its base, and a built-in base gets a
+	// runtime variant
+	free := FromSpec(ModelSpec{ID: "acme/linked:free"})
+	base := FromSpec(ModelSpec{ID: "acme/linked"})
+	extra := FromSpec(ModelSpec{ID: "qwen/qwen3-32b:acme"})
+	for _, m := range []Model{free, base, extra} {
+		if err := Register(m); err != nil {
+			t.Fatal(err)
+		}
+		defer Unregister(m.ID())
+	}
+
+	if free.BaseVariant() != "acme/linked" {
+		t.Errorf("Expected the base to be linked, got %q", free.BaseVariant())
+	}
+	if v := base.Variants(); !reflect.DeepEqual(v, []string{"acme/linked:free"}) {
+		t.Errorf("Unexpected variants %v", v)
+	}
+	if extra.BaseVariant() != "qwen/qwen3-32b" {
+		t.Errorf("Expected the built-in base, got %q", extra.BaseVariant())
+	}
+	qwen, _ := Get("qwen/qwen3-32b")
+	if v := qwen.Variants(); !slices.Contains(v, "qwen/qwen3-32b:acme") {
+		t.Errorf("Expected the runtime variant among %v", v)
+	}
+
+	Unregister(base.ID())
+	if free.BaseVariant() != "" {
+		t.Errorf("Expected no base after Unregister, got %q", free.BaseVariant())
+	}
+}
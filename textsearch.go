@@ -0,0 +1,225 @@
+package llmspecs
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// TextResult is a model returned by SearchText with its BM25 relevance.
+type TextResult struct {
+	Model Model
+	Score float64
+}
+
+// SearchText ranks models by how well their names, aliases and English and
+// Chinese descriptions match a free-text query, e.g. "roleplay", "Solidity"
+// or "智能体". Unlike Search, which finds a model by name, SearchText finds
+// models by what they are good at.
+//
+// Ranking uses BM25 over an in-memory inverted index built on first use.
+// Latin words are lowercased and lightly stemmed; Chinese, Japanese and
+// Korean text is split into overlapping character bigrams. A model matches
+// if it contains any query term; models containing more of them rank higher.
+//
+// filter restricts the candidates, e.g. Query().Has(CapChat).Provider("Meta");
+// only its filters apply, not its ordering or limits. filter may be nil.
+func SearchText(query string, filter *QueryBuilder, limit int) []TextResult {
+	terms := textTerms(query)
+	if len(terms) == 0 {
+		return nil
+	}
+	idx := currentTextIndex()
+
+	scores := make(map[int]float64)
+	seen := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+		postings := idx.postings[term]
+		if len(postings) == 0 {
+			continue
+		}
+		n := float64(len(idx.docs))
+		df := float64(len(postings))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for _, p := range postings {
+			norm := bm25K1 * (1 - bm25B + bm25B*idx.lengths[p.doc]/idx.avgLength)
+			scores[p.doc] += idf * p.tf * (bm25K1 + 1) / (p.tf + norm)
+		}
+	}
+
+	results := make([]TextResult, 0, len(scores))
+	for doc, score := range scores {
+		m := idx.docs[doc]
+		if filter != nil && !filter.match(m) {
+			continue
+		}
+		results = append(results, TextResult{Model: m, Score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score == results[j].Score {
+			return results[i].Model.ID() < results[j].Model.ID()
+		}
+		return results[i].Score > results[j].Score
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// BM25 parameters: term frequency saturation and length normalization.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Field weights: a term in the name counts as much as three in a description.
+const (
+	weightName        = 3
+	weightAlias       = 2
+	weightDescription = 1
+)
+
+type posting struct {
+	doc int
+	tf  float64 // weighted term frequency
+}
+
+// textIndex is an inverted index over the layered registry.
+type textIndex struct {
+	version   uint64 // overlay version the index was built from
+	docs      []Model
+	lengths   []float64 // weighted document lengths
+	avgLength float64
+	postings  map[string][]posting
+}
+
+var textIndexCache struct {
+	sync.Mutex
+	idx *textIndex
+}
+
+// currentTextIndex returns the index of the layered registry, rebuilding it
+// after runtime models change.
+func currentTextIndex() *textIndex {
+	version := overlayVersion()
+	textIndexCache.Lock()
+	defer textIndexCache.Unlock()
+	if idx := textIndexCache.idx; idx != nil && idx.version == version {
+		return idx
+	}
+	idx := buildTextIndex(version)
+	textIndexCache.idx = idx
+	return idx
+}
+
+func buildTextIndex(version uint64) *textIndex {
+	idx := &textIndex{version: version, postings: make(map[string][]posting)}
+	forEach(func(m Model) bool {
+		idx.docs = append(idx.docs, m)
+		return true
+	})
+	// Stable document order keeps ties deterministic
+	sort.Slice(idx.docs, func(i, j int) bool { return idx.docs[i].ID() < idx.docs[j].ID() })
+
+	total := 0.0
+	idx.lengths = make([]float64, len(idx.docs))
+	for doc, m := range idx.docs {
+		tf := make(map[string]float64)
+		add := func(text string, weight float64) {
+			for _, term := range textTerms(text) {
+				tf[term] += weight
+				idx.lengths[doc] += weight
+			}
+		}
+		add(m.Name(), weightName)
+		for _, alias := range m.Aliases() {
+			add(alias, weightAlias)
+		}
+		add(m.Description(), weightDescription)
+		add(m.DescriptionCN(), weightDescription)
+
+		for term, f := range tf {
+			idx.postings[term] = append(idx.postings[term], posting{doc: doc, tf: f})
+		}
+		total += idx.lengths[doc]
+	}
+	if len(idx.docs) > 0 {
+		idx.avgLength = total / float64(len(idx.docs))
+	}
+	return idx
+}
+
+// textTerms splits text into index terms: stemmed lowercase words for
+// alphabetic scripts, and character bigrams for CJK runs.
+func textTerms(text string) []string {
+	var terms []string
+	var word []rune
+	var cjk []rune
+	flushWord := func() {
+		if len(word) > 0 {
+			if w := string(word); !stopWords[w] {
+				terms = append(terms, stem(w))
+			}
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		switch len(cjk) {
+		case 0:
+		case 1:
+			terms = append(terms, string(cjk))
+		default:
+			for i := 0; i+1 < len(cjk); i++ {
+				terms = append(terms, string(cjk[i:i+2]))
+			}
+		}
+		cjk = cjk[:0]
+	}
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, unicode.ToLower(r))
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return terms
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// stem strips common English inflections so "agents" matches "agent".
+// It is deliberately conservative; a wrong merge costs more than a miss.
+func stem(w string) string {
+	switch {
+	case len(w) > 4 && strings.HasSuffix(w, "ies"):
+		return w[:len(w)-3] + "y"
+	case len(w) > 3 && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") && !strings.HasSuffix(w, "us"):
+		return w[:len(w)-1]
+	}
+	return w
+}
+
+// stopWords are frequent English words that carry no meaning for ranking.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "for": true, "from": true, "in": true, "is": true,
+	"it": true, "its": true, "of": true, "on": true, "or": true, "that": true,
+	"the": true, "this": true, "to": true, "with": true,
+}
@@ -0,0 +1,75 @@
+package llmspecs
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTextTerms(t *testing.T) {
+	got := textTerms("The Agents' roleplay 智能体, Qwen3-32B")
+	want := []string{"agent", "roleplay", "智能", "能体", "qwen3", "32b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("textTerms = %q, want %q", got, want)
+	}
+}
+
+func TestSearchText(t *testing.T) {
+	for _, query := range []string{"roleplay", "Solidity", "Korean", "智能体"} {
+		results := SearchText(query, nil, 5)
+		if len(results) == 0 {
+			t.Errorf("SearchText(%q) found nothing", query)
+			continue
+		}
+		for i := 1; i < len(results); i++ {
+			if results[i].Score > results[i-1].Score {
+				t.Errorf("SearchText(%q) not ranked by score", query)
+			}
+		}
+	}
+
+	top := SearchText("solidity smart contracts", nil, 1)
+	if len(top) != 1 || top[0].Model.ID() != "alfredpros/codellama-7b-instruct-solidity" {
+		t.Errorf("Expected the Solidity model first, got %v", top)
+	}
+	m := top[0].Model
+	if !strings.Contains(strings.ToLower(m.Description()), "solidity") {
+		t.Errorf("Top result %s does not mention Solidity", m.ID())
+	}
+
+	if got := SearchText("", nil, 5); got != nil {
+		t.Errorf("Expected nil for an empty query, got %v", got)
+	}
+}
+
+func TestSearchText_Filter(t *testing.T) {
+	results := SearchText("roleplay", Query().Has(CapFunctionCall).ExcludeProviders("Sao10k"), 0)
+	for _, r := range results {
+		if !r.Model.HasCapability(CapFunctionCall) || strings.EqualFold(r.Model.Provider(), "Sao10k") {
+			t.Errorf("Filter ignored for %s", r.Model.ID())
+		}
+	}
+}
+
+func TestSearchText_Overlay(t *testing.T) {
+	m := &modelData{IDVal: "acme/klingon", NameVal: "Acme Klingon", DescVal: "Fluent in Klingon poetry."}
+	if err := Register(m); err != nil {
+		t.Fatal(err)
+	}
+	results := SearchText("klingon", nil, 1)
+	if len(results) != 1 || results[0].Model.ID() != m.ID() {
+		t.Errorf("Expected the runtime model, got %v", results)
+	}
+	Unregister(m.ID())
+	if got := SearchText("klingon", nil, 1); len(got) != 0 {
+		t.Errorf("Index not rebuilt after Unregister, got %v", got)
+	}
+}
+
+func BenchmarkSearchText(b *testing.B) {
+	SearchText("warmup", nil, 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SearchText("multilingual coding agent", nil, 10)
+	}
+}